@@ -0,0 +1,28 @@
+#!/usr/bin/env bash
+set -e
+
+# controller-gen has no markers for CRD conversion webhook and for served flag
+# templating, so CephDeployment CRD is patched after generation: non-storage
+# versions are served and converted only with enabled CephDeployment webhook,
+# CA bundle is set from existing webhook secret or injected by controller
+
+CRD_FILE=${CRD_FILE:-"charts/pelagia-ceph/templates/crds/lcm.mirantis.com_cephdeployments.yaml"}
+
+perl -0pi -e 's/\n    served: true\n    storage: false\n/\n    served: {{ include "webhook.conversionEnabled" . }}\n    storage: false\n/g' "${CRD_FILE}"
+
+perl -0pi -e 's/\n  scope: Namespaced\n(?!\{\{- if)/\n  scope: Namespaced\n{{- if eq (include "webhook.conversionEnabled" .) "true" }}
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+      {{- with include "webhook.caBundle" . }}
+        caBundle: {{ . }}
+      {{- end }}
+        service:
+          name: {{ template "webhook.serviceName" . }}
+          namespace: {{ template "release.namespace" . }}
+          path: \/convert
+          port: 443
+      conversionReviewVersions:
+      - v1
+{{- end }}\n/' "${CRD_FILE}"
@@ -16,6 +16,21 @@ app.kubernetes.io/managed-by: {{ .Release.Service }}
 {{- end -}}
 {{- end -}}
 
+{{- define "webhook.serviceName" -}}
+{{- printf "%s-webhook" .Values.controllers.cephdeployment.appName -}}
+{{- end -}}
+
+{{- define "webhook.conversionEnabled" -}}
+{{- and .Values.cephDeployment.enabled .Values.cephDeployment.webhook.enabled -}}
+{{- end -}}
+
+{{- define "webhook.caBundle" -}}
+{{- $secret := lookup "v1" "Secret" (include "release.namespace" .) (printf "%s-cert" (include "webhook.serviceName" .)) -}}
+{{- if and $secret (index $secret "data") (index $secret.data "ca.crt") -}}
+{{- index $secret.data "ca.crt" -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "controller.image" -}}
 {{- if (.Values.images.pelagia.fullName) -}}
 {{- .Values.images.pelagia.fullName -}}
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	lcmversion "github.com/Mirantis/pelagia/v3/codeversion"
 	lcmapi "github.com/Mirantis/pelagia/v3/pkg/apis"
@@ -42,9 +43,13 @@ func main() {
 	log.Info().Msg(lcmversion.GetCodeVersion("Controller"))
 	log.Info().Msg(lcmversion.GetGoRuntimeVersion())
 
-	var controllerName, leaderElectionID string
+	var controllerName, leaderElectionID, metricsBindAddress, webhookCertDir string
+	var webhookPort int
 	flag.StringVar(&controllerName, "controller-name", "", "controller name")
 	flag.StringVar(&leaderElectionID, "leader-election-id", "", "leader election id")
+	flag.StringVar(&metricsBindAddress, "metrics-bind-address", "0", "bind address for metrics server, '0' disables metrics server")
+	flag.IntVar(&webhookPort, "webhook-port", 0, "port for admission webhook server, '0' disables webhook server")
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "", "directory with admission webhook server TLS certificate and key")
 	flag.Parse()
 
 	if controllerName == "" {
@@ -84,9 +89,15 @@ func main() {
 		LeaderElectionID: leaderElectionID,
 		Metrics: metricsserver.Options{
 			// BindAddress is the bind address for controller runtime metrics server. Defaulted to "0" which is off.
-			BindAddress: "0",
+			BindAddress: metricsBindAddress,
 		},
 	}
+	if webhookPort > 0 {
+		options.WebhookServer = webhook.NewServer(webhook.Options{
+			Port:    webhookPort,
+			CertDir: webhookCertDir,
+		})
+	}
 
 	// Create a new manager to provide shared dependencies and start components
 	mgr, err := manager.New(cfg, options)
@@ -116,6 +127,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup admission webhooks
+	if webhookPort > 0 {
+		if err := lcmcontroller.AddWebhooksToManager(mgr); err != nil {
+			log.Fatal().Err(err).Msg("")
+			os.Exit(1)
+		}
+		log.Info().Msgf("admission webhook server enabled on port %d", webhookPort)
+	}
+
 	log.Info().Msgf("starting controller '%s'", controllerName)
 
 	// Start the Cmd
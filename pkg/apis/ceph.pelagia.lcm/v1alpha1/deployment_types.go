@@ -20,6 +20,7 @@ import (
 // +kubebuilder:resource:path=cephdeployments,scope=Namespaced
 // +kubebuilder:resource:shortName={cephdpl}
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +genclient
 
 // CephDeployment is the Schema for the cephdeployments API which contains
@@ -66,12 +67,29 @@ type CephDeploymentSpec struct {
 	// RookConfig is a key-value mapping which contains ceph config keys with a specified values
 	// +optional
 	RookConfig map[string]string `json:"rookConfig,omitempty"`
+	// RookNamespace is a namespace with Rook operator and Ceph cluster daemons for current
+	// CephDeployment. If not specified, rook namespace from Pelagia config is used.
+	// Each CephDeployment in namespace must use own rook namespace.
+	// +optional
+	RookNamespace string `json:"rookNamespace,omitempty"`
 	// SharedFilesystem enables such system as CephFS
 	// +optional
 	SharedFilesystem *CephSharedFilesystem `json:"sharedFilesystem,omitempty"`
 	// CSI provides an ability to specify CephCSI Drivers and OperatorConfig objects
 	// +optional
 	CSIResources *CephCSI `json:"csi,omitempty"`
+	// Pause allows to pause configuration apply for the whole CephDeployment or
+	// for specified sections only, for example, to edit Rook resources manually
+	// +optional
+	Pause *CephDeploymentPause `json:"pause,omitempty"`
+	// DriftPolicy specifies how to handle changes made in Rook objects managed by
+	// CephDeployment outside of CephDeployment. If not specified, changes are reverted.
+	// +optional
+	DriftPolicy *CephDeploymentDriftPolicy `json:"driftPolicy,omitempty"`
+	// Crush describes additional CRUSH roots and named CRUSH rules, which
+	// could be referenced by pools
+	// +optional
+	Crush *CephDeploymentCrush `json:"crush,omitempty"`
 
 	// Deprecated parameter, objectStorage.gatewayHTTPRoutes should be used instead.
 	// Ingress became deprecated and going to be replaced by Gateway API, for more information
@@ -110,6 +128,21 @@ type CephPool struct {
 	// StorageClassOpts represents options to set on related storage class
 	// +optional
 	StorageClassOpts CephStorageClassSpec `json:"storageClassOpts,omitempty"`
+	// CrushRule is a name of CRUSH rule from crush section to use for pool,
+	// pool spec crushRoot and failureDomain are taken from the rule
+	// +optional
+	CrushRule string `json:"crushRule,omitempty"`
+	// Quota represents pool quotas, applied to pool spec quotas
+	// +optional
+	Quota *CephPoolQuota `json:"quota,omitempty"`
+	// TargetSizeRatio is an expected pool share of device class capacity,
+	// used by PG autoscaler, for example, "0.3". Mutually exclusive with TargetSizeBytes
+	// +optional
+	TargetSizeRatio string `json:"targetSizeRatio,omitempty"`
+	// TargetSizeBytes is an expected pool size used by PG autoscaler,
+	// for example, "500Gi". Mutually exclusive with TargetSizeRatio
+	// +optional
+	TargetSizeBytes string `json:"targetSizeBytes,omitempty"`
 	// PoolSpec represents pool specification
 	// Follow https://rook.io/docs/rook/v1.19/CRDs/Block-Storage/ceph-block-pool-crd
 	// for available options
@@ -137,17 +170,39 @@ type CephDeploymentExtraOpts struct {
 	// Option should be dropped in case of real cluster remove.
 	// +optional
 	PreventClusterDestroy bool `json:"preventClusterDestroy,omitempty"`
-	// OsdRestartReason option is used for restarting ALL osds on config changes,
-	// which are requires daemon restart.
+	// OsdRestartReason option is used for rolling restart of ALL osds on config changes,
+	// which are requires daemon restart. Osds are restarted one failure domain at a time.
 	// Should contain description why it is required.
 	// +nullable
 	OsdRestartReason string `json:"osdRestartReason,omitempty"`
+	// OsdRestartFailureDomain is a crush failure domain type, by which osds are
+	// restarted, for example host or rack. Defaults to host.
+	// +optional
+	OsdRestartFailureDomain string `json:"osdRestartFailureDomain,omitempty"`
+	// OsdRestartAction controls osds rolling restart in progress: Pause stops
+	// restart of next failure domains, Abort cancels restart. Empty value resumes restart.
+	// +kubebuilder:validation:Enum=Pause;Abort
+	// +optional
+	OsdRestartAction OsdRestartAction `json:"osdRestartAction,omitempty"`
+	// CephConfigRollbackGeneration is a generation of previously applied Ceph config
+	// from history to roll back to. While set, Ceph config built from spec is not applied.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CephConfigRollbackGeneration int64 `json:"cephConfigRollbackGeneration,omitempty"`
 	// DisableOsKeys disables automatic generating of openstack-ceph-keys secret.
 	// Valuable only for MOS managed clusters
 	// +optional
 	DisableOsKeys bool `json:"disableOsSharedKeys,omitempty"`
 }
 
+// OsdRestartAction is an action for osds rolling restart in progress
+type OsdRestartAction string
+
+const (
+	OsdRestartActionPause OsdRestartAction = "Pause"
+	OsdRestartActionAbort OsdRestartAction = "Abort"
+)
+
 // CephDeploymentNode contains specific node configuration to use it in Ceph Cluster
 type CephDeploymentNode struct {
 	cephv1.Node `json:",inline"`
@@ -170,7 +225,7 @@ type CephDeploymentNode struct {
 	// +nullable
 	NodesByLabel string `json:"nodesByLabel,omitempty"`
 	// MonitorIP represents custom static endpoint for monitor daemon on a node.
-	// Updates have no effect on that parameter, could be used only on monitor create
+	// On update, monitor on a node is removed and created again with a new endpoint
 	// +nullable
 	MonitorIP string `json:"monitorIP,omitempty"`
 }
@@ -381,6 +436,129 @@ const (
 	NVMEoFCSIDriver CSIDriverType = "nvmeof"
 )
 
+// CephDeploymentPause describes configuration apply pause
+type CephDeploymentPause struct {
+	// Reason is a description why configuration apply is paused
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+	// Sections is a list of configuration sections to pause apply for. Sections
+	// are named the same as CephDeployment status conditions. If not specified,
+	// the whole configuration apply is paused.
+	// +optional
+	Sections []CephDeploymentSection `json:"sections,omitempty"`
+	// Until is a time when pause expires and configuration apply is resumed.
+	// If not specified, pause is active until removed from spec.
+	// +optional
+	// +nullable
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// CephDeploymentSection is a configuration section name, equal to corresponding
+// status condition type
+// +kubebuilder:validation:Enum=Cluster;Pools;SharedFilesystem;StorageClasses;Clients;ObjectStorage;RBDMirror;CSI;NetworkPolicy;OpenstackSecret;ClusterState
+type CephDeploymentSection string
+
+// CephDeploymentCrush describes additional CRUSH roots and named CRUSH rules
+type CephDeploymentCrush struct {
+	// Roots is a list of additional CRUSH roots. Nodes are placed to a root
+	// with 'root' key in node crush section, otherwise 'default' root is used
+	// +optional
+	Roots []string `json:"roots,omitempty"`
+	// Rules is a list of named CRUSH rules, which could be referenced by pools
+	// +optional
+	Rules []CephCrushRule `json:"rules,omitempty"`
+}
+
+// CephCrushRule describes named CRUSH rule
+type CephCrushRule struct {
+	// Name is a CRUSH rule name to reference from pools
+	Name string `json:"name"`
+	// Root is a CRUSH root to place data in. If not specified, 'default' root is used
+	// +optional
+	Root string `json:"root,omitempty"`
+	// FailureDomain is a CRUSH failure domain for the rule, for example, host or rack
+	// +optional
+	FailureDomain string `json:"failureDomain,omitempty"`
+	// DeviceClass restricts rule to a particular device class. If specified,
+	// must be equal to device class of pools referencing the rule
+	// +optional
+	DeviceClass string `json:"deviceClass,omitempty"`
+}
+
+// CephPoolQuota describes pool quotas
+type CephPoolQuota struct {
+	// MaxBytes is a maximum pool size, for example, "100Gi"
+	// +optional
+	MaxBytes string `json:"maxBytes,omitempty"`
+	// MaxObjects is a maximum number of objects in pool
+	// +optional
+	MaxObjects uint64 `json:"maxObjects,omitempty"`
+}
+
+// CephDeploymentDriftPolicy describes handling of Rook objects changed outside of CephDeployment
+type CephDeploymentDriftPolicy struct {
+	// Default is a policy for all managed objects, if not specified 'revert' is used
+	// +optional
+	Default DriftPolicy `json:"default,omitempty"`
+	// Objects is a list of policies for particular objects, overrides default policy
+	// +optional
+	Objects []CephDeploymentObjectDriftPolicy `json:"objects,omitempty"`
+	// CephConfig is a policy for Ceph config options set outside of CephDeployment,
+	// for example, with 'ceph config set' command
+	// +optional
+	CephConfig *CephConfigDriftPolicy `json:"cephConfig,omitempty"`
+}
+
+// CephConfigDriftPolicy describes handling of Ceph config options set outside of CephDeployment
+type CephConfigDriftPolicy struct {
+	// Policy is a policy for options set outside of CephDeployment: 'report-only' to report
+	// options only, 'remove' to report them and remove options listed in RemoveOptions from
+	// Ceph config database. If not specified, 'report-only' is used
+	// +kubebuilder:validation:Enum=report-only;remove
+	// +optional
+	Policy CephConfigDriftAction `json:"policy,omitempty"`
+	// IgnoredOptions is a list of options, which are not reported and never removed,
+	// in '<section>|<option>' or '<option>' format
+	// +optional
+	IgnoredOptions []string `json:"ignoredOptions,omitempty"`
+	// RemoveOptions is a list of options set outside of CephDeployment, which are removed
+	// with 'remove' policy, in '<section>|<option>' or '<option>' format. Other options are
+	// only reported, since Rook and Ceph daemons set options in Ceph config database as well
+	// +optional
+	RemoveOptions []string `json:"removeOptions,omitempty"`
+}
+
+// CephDeploymentObjectDriftPolicy describes drift policy for a particular object
+type CephDeploymentObjectDriftPolicy struct {
+	// Kind is a kind of managed Rook object
+	// +kubebuilder:validation:Enum=CephCluster;CephBlockPool;CephObjectStore
+	Kind string `json:"kind"`
+	// Name is a name of managed Rook object
+	Name string `json:"name"`
+	// Policy is a drift policy for the object
+	Policy DriftPolicy `json:"policy"`
+}
+
+// DriftPolicy is a policy for changes made in managed objects outside of CephDeployment:
+// 'revert' to report and revert changes, 'report-only' to report changes and skip object
+// update, 'adopt' to report changes and keep them until object is changed in CephDeployment
+// +kubebuilder:validation:Enum=revert;report-only;adopt
+type DriftPolicy string
+
+const (
+	DriftPolicyRevert     DriftPolicy = "revert"
+	DriftPolicyReportOnly DriftPolicy = "report-only"
+	DriftPolicyAdopt      DriftPolicy = "adopt"
+)
+
+// CephConfigDriftAction is an action for Ceph config options set outside of CephDeployment
+type CephConfigDriftAction string
+
+const (
+	CephConfigDriftReportOnly CephConfigDriftAction = "report-only"
+	CephConfigDriftRemove     CephConfigDriftAction = "remove"
+)
+
 type CephDeploymentPhase string
 
 const (
@@ -390,10 +568,37 @@ const (
 	PhaseReady       CephDeploymentPhase = "Ready"
 	PhaseOnHold      CephDeploymentPhase = "OnHold"
 	PhaseMaintenance CephDeploymentPhase = "Maintenance"
+	PhasePaused      CephDeploymentPhase = "Paused"
+	PhasePlanning    CephDeploymentPhase = "Planning"
 	PhaseDeleting    CephDeploymentPhase = "Deleting"
 	PhaseFailed      CephDeploymentPhase = "Failed"
 )
 
+// CephDeployment status condition types, each one reflects configuration
+// apply state of a corresponding subsystem
+const (
+	ConditionTypeCluster          = "Cluster"
+	ConditionTypePools            = "Pools"
+	ConditionTypeSharedFilesystem = "SharedFilesystem"
+	ConditionTypeStorageClasses   = "StorageClasses"
+	ConditionTypeClients          = "Clients"
+	ConditionTypeObjectStorage    = "ObjectStorage"
+	ConditionTypeRBDMirror        = "RBDMirror"
+	ConditionTypeCSI              = "CSI"
+	ConditionTypeNetworkPolicy    = "NetworkPolicy"
+	ConditionTypeOpenstackSecret  = "OpenstackSecret"
+	ConditionTypeClusterState     = "ClusterState"
+)
+
+// CephDeployment status condition reasons
+const (
+	ConditionReasonApplied    = "Applied"
+	ConditionReasonInProgress = "InProgress"
+	ConditionReasonFailed     = "Failed"
+	ConditionReasonPending    = "Pending"
+	ConditionReasonPaused     = "Paused"
+)
+
 // CephDeploymentStatus defines the observed state of MiraCeph
 type CephDeploymentStatus struct {
 	//+kubebuilder:default=Creating
@@ -414,6 +619,140 @@ type CephDeploymentStatus struct {
 	// objects refs
 	// +optional
 	ObjectsRefs []v1.ObjectReference `json:"objRefs,omitempty"`
+	// Pause reflects currently active configuration apply pause
+	// +optional
+	Pause *CephDeploymentPauseStatus `json:"pause,omitempty"`
+	// Drifts is a list of managed Rook objects changed outside of CephDeployment
+	// +optional
+	Drifts []CephDeploymentObjectDrift `json:"drifts,omitempty"`
+	// Monitors reflects progress of Ceph Monitors removal or relocation
+	// +optional
+	Monitors *CephDeploymentMonitorsStatus `json:"monitors,omitempty"`
+	// OsdRestart reflects progress of osds rolling restart
+	// +optional
+	OsdRestart *CephDeploymentOsdRestartStatus `json:"osdRestart,omitempty"`
+	// CephConfig reflects currently applied Ceph config generation from history
+	// +optional
+	CephConfig *CephDeploymentCephConfigStatus `json:"cephConfig,omitempty"`
+	// Conditions represents configuration apply state per each subsystem
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// CephDeploymentCephConfigStatus reflects currently applied Ceph config generation
+type CephDeploymentCephConfigStatus struct {
+	// Generation is a currently applied Ceph config generation from history
+	Generation int64 `json:"generation"`
+	// AppliedAt is a time when current Ceph config generation was applied
+	AppliedAt string `json:"appliedAt"`
+	// RollbackGeneration is a Ceph config generation from history, which is rolled back to
+	// +optional
+	RollbackGeneration int64 `json:"rollbackGeneration,omitempty"`
+}
+
+// CephDeploymentPauseStatus reflects active configuration apply pause
+type CephDeploymentPauseStatus struct {
+	// Reason is a description why configuration apply is paused
+	Reason string `json:"reason"`
+	// Sections is a list of configuration sections with paused apply
+	Sections []CephDeploymentSection `json:"sections"`
+	// Since is a time when pause became active
+	Since metav1.Time `json:"since"`
+	// Until is a time when pause expires
+	// +optional
+	// +nullable
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// CephDeploymentMonitorsStatus reflects progress of Ceph Monitors removal or relocation
+type CephDeploymentMonitorsStatus struct {
+	// Pending is a list of Ceph Monitors to remove, processed one by one
+	Pending []CephDeploymentMonitorChange `json:"pending"`
+	// Message is a description of a current Ceph Monitors change step
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CephDeploymentMonitorChange describes Ceph Monitor to remove
+type CephDeploymentMonitorChange struct {
+	// Name is a Ceph Monitor name
+	Name string `json:"name"`
+	// Node is a name of node, where Ceph Monitor is placed
+	Node string `json:"node"`
+	// Reason is a description why Ceph Monitor is removed
+	Reason string `json:"reason"`
+}
+
+// OsdRestartPhase is a phase of osds rolling restart
+type OsdRestartPhase string
+
+const (
+	OsdRestartInProgress OsdRestartPhase = "InProgress"
+	OsdRestartPaused     OsdRestartPhase = "Paused"
+	OsdRestartCompleted  OsdRestartPhase = "Completed"
+	OsdRestartAborted    OsdRestartPhase = "Aborted"
+)
+
+// OsdRestartDomainState is a restart state of a failure domain
+type OsdRestartDomainState string
+
+const (
+	OsdRestartDomainPending    OsdRestartDomainState = "Pending"
+	OsdRestartDomainRestarting OsdRestartDomainState = "Restarting"
+	OsdRestartDomainCompleted  OsdRestartDomainState = "Completed"
+)
+
+// CephDeploymentOsdRestartStatus reflects progress of osds rolling restart
+type CephDeploymentOsdRestartStatus struct {
+	// Reason is an osds restart reason
+	Reason string `json:"reason"`
+	// FailureDomain is a crush failure domain type, by which osds are restarted
+	FailureDomain string `json:"failureDomain"`
+	// Phase is a current osds restart phase
+	Phase OsdRestartPhase `json:"phase"`
+	// Domains is a list of failure domains, restarted one by one
+	// +optional
+	Domains []CephDeploymentOsdRestartDomain `json:"domains,omitempty"`
+	// Message is a description of a current osds restart step
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartedAt is a time when osds restart is started
+	StartedAt metav1.Time `json:"startedAt"`
+}
+
+// CephDeploymentOsdRestartDomain reflects restart state of a failure domain
+type CephDeploymentOsdRestartDomain struct {
+	// Name is a failure domain name
+	Name string `json:"name"`
+	// Osds is a list of osd ids in failure domain
+	Osds []string `json:"osds"`
+	// State is a failure domain restart state
+	State OsdRestartDomainState `json:"state"`
+	// RestartedAt is a time when failure domain osds are restarted
+	// +optional
+	// +nullable
+	RestartedAt *metav1.Time `json:"restartedAt,omitempty"`
+}
+
+// CephDeploymentObjectDrift describes managed Rook object changed outside of CephDeployment
+type CephDeploymentObjectDrift struct {
+	// Kind is a kind of drifted object
+	Kind string `json:"kind"`
+	// Namespace is a namespace of drifted object
+	Namespace string `json:"namespace"`
+	// Name is a name of drifted object
+	Name string `json:"name"`
+	// Fields is a list of object fields, which differ from CephDeployment spec
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// Policy is a drift policy applied to the object
+	Policy DriftPolicy `json:"policy"`
+	// DetectedAt is a time when drift is detected
+	DetectedAt metav1.Time `json:"detectedAt"`
 }
 
 type ValidationResult string
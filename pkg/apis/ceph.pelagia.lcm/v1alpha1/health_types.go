@@ -26,6 +26,7 @@ type CephDeploymentHealth struct {
 
 type CephDeploymentHealthState string
 type DaemonState string
+type HealthIssueState string
 
 const (
 	HealthStateOk     CephDeploymentHealthState = "Ok"
@@ -34,6 +35,9 @@ const (
 	DaemonStateOk      DaemonState = "ok"
 	DaemonStateFailed  DaemonState = "failed"
 	DaemonStateSkipped DaemonState = "skipped"
+
+	HealthIssueActive   HealthIssueState = "Active"
+	HealthIssueResolved HealthIssueState = "Resolved"
 )
 
 type CephDeploymentHealthStatus struct {
@@ -51,6 +55,25 @@ type CephDeploymentHealthStatus struct {
 	// Messages is a list with any possible error/warning messages
 	// +optional
 	Issues []string `json:"issues,omitempty"`
+	// History is a bounded list of health issues transitions, from the oldest to the latest
+	// +optional
+	History []HealthIssueTransition `json:"history,omitempty"`
+}
+
+type HealthIssueTransition struct {
+	// Issue is a health issue message
+	Issue string `json:"issue"`
+	// Source is a daemon or a check, which raised the issue
+	Source string `json:"source"`
+	// State is an issue state: Active or Resolved
+	State HealthIssueState `json:"state"`
+	// FirstSeen is a time when issue appeared
+	FirstSeen string `json:"firstSeen"`
+	// LastSeen is a last health check time when issue was present
+	LastSeen string `json:"lastSeen"`
+	// ResolvedAt is a health check time when issue was not found anymore
+	// +optional
+	ResolvedAt string `json:"resolvedAt,omitempty"`
 }
 
 type CephDeploymentHealthReport struct {
@@ -68,6 +91,33 @@ type CephDeploymentHealthReport struct {
 	// Osd spec analyse based on info from disk daemons for osd nodes
 	// +optional
 	OsdAnalysis *OsdSpecAnalysisState `json:"osdAnalysis,omitempty"`
+	// CephConfigDrift represents differences between Ceph config database and
+	// config computed by CephDeployment per config section
+	// +optional
+	CephConfigDrift map[string]CephConfigSectionDrift `json:"cephConfigDrift,omitempty"`
+}
+
+type CephConfigSectionDrift struct {
+	// Unexpected is a list of options set outside of CephDeployment
+	// +optional
+	Unexpected []CephConfigOptionDrift `json:"unexpected,omitempty"`
+	// Overridden is a list of options with value, which differs from expected one
+	// +optional
+	Overridden []CephConfigOptionDrift `json:"overridden,omitempty"`
+	// Missing is a list of expected options, which are not set in Ceph config database
+	// +optional
+	Missing []CephConfigOptionDrift `json:"missing,omitempty"`
+}
+
+type CephConfigOptionDrift struct {
+	// Name is a Ceph config option name
+	Name string `json:"name"`
+	// Expected is an option value computed by CephDeployment
+	// +optional
+	Expected string `json:"expected,omitempty"`
+	// Actual is an option value in Ceph config database
+	// +optional
+	Actual string `json:"actual,omitempty"`
 }
 
 type CephDaemonsStatus struct {
@@ -152,6 +202,14 @@ type ClusterDetails struct {
 	// RgwInfo represents additional Ceph Multiste Object storage info
 	// +optional
 	RgwInfo *RgwInfo `json:"rgwInfo,omitempty"`
+	// CapacityForecast contains usage fill rate and estimated time to nearfull/full
+	// per class/pools based on periodic usage samples
+	// +optional
+	CapacityForecast *CapacityForecast `json:"capacityForecast,omitempty"`
+	// PgDetails contains info about placement groups in inactive, undersized, degraded,
+	// incomplete, inconsistent states and stuck placement groups
+	// +optional
+	PgDetails *PgDetails `json:"pgDetails,omitempty"`
 }
 
 type UsageDetails struct {
@@ -190,6 +248,60 @@ type PoolUsageStats struct {
 	TotalBytes string `json:"totalBytes,omitempty"`
 }
 
+type CapacityForecast struct {
+	// SamplesPeriod is a time period covered by usage samples used for forecast
+	SamplesPeriod string `json:"samplesPeriod"`
+	// ClassesForecast represents forecast based on device classes usage
+	// +optional
+	ClassesForecast map[string]CapacityForecastStats `json:"deviceClasses,omitempty"`
+	// PoolsForecast represents forecast based on pools usage
+	// +optional
+	PoolsForecast map[string]CapacityForecastStats `json:"pools,omitempty"`
+}
+
+type CapacityForecastStats struct {
+	// FillRateBytesPerDay average used bytes growth per day, negative when usage decreases
+	FillRateBytesPerDay string `json:"fillRateBytesPerDay"`
+	// DaysToNearFull estimated number of days until nearfull ratio is reached,
+	// not set when usage is not growing
+	// +optional
+	DaysToNearFull string `json:"daysToNearFull,omitempty"`
+	// DaysToFull estimated number of days until full ratio is reached,
+	// not set when usage is not growing
+	// +optional
+	DaysToFull string `json:"daysToFull,omitempty"`
+}
+
+type PgDetails struct {
+	// StatesCount is a number of placement groups per state
+	// +optional
+	StatesCount map[string]int `json:"statesCount,omitempty"`
+	// StuckPgsCount is an overall number of stuck placement groups
+	// +optional
+	StuckPgsCount int `json:"stuckPgsCount,omitempty"`
+	// StuckPgs is a list of stuck placement groups, the longest stuck go first
+	// +optional
+	StuckPgs []StuckPgInfo `json:"stuckPgs,omitempty"`
+}
+
+type StuckPgInfo struct {
+	// PgID is a placement group id
+	PgID string `json:"pgid"`
+	// Pool is a name of pool, which placement group belongs to
+	Pool string `json:"pool"`
+	// State is a current placement group state
+	State string `json:"state"`
+	// ActingOsds is a list of osds from placement group acting set
+	// +optional
+	ActingOsds []int `json:"actingOsds,omitempty"`
+	// ActingHosts is a list of hosts, where acting set osds are placed
+	// +optional
+	ActingHosts []string `json:"actingHosts,omitempty"`
+	// StuckSince is a time when placement group was last seen in expected state
+	// +optional
+	StuckSince string `json:"stuckSince,omitempty"`
+}
+
 const (
 	CephEventIdle        CephEventState = "Idle"
 	CephEventProgressing CephEventState = "Progressing"
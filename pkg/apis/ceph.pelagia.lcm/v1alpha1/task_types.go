@@ -44,6 +44,10 @@ type CephOsdRemoveTaskSpec struct {
 	// do not block any further operations.
 	// +optional
 	Resolved bool `json:"resolved,omitempty"`
+	// CephDeployment is a name of CephDeployment, which Ceph cluster osds are removed from.
+	// Required only if namespace contains multiple CephDeployments.
+	// +optional
+	CephDeployment string `json:"cephDeployment,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	ceph_rook_iov1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -41,6 +42,50 @@ func (in *BlockStorageStatus) DeepCopy() *BlockStorageStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecast) DeepCopyInto(out *CapacityForecast) {
+	*out = *in
+	if in.ClassesForecast != nil {
+		in, out := &in.ClassesForecast, &out.ClassesForecast
+		*out = make(map[string]CapacityForecastStats, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PoolsForecast != nil {
+		in, out := &in.PoolsForecast, &out.PoolsForecast
+		*out = make(map[string]CapacityForecastStats, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecast.
+func (in *CapacityForecast) DeepCopy() *CapacityForecast {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecastStats) DeepCopyInto(out *CapacityForecastStats) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecastStats.
+func (in *CapacityForecastStats) DeepCopy() *CapacityForecastStats {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecastStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephBlockStorage) DeepCopyInto(out *CephBlockStorage) {
 	*out = *in
@@ -169,6 +214,91 @@ func (in *CephClusterSpecVersion) DeepCopy() *CephClusterSpecVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephConfigDriftPolicy) DeepCopyInto(out *CephConfigDriftPolicy) {
+	*out = *in
+	if in.IgnoredOptions != nil {
+		in, out := &in.IgnoredOptions, &out.IgnoredOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoveOptions != nil {
+		in, out := &in.RemoveOptions, &out.RemoveOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephConfigDriftPolicy.
+func (in *CephConfigDriftPolicy) DeepCopy() *CephConfigDriftPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CephConfigDriftPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephConfigOptionDrift) DeepCopyInto(out *CephConfigOptionDrift) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephConfigOptionDrift.
+func (in *CephConfigOptionDrift) DeepCopy() *CephConfigOptionDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(CephConfigOptionDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephConfigSectionDrift) DeepCopyInto(out *CephConfigSectionDrift) {
+	*out = *in
+	if in.Unexpected != nil {
+		in, out := &in.Unexpected, &out.Unexpected
+		*out = make([]CephConfigOptionDrift, len(*in))
+		copy(*out, *in)
+	}
+	if in.Overridden != nil {
+		in, out := &in.Overridden, &out.Overridden
+		*out = make([]CephConfigOptionDrift, len(*in))
+		copy(*out, *in)
+	}
+	if in.Missing != nil {
+		in, out := &in.Missing, &out.Missing
+		*out = make([]CephConfigOptionDrift, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephConfigSectionDrift.
+func (in *CephConfigSectionDrift) DeepCopy() *CephConfigSectionDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(CephConfigSectionDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephCrushRule) DeepCopyInto(out *CephCrushRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephCrushRule.
+func (in *CephCrushRule) DeepCopy() *CephCrushRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CephCrushRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephDaemonsStatus) DeepCopyInto(out *CephDaemonsStatus) {
 	*out = *in
@@ -225,6 +355,21 @@ func (in *CephDeployment) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentCephConfigStatus) DeepCopyInto(out *CephDeploymentCephConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentCephConfigStatus.
+func (in *CephDeploymentCephConfigStatus) DeepCopy() *CephDeploymentCephConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentCephConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephDeploymentCert) DeepCopyInto(out *CephDeploymentCert) {
 	*out = *in
@@ -240,6 +385,56 @@ func (in *CephDeploymentCert) DeepCopy() *CephDeploymentCert {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentCrush) DeepCopyInto(out *CephDeploymentCrush) {
+	*out = *in
+	if in.Roots != nil {
+		in, out := &in.Roots, &out.Roots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]CephCrushRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentCrush.
+func (in *CephDeploymentCrush) DeepCopy() *CephDeploymentCrush {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentCrush)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentDriftPolicy) DeepCopyInto(out *CephDeploymentDriftPolicy) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]CephDeploymentObjectDriftPolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.CephConfig != nil {
+		in, out := &in.CephConfig, &out.CephConfig
+		*out = new(CephConfigDriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentDriftPolicy.
+func (in *CephDeploymentDriftPolicy) DeepCopy() *CephDeploymentDriftPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentDriftPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephDeploymentExtraOpts) DeepCopyInto(out *CephDeploymentExtraOpts) {
 	*out = *in
@@ -371,6 +566,13 @@ func (in *CephDeploymentHealthReport) DeepCopyInto(out *CephDeploymentHealthRepo
 		*out = new(OsdSpecAnalysisState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CephConfigDrift != nil {
+		in, out := &in.CephConfigDrift, &out.CephConfigDrift
+		*out = make(map[string]CephConfigSectionDrift, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentHealthReport.
@@ -396,6 +598,11 @@ func (in *CephDeploymentHealthStatus) DeepCopyInto(out *CephDeploymentHealthStat
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]HealthIssueTransition, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentHealthStatus.
@@ -564,6 +771,41 @@ func (in *CephDeploymentMaintenanceStatus) DeepCopy() *CephDeploymentMaintenance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentMonitorChange) DeepCopyInto(out *CephDeploymentMonitorChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentMonitorChange.
+func (in *CephDeploymentMonitorChange) DeepCopy() *CephDeploymentMonitorChange {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentMonitorChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentMonitorsStatus) DeepCopyInto(out *CephDeploymentMonitorsStatus) {
+	*out = *in
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]CephDeploymentMonitorChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentMonitorsStatus.
+func (in *CephDeploymentMonitorsStatus) DeepCopy() *CephDeploymentMonitorsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentMonitorsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephDeploymentNode) DeepCopyInto(out *CephDeploymentNode) {
 	*out = *in
@@ -597,6 +839,138 @@ func (in *CephDeploymentNode) DeepCopy() *CephDeploymentNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentObjectDrift) DeepCopyInto(out *CephDeploymentObjectDrift) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentObjectDrift.
+func (in *CephDeploymentObjectDrift) DeepCopy() *CephDeploymentObjectDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentObjectDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentObjectDriftPolicy) DeepCopyInto(out *CephDeploymentObjectDriftPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentObjectDriftPolicy.
+func (in *CephDeploymentObjectDriftPolicy) DeepCopy() *CephDeploymentObjectDriftPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentObjectDriftPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentOsdRestartDomain) DeepCopyInto(out *CephDeploymentOsdRestartDomain) {
+	*out = *in
+	if in.Osds != nil {
+		in, out := &in.Osds, &out.Osds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestartedAt != nil {
+		in, out := &in.RestartedAt, &out.RestartedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentOsdRestartDomain.
+func (in *CephDeploymentOsdRestartDomain) DeepCopy() *CephDeploymentOsdRestartDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentOsdRestartDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentOsdRestartStatus) DeepCopyInto(out *CephDeploymentOsdRestartStatus) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]CephDeploymentOsdRestartDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentOsdRestartStatus.
+func (in *CephDeploymentOsdRestartStatus) DeepCopy() *CephDeploymentOsdRestartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentOsdRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentPause) DeepCopyInto(out *CephDeploymentPause) {
+	*out = *in
+	if in.Sections != nil {
+		in, out := &in.Sections, &out.Sections
+		*out = make([]CephDeploymentSection, len(*in))
+		copy(*out, *in)
+	}
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentPause.
+func (in *CephDeploymentPause) DeepCopy() *CephDeploymentPause {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentPause)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephDeploymentPauseStatus) DeepCopyInto(out *CephDeploymentPauseStatus) {
+	*out = *in
+	if in.Sections != nil {
+		in, out := &in.Sections, &out.Sections
+		*out = make([]CephDeploymentSection, len(*in))
+		copy(*out, *in)
+	}
+	in.Since.DeepCopyInto(&out.Since)
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentPauseStatus.
+func (in *CephDeploymentPauseStatus) DeepCopy() *CephDeploymentPauseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephDeploymentPauseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephDeploymentSecret) DeepCopyInto(out *CephDeploymentSecret) {
 	*out = *in
@@ -783,6 +1157,21 @@ func (in *CephDeploymentSpec) DeepCopyInto(out *CephDeploymentSpec) {
 		*out = new(CephCSI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(CephDeploymentPause)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(CephDeploymentDriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Crush != nil {
+		in, out := &in.Crush, &out.Crush
+		*out = new(CephDeploymentCrush)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IngressConfig != nil {
 		in, out := &in.IngressConfig, &out.IngressConfig
 		*out = new(CephDeploymentIngressConfig)
@@ -809,6 +1198,40 @@ func (in *CephDeploymentStatus) DeepCopyInto(out *CephDeploymentStatus) {
 		*out = make([]v1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(CephDeploymentPauseStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drifts != nil {
+		in, out := &in.Drifts, &out.Drifts
+		*out = make([]CephDeploymentObjectDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = new(CephDeploymentMonitorsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OsdRestart != nil {
+		in, out := &in.OsdRestart, &out.OsdRestart
+		*out = new(CephDeploymentOsdRestartStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CephConfig != nil {
+		in, out := &in.CephConfig, &out.CephConfig
+		*out = new(CephDeploymentCephConfigStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephDeploymentStatus.
@@ -1198,6 +1621,11 @@ func (in *CephOsdRemoveTaskStatus) DeepCopy() *CephOsdRemoveTaskStatus {
 func (in *CephPool) DeepCopyInto(out *CephPool) {
 	*out = *in
 	out.StorageClassOpts = in.StorageClassOpts
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(CephPoolQuota)
+		**out = **in
+	}
 	in.PoolSpec.DeepCopyInto(&out.PoolSpec)
 }
 
@@ -1211,6 +1639,21 @@ func (in *CephPool) DeepCopy() *CephPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephPoolQuota) DeepCopyInto(out *CephPoolQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephPoolQuota.
+func (in *CephPoolQuota) DeepCopy() *CephPoolQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(CephPoolQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephRBDMirrorSecret) DeepCopyInto(out *CephRBDMirrorSecret) {
 	*out = *in
@@ -1323,6 +1766,16 @@ func (in *ClusterDetails) DeepCopyInto(out *ClusterDetails) {
 		*out = new(RgwInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityForecast != nil {
+		in, out := &in.CapacityForecast, &out.CapacityForecast
+		*out = new(CapacityForecast)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PgDetails != nil {
+		in, out := &in.PgDetails, &out.PgDetails
+		*out = new(PgDetails)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDetails.
@@ -1411,6 +1864,21 @@ func (in DeviceMapping) DeepCopy() DeviceMapping {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthIssueTransition) DeepCopyInto(out *HealthIssueTransition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthIssueTransition.
+func (in *HealthIssueTransition) DeepCopy() *HealthIssueTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthIssueTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostMapping) DeepCopyInto(out *HostMapping) {
 	*out = *in
@@ -1684,6 +2152,35 @@ func (in *OsdSpecAnalysisState) DeepCopy() *OsdSpecAnalysisState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PgDetails) DeepCopyInto(out *PgDetails) {
+	*out = *in
+	if in.StatesCount != nil {
+		in, out := &in.StatesCount, &out.StatesCount
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StuckPgs != nil {
+		in, out := &in.StuckPgs, &out.StuckPgs
+		*out = make([]StuckPgInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PgDetails.
+func (in *PgDetails) DeepCopy() *PgDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(PgDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PoolUsageStats) DeepCopyInto(out *PoolUsageStats) {
 	*out = *in
@@ -1862,6 +2359,31 @@ func (in *SharedFilesystemStatus) DeepCopy() *SharedFilesystemStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StuckPgInfo) DeepCopyInto(out *StuckPgInfo) {
+	*out = *in
+	if in.ActingOsds != nil {
+		in, out := &in.ActingOsds, &out.ActingOsds
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActingHosts != nil {
+		in, out := &in.ActingHosts, &out.ActingHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StuckPgInfo.
+func (in *StuckPgInfo) DeepCopy() *StuckPgInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(StuckPgInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskRemoveInfo) DeepCopyInto(out *TaskRemoveInfo) {
 	*out = *in
@@ -0,0 +1,442 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+var _ conversion.Convertible = &CephDeployment{}
+
+// HubRawSpecsAnnotation keeps hub (v1alpha1) raw specs, which are not encoded back
+// from v1beta1 sections as is, for example, specs with fields unknown to v1beta1,
+// so they are restored on conversion back to the hub, if sections are not changed
+const HubRawSpecsAnnotation = "cephdeployment.lcm.mirantis.com/v1alpha1-raw-specs"
+
+// hubRawSpecs contains hub raw specs by CephDeployment spec section path
+type hubRawSpecs map[string]json.RawMessage
+
+// ConvertTo converts v1beta1 CephDeployment to the hub (v1alpha1) version,
+// all structured sections are encoded back to raw specs
+func (cd *CephDeployment) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*cephlcmv1alpha1.CephDeployment)
+	if !ok {
+		return errors.Errorf("unexpected hub type %T for CephDeployment conversion", dstRaw)
+	}
+	cd.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	preserved := hubRawSpecs{}
+	if data, present := dst.Annotations[HubRawSpecsAnnotation]; present {
+		if err := json.Unmarshal([]byte(data), &preserved); err != nil {
+			return errors.Wrapf(err, "failed to convert CephDeployment %s/%s to %s: failed to parse '%s' annotation",
+				cd.Namespace, cd.Name, cephlcmv1alpha1.SchemeGroupVersion, HubRawSpecsAnnotation)
+		}
+		delete(dst.Annotations, HubRawSpecsAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+	cd.Status.DeepCopyInto(&dst.Status)
+	spec, err := convertSpecToHub(cd.Spec.DeepCopy(), preserved)
+	if err != nil {
+		return errors.Wrapf(err, "failed to convert CephDeployment %s/%s to %s", cd.Namespace, cd.Name, cephlcmv1alpha1.SchemeGroupVersion)
+	}
+	dst.Spec = spec
+	return nil
+}
+
+// ConvertFrom converts the hub (v1alpha1) CephDeployment to v1beta1 version,
+// all raw specs are decoded to the structured sections
+func (cd *CephDeployment) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*cephlcmv1alpha1.CephDeployment)
+	if !ok {
+		return errors.Errorf("unexpected hub type %T for CephDeployment conversion", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&cd.ObjectMeta)
+	src.Status.DeepCopyInto(&cd.Status)
+	preserved := hubRawSpecs{}
+	spec, err := convertSpecFromHub(src.Spec.DeepCopy(), preserved)
+	if err != nil {
+		return errors.Wrapf(err, "failed to convert CephDeployment %s/%s to %s", src.Namespace, src.Name, SchemeGroupVersion)
+	}
+	cd.Spec = spec
+	if len(preserved) > 0 {
+		data, err := json.Marshal(preserved)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert CephDeployment %s/%s to %s: failed to prepare '%s' annotation",
+				src.Namespace, src.Name, SchemeGroupVersion, HubRawSpecsAnnotation)
+		}
+		if cd.Annotations == nil {
+			cd.Annotations = map[string]string{}
+		}
+		cd.Annotations[HubRawSpecsAnnotation] = string(data)
+	}
+	return nil
+}
+
+// fromHubRaw returns section spec decoded from hub raw spec. Raw spec with fields
+// unknown to v1beta1 is decoded partially. If raw spec is not encoded back as is,
+// it is preserved to be restored on conversion back to the hub
+func fromHubRaw[T any](path string, raw runtime.RawExtension, getSpec func() (T, error), preserved hubRawSpecs) (T, error) {
+	spec, err := getSpec()
+	if raw.Raw == nil {
+		return spec, err
+	}
+	if err != nil {
+		var partialSpec T
+		if json.Unmarshal(raw.Raw, &partialSpec) != nil {
+			return spec, err
+		}
+		spec = partialSpec
+	}
+	encoded, err := cephlcmv1alpha1.DecodeStructToRaw(spec)
+	if err != nil {
+		return spec, err
+	}
+	if !bytes.Equal(encoded, raw.Raw) {
+		preserved[path] = bytes.Clone(raw.Raw)
+	}
+	return spec, nil
+}
+
+// toHubRaw returns hub raw spec for section spec. Preserved hub raw spec is restored if
+// section is not changed. Changed section is rejected if preserved raw spec has fields
+// unknown to v1beta1, since such fields would be lost
+func toHubRaw[T any](path string, spec T, preserved hubRawSpecs) (runtime.RawExtension, error) {
+	raw, err := cephlcmv1alpha1.DecodeStructToRaw(spec)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+	original, present := preserved[path]
+	if !present {
+		return runtime.RawExtension{Raw: raw}, nil
+	}
+	var originalSpec T
+	if err := json.Unmarshal(original, &originalSpec); err == nil {
+		if encoded, err := cephlcmv1alpha1.DecodeStructToRaw(originalSpec); err == nil && bytes.Equal(encoded, raw) {
+			return runtime.RawExtension{Raw: bytes.Clone(original)}, nil
+		}
+	}
+	if err := cephlcmv1alpha1.DecodeRawToStruct(original, new(T)); err != nil {
+		return runtime.RawExtension{}, errors.Errorf("spec has fields unknown to %s, which would be lost on update, use %s API to change it",
+			SchemeGroupVersion.Version, cephlcmv1alpha1.SchemeGroupVersion.Version)
+	}
+	return runtime.RawExtension{Raw: raw}, nil
+}
+
+func convertSpecToHub(in *CephDeploymentSpec, preserved hubRawSpecs) (cephlcmv1alpha1.CephDeploymentSpec, error) {
+	out := cephlcmv1alpha1.CephDeploymentSpec{
+		ExtraOpts:     in.ExtraOpts,
+		Nodes:         in.Nodes,
+		RBDMirror:     in.RBDMirror,
+		RookConfig:    in.RookConfig,
+		RookNamespace: in.RookNamespace,
+		Pause:         in.Pause,
+		DriftPolicy:   in.DriftPolicy,
+		Crush:         in.Crush,
+		IngressConfig: in.IngressConfig,
+	}
+	if in.Cluster != nil {
+		raw, err := toHubRaw("cluster", *in.Cluster, preserved)
+		if err != nil {
+			return out, errors.Wrap(err, "cluster")
+		}
+		out.Cluster = &cephlcmv1alpha1.CephCluster{RawExtension: raw}
+	}
+	if in.BlockStorage != nil {
+		out.BlockStorage = &cephlcmv1alpha1.CephBlockStorage{}
+		for _, pool := range in.BlockStorage.Pools {
+			raw, err := toHubRaw("blockStorage.pools."+pool.Name, pool.PoolSpec, preserved)
+			if err != nil {
+				return out, errors.Wrapf(err, "block storage pool '%s'", pool.Name)
+			}
+			out.BlockStorage.Pools = append(out.BlockStorage.Pools, cephlcmv1alpha1.CephPool{
+				Name:             pool.Name,
+				UseAsFullName:    pool.UseAsFullName,
+				Role:             pool.Role,
+				PreserveOnDelete: pool.PreserveOnDelete,
+				StorageClassOpts: pool.StorageClassOpts,
+				CrushRule:        pool.CrushRule,
+				Quota:            pool.Quota,
+				TargetSizeRatio:  pool.TargetSizeRatio,
+				TargetSizeBytes:  pool.TargetSizeBytes,
+				PoolSpec:         raw,
+			})
+		}
+	}
+	for idx, client := range in.Clients {
+		raw, err := toHubRaw(fmt.Sprintf("clients.%d", idx), client.ClientSpec, preserved)
+		if err != nil {
+			return out, errors.Wrapf(err, "client #%d", idx)
+		}
+		out.Clients = append(out.Clients, cephlcmv1alpha1.CephClient{RawExtension: raw})
+	}
+	if in.ObjectStorage != nil {
+		objectStorage, err := convertObjectStorageToHub(in.ObjectStorage, preserved)
+		if err != nil {
+			return out, err
+		}
+		out.ObjectStorage = objectStorage
+	}
+	if in.SharedFilesystem != nil {
+		out.SharedFilesystem = &cephlcmv1alpha1.CephSharedFilesystem{}
+		for _, cephfs := range in.SharedFilesystem.Filesystems {
+			raw, err := toHubRaw("sharedFilesystem.filesystems."+cephfs.Name, cephfs.FsSpec, preserved)
+			if err != nil {
+				return out, errors.Wrapf(err, "ceph filesystem '%s'", cephfs.Name)
+			}
+			out.SharedFilesystem.Filesystems = append(out.SharedFilesystem.Filesystems, cephlcmv1alpha1.CephFilesystem{Name: cephfs.Name, FsSpec: raw})
+		}
+	}
+	if in.CSIResources != nil {
+		out.CSIResources = &cephlcmv1alpha1.CephCSI{}
+		if in.CSIResources.OperatorConfig != nil {
+			raw, err := toHubRaw("csi.operatorConfig", in.CSIResources.OperatorConfig.Spec, preserved)
+			if err != nil {
+				return out, errors.Wrap(err, "CSI operatorConfig")
+			}
+			out.CSIResources.OperatorConfig = &cephlcmv1alpha1.CephCSIOperatorConfig{
+				FullOverride: in.CSIResources.OperatorConfig.FullOverride,
+				Spec:         raw,
+			}
+		}
+		for idx, driver := range in.CSIResources.Drivers {
+			raw, err := toHubRaw(fmt.Sprintf("csi.drivers.%d", idx), driver.Spec, preserved)
+			if err != nil {
+				return out, errors.Wrapf(err, "CSI driver #%d", idx)
+			}
+			out.CSIResources.Drivers = append(out.CSIResources.Drivers, cephlcmv1alpha1.CephCSIDriver{
+				Type:         driver.Type,
+				FullOverride: driver.FullOverride,
+				Spec:         raw,
+			})
+		}
+	}
+	return out, nil
+}
+
+func convertObjectStorageToHub(in *CephObjectStorage, preserved hubRawSpecs) (*cephlcmv1alpha1.CephObjectStorage, error) {
+	out := &cephlcmv1alpha1.CephObjectStorage{}
+	for _, rgw := range in.Rgws {
+		raw, err := toHubRaw("objectStorage.rgws."+rgw.Name, rgw.Spec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rgw '%s'", rgw.Name)
+		}
+		out.Rgws = append(out.Rgws, cephlcmv1alpha1.CephObjectStore{
+			Name:             rgw.Name,
+			ServedByIngress:  rgw.ServedByIngress,
+			UsedForOpenstack: rgw.UsedForOpenstack,
+			AuxiliaryService: rgw.AuxiliaryService,
+			Spec:             raw,
+		})
+	}
+	for _, user := range in.Users {
+		raw, err := toHubRaw("objectStorage.users."+user.Name, user.Spec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "user '%s'", user.Name)
+		}
+		out.Users = append(out.Users, cephlcmv1alpha1.CephObjectStoreUser{Name: user.Name, Spec: raw})
+	}
+	for _, httpRoute := range in.GatewayHTTPRoutes {
+		raw, err := toHubRaw("objectStorage.gatewayHTTPRoutes."+httpRoute.Name, httpRoute.Spec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "http route '%s'", httpRoute.Name)
+		}
+		out.GatewayHTTPRoutes = append(out.GatewayHTTPRoutes, cephlcmv1alpha1.CephDeploymentHTTPRoute{
+			Name:            httpRoute.Name,
+			ObjectStoreName: httpRoute.ObjectStoreName,
+			Spec:            raw,
+		})
+	}
+	for _, realm := range in.Realms {
+		raw, err := toHubRaw("objectStorage.realms."+realm.Name, realm.Spec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "realm '%s'", realm.Name)
+		}
+		out.Realms = append(out.Realms, cephlcmv1alpha1.CephObjectRealm{Name: realm.Name, Spec: raw})
+	}
+	for _, zonegroup := range in.Zonegroups {
+		raw, err := toHubRaw("objectStorage.zonegroups."+zonegroup.Name, zonegroup.Spec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "zonegroup '%s'", zonegroup.Name)
+		}
+		out.Zonegroups = append(out.Zonegroups, cephlcmv1alpha1.CephObjectZonegroup{Name: zonegroup.Name, Spec: raw})
+	}
+	for _, zone := range in.Zones {
+		raw, err := toHubRaw("objectStorage.zones."+zone.Name, zone.Spec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "zone '%s'", zone.Name)
+		}
+		out.Zones = append(out.Zones, cephlcmv1alpha1.CephObjectZone{Name: zone.Name, Spec: raw})
+	}
+	return out, nil
+}
+
+func convertSpecFromHub(in *cephlcmv1alpha1.CephDeploymentSpec, preserved hubRawSpecs) (CephDeploymentSpec, error) {
+	out := CephDeploymentSpec{
+		ExtraOpts:     in.ExtraOpts,
+		Nodes:         in.Nodes,
+		RBDMirror:     in.RBDMirror,
+		RookConfig:    in.RookConfig,
+		RookNamespace: in.RookNamespace,
+		Pause:         in.Pause,
+		DriftPolicy:   in.DriftPolicy,
+		Crush:         in.Crush,
+		IngressConfig: in.IngressConfig,
+	}
+	if in.Cluster != nil {
+		clusterSpec, err := fromHubRaw("cluster", in.Cluster.RawExtension, in.Cluster.GetSpec, preserved)
+		if err != nil {
+			return out, err
+		}
+		out.Cluster = &clusterSpec
+	}
+	if in.BlockStorage != nil {
+		out.BlockStorage = &CephBlockStorage{}
+		for _, pool := range in.BlockStorage.Pools {
+			poolSpec, err := fromHubRaw("blockStorage.pools."+pool.Name, pool.PoolSpec, pool.GetSpec, preserved)
+			if err != nil {
+				return out, errors.Wrapf(err, "block storage pool '%s'", pool.Name)
+			}
+			out.BlockStorage.Pools = append(out.BlockStorage.Pools, CephPool{
+				Name:             pool.Name,
+				UseAsFullName:    pool.UseAsFullName,
+				Role:             pool.Role,
+				PreserveOnDelete: pool.PreserveOnDelete,
+				StorageClassOpts: pool.StorageClassOpts,
+				CrushRule:        pool.CrushRule,
+				Quota:            pool.Quota,
+				TargetSizeRatio:  pool.TargetSizeRatio,
+				TargetSizeBytes:  pool.TargetSizeBytes,
+				PoolSpec:         poolSpec,
+			})
+		}
+	}
+	for idx, client := range in.Clients {
+		clientSpec, err := fromHubRaw(fmt.Sprintf("clients.%d", idx), client.RawExtension, client.GetSpec, preserved)
+		if err != nil {
+			return out, errors.Wrapf(err, "client #%d", idx)
+		}
+		out.Clients = append(out.Clients, CephClient{ClientSpec: clientSpec})
+	}
+	if in.ObjectStorage != nil {
+		objectStorage, err := convertObjectStorageFromHub(in.ObjectStorage, preserved)
+		if err != nil {
+			return out, err
+		}
+		out.ObjectStorage = objectStorage
+	}
+	if in.SharedFilesystem != nil {
+		out.SharedFilesystem = &CephSharedFilesystem{}
+		for _, cephfs := range in.SharedFilesystem.Filesystems {
+			fsSpec, err := fromHubRaw("sharedFilesystem.filesystems."+cephfs.Name, cephfs.FsSpec, cephfs.GetSpec, preserved)
+			if err != nil {
+				return out, errors.Wrapf(err, "ceph filesystem '%s'", cephfs.Name)
+			}
+			out.SharedFilesystem.Filesystems = append(out.SharedFilesystem.Filesystems, CephFilesystem{Name: cephfs.Name, FsSpec: fsSpec})
+		}
+	}
+	if in.CSIResources != nil {
+		out.CSIResources = &CephCSI{}
+		if in.CSIResources.OperatorConfig != nil {
+			opCfgSpec, err := fromHubRaw("csi.operatorConfig", in.CSIResources.OperatorConfig.Spec, in.CSIResources.OperatorConfig.GetSpec, preserved)
+			if err != nil {
+				return out, err
+			}
+			out.CSIResources.OperatorConfig = &CephCSIOperatorConfig{
+				FullOverride: in.CSIResources.OperatorConfig.FullOverride,
+				Spec:         opCfgSpec,
+			}
+		}
+		for idx, driver := range in.CSIResources.Drivers {
+			driverSpec, err := fromHubRaw(fmt.Sprintf("csi.drivers.%d", idx), driver.Spec, driver.GetSpec, preserved)
+			if err != nil {
+				return out, errors.Wrapf(err, "CSI driver #%d", idx)
+			}
+			out.CSIResources.Drivers = append(out.CSIResources.Drivers, CephCSIDriver{
+				Type:         driver.Type,
+				FullOverride: driver.FullOverride,
+				Spec:         driverSpec,
+			})
+		}
+	}
+	return out, nil
+}
+
+func convertObjectStorageFromHub(in *cephlcmv1alpha1.CephObjectStorage, preserved hubRawSpecs) (*CephObjectStorage, error) {
+	out := &CephObjectStorage{}
+	for _, rgw := range in.Rgws {
+		rgwSpec, err := fromHubRaw("objectStorage.rgws."+rgw.Name, rgw.Spec, rgw.GetSpec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rgw '%s'", rgw.Name)
+		}
+		out.Rgws = append(out.Rgws, CephObjectStore{
+			Name:             rgw.Name,
+			ServedByIngress:  rgw.ServedByIngress,
+			UsedForOpenstack: rgw.UsedForOpenstack,
+			AuxiliaryService: rgw.AuxiliaryService,
+			Spec:             rgwSpec,
+		})
+	}
+	for _, user := range in.Users {
+		userSpec, err := fromHubRaw("objectStorage.users."+user.Name, user.Spec, user.GetSpec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "user '%s'", user.Name)
+		}
+		out.Users = append(out.Users, CephObjectStoreUser{Name: user.Name, Spec: userSpec})
+	}
+	for _, httpRoute := range in.GatewayHTTPRoutes {
+		httpRouteSpec, err := fromHubRaw("objectStorage.gatewayHTTPRoutes."+httpRoute.Name, httpRoute.Spec, httpRoute.GetSpec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "http route '%s'", httpRoute.Name)
+		}
+		out.GatewayHTTPRoutes = append(out.GatewayHTTPRoutes, CephDeploymentHTTPRoute{
+			Name:            httpRoute.Name,
+			ObjectStoreName: httpRoute.ObjectStoreName,
+			Spec:            httpRouteSpec,
+		})
+	}
+	for _, realm := range in.Realms {
+		realmSpec, err := fromHubRaw("objectStorage.realms."+realm.Name, realm.Spec, realm.GetSpec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "realm '%s'", realm.Name)
+		}
+		out.Realms = append(out.Realms, CephObjectRealm{Name: realm.Name, Spec: realmSpec})
+	}
+	for _, zonegroup := range in.Zonegroups {
+		zonegroupSpec, err := fromHubRaw("objectStorage.zonegroups."+zonegroup.Name, zonegroup.Spec, zonegroup.GetSpec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "zonegroup '%s'", zonegroup.Name)
+		}
+		out.Zonegroups = append(out.Zonegroups, CephObjectZonegroup{Name: zonegroup.Name, Spec: zonegroupSpec})
+	}
+	for _, zone := range in.Zones {
+		zoneSpec, err := fromHubRaw("objectStorage.zones."+zone.Name, zone.Spec, zone.GetSpec, preserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "zone '%s'", zone.Name)
+		}
+		out.Zones = append(out.Zones, CephObjectZone{Name: zone.Name, Spec: zoneSpec})
+	}
+	return out, nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestCephDeploymentConversion(t *testing.T) {
+	tests := []struct {
+		name              string
+		cephDpl           *cephlcmv1alpha1.CephDeployment
+		exactRoundTrip    bool
+		changeBeta        func(*CephDeployment)
+		expectedPreserved []string
+		expectedError     string
+	}{
+		{
+			name:           "mosk cephdeployment round trip",
+			cephDpl:        unitinputs.CephDeployMosk.DeepCopy(),
+			exactRoundTrip: true,
+		},
+		{
+			name:           "cephdeployment with csi round trip",
+			cephDpl:        unitinputs.CephDeployWithCSI.DeepCopy(),
+			exactRoundTrip: true,
+		},
+		{
+			name:           "cephdeployment with http routes round trip",
+			cephDpl:        unitinputs.CephDeployMoskWithHTTPRoute.DeepCopy(),
+			exactRoundTrip: true,
+		},
+		{
+			name: "cephdeployment with pause round trip",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.CephDeployMosk.DeepCopy()
+				cd.Spec.Pause = &cephlcmv1alpha1.CephDeploymentPause{
+					Reason:   "incident",
+					Sections: []cephlcmv1alpha1.CephDeploymentSection{cephlcmv1alpha1.ConditionTypeObjectStorage},
+				}
+				return cd
+			}(),
+			exactRoundTrip: true,
+		},
+		{
+			name:              "cephdeployment with multisite round trip",
+			cephDpl:           unitinputs.CephDeployMultisiteRgw.DeepCopy(),
+			exactRoundTrip:    true,
+			expectedPreserved: []string{"objectStorage.realms.realm1", "objectStorage.zonegroups.zonegroup1"},
+		},
+		{
+			name: "cephdeployment with unknown pool spec field round trip",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.CephDeployMosk.DeepCopy()
+				cd.Spec.BlockStorage.Pools[0].PoolSpec = runtime.RawExtension{Raw: []byte(`{"deviceClass":"hdd","unknownField":true}`)}
+				return cd
+			}(),
+			exactRoundTrip:    true,
+			expectedPreserved: []string{"blockStorage.pools.pool1"},
+		},
+		{
+			name: "cephdeployment with unknown pool spec field, changed pool is rejected",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.CephDeployMosk.DeepCopy()
+				cd.Spec.BlockStorage.Pools[0].PoolSpec = runtime.RawExtension{Raw: []byte(`{"deviceClass":"hdd","unknownField":true}`)}
+				return cd
+			}(),
+			changeBeta: func(cd *CephDeployment) {
+				cd.Spec.BlockStorage.Pools[0].PoolSpec.DeviceClass = "ssd"
+			},
+			expectedPreserved: []string{"blockStorage.pools.pool1"},
+			expectedError:     "failed to convert CephDeployment lcm-namespace/cephcluster to lcm.mirantis.com/v1alpha1: block storage pool 'pool1': spec has fields unknown to v1beta1, which would be lost on update, use v1alpha1 API to change it",
+		},
+		{
+			name: "cephdeployment with wrong pool spec field type",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.CephDeployMosk.DeepCopy()
+				cd.Spec.BlockStorage.Pools[0].PoolSpec = runtime.RawExtension{Raw: []byte(`{"deviceClass":true}`)}
+				return cd
+			}(),
+			expectedError: "failed to convert CephDeployment lcm-namespace/cephcluster to lcm.mirantis.com/v1beta1: block storage pool 'pool1': spec: pool spec has failed to decode to Rook PoolSpec struct",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			beta := &CephDeployment{}
+			err := beta.ConvertFrom(test.cephDpl)
+			if test.expectedError != "" && test.changeBeta == nil {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+				return
+			}
+			assert.Nil(t, err)
+			preserved := hubRawSpecs{}
+			if data, present := beta.Annotations[HubRawSpecsAnnotation]; present {
+				assert.Nil(t, json.Unmarshal([]byte(data), &preserved))
+			}
+			assert.ElementsMatch(t, test.expectedPreserved, slices.Collect(maps.Keys(preserved)))
+			betaMeta := beta.ObjectMeta.DeepCopy()
+			delete(betaMeta.Annotations, HubRawSpecsAnnotation)
+			if len(betaMeta.Annotations) == 0 {
+				betaMeta.Annotations = nil
+			}
+			assert.Equal(t, test.cephDpl.ObjectMeta, *betaMeta)
+			assert.Equal(t, test.cephDpl.Status, beta.Status)
+
+			if test.changeBeta != nil {
+				test.changeBeta(beta)
+			}
+			alpha := &cephlcmv1alpha1.CephDeployment{}
+			err = beta.ConvertTo(alpha)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			if test.exactRoundTrip {
+				assert.Equal(t, test.cephDpl, alpha)
+			}
+
+			betaAgain := &CephDeployment{}
+			err = betaAgain.ConvertFrom(alpha)
+			assert.Nil(t, err)
+			assert.Equal(t, beta, betaAgain)
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package v1beta1
+
+import (
+	cephcsi "github.com/ceph/ceph-csi-operator/api/v1"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Validation",type=string,JSONPath=`.status.validation.result`,description="Validation status"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="Deployment phase"
+// +kubebuilder:printcolumn:name="Last run",type=string,JSONPath=`.status.lastRun`,description="Last reconcile run"
+// +kubebuilder:printcolumn:name="Cluster version",type=string,JSONPath=`.status.clusterVersion`,description="Current Ceph cluster version"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.message`,description="Cluster status message"
+// +kubebuilder:resource:path=cephdeployments,scope=Namespaced
+// +kubebuilder:resource:shortName={cephdpl}
+// +kubebuilder:subresource:status
+
+// CephDeployment is the Schema for the cephdeployments API which contains
+// a valid Ceph configuration which is handled by Pelagia controller and
+// produce all related objects and daemons in Rook (K8S based Ceph).
+// In comparison with v1alpha1, all Rook, CephCSI and Gateway API sections
+// are structured and validated by CRD schema, version is served only
+// when CephDeployment webhook is enabled, since it requires conversion webhook
+type CephDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired configuration of resulting Ceph Cluster
+	// and all corresponding resources
+	Spec CephDeploymentSpec `json:"spec"`
+	// Status represents current status of handling Ceph Cluster configuration
+	// +optional
+	Status cephlcmv1alpha1.CephDeploymentStatus `json:"status,omitempty"`
+}
+
+// CephDeploymentSpec defines the desired configuration of resulting Ceph Cluster
+// and all corresponding resources
+type CephDeploymentSpec struct {
+	// Cluster stands for main Ceph cluster configuration
+	// Follow https://rook.io/docs/rook/v1.19/CRDs/Cluster/ceph-cluster-crd/
+	// for available options
+	Cluster *cephv1.ClusterSpec `json:"cluster"`
+	// BlockStorage stands for configuration Ceph block storage,
+	// such as rbd pool and rbd mirroring
+	// +optional
+	BlockStorage *CephBlockStorage `json:"blockStorage,omitempty"`
+	// Clients is a list of Ceph Clients used for Ceph Cluster connection by
+	// consumer services
+	// +optional
+	Clients []CephClient `json:"clients,omitempty"`
+	// ExtraOpts contains some extra options for managing Ceph cluster, like devices labels
+	// +optional
+	ExtraOpts *cephlcmv1alpha1.CephDeploymentExtraOpts `json:"extraOpts,omitempty"`
+	// Nodes contains full cluster nodes configuration to use as Ceph Nodes
+	Nodes []cephlcmv1alpha1.CephDeploymentNode `json:"nodes"`
+	// ObjectStorage contains full RadosGW Object Storage configurations: RGW itself
+	// and RGW multisite feature
+	// +optional
+	ObjectStorage *CephObjectStorage `json:"objectStorage,omitempty"`
+	// RBDMirror allows to configure RBD mirroring between two Ceph Clusters
+	// +optional
+	RBDMirror *cephlcmv1alpha1.CephRBDMirrorSpec `json:"rbdMirror,omitempty"`
+	// RookConfig is a key-value mapping which contains ceph config keys with a specified values
+	// +optional
+	RookConfig map[string]string `json:"rookConfig,omitempty"`
+	// RookNamespace is a namespace with Rook operator and Ceph cluster daemons for current
+	// CephDeployment. If not specified, rook namespace from Pelagia config is used.
+	// Each CephDeployment in namespace must use own rook namespace.
+	// +optional
+	RookNamespace string `json:"rookNamespace,omitempty"`
+	// SharedFilesystem enables such system as CephFS
+	// +optional
+	SharedFilesystem *CephSharedFilesystem `json:"sharedFilesystem,omitempty"`
+	// CSI provides an ability to specify CephCSI Drivers and OperatorConfig objects
+	// +optional
+	CSIResources *CephCSI `json:"csi,omitempty"`
+	// Pause allows to pause configuration apply for the whole CephDeployment or
+	// for specified sections only, for example, to edit Rook resources manually
+	// +optional
+	Pause *cephlcmv1alpha1.CephDeploymentPause `json:"pause,omitempty"`
+	// DriftPolicy specifies how to handle changes made in Rook objects managed by
+	// CephDeployment outside of CephDeployment. If not specified, changes are reverted.
+	// +optional
+	DriftPolicy *cephlcmv1alpha1.CephDeploymentDriftPolicy `json:"driftPolicy,omitempty"`
+	// Crush describes additional CRUSH roots and named CRUSH rules, which
+	// could be referenced by pools
+	// +optional
+	Crush *cephlcmv1alpha1.CephDeploymentCrush `json:"crush,omitempty"`
+
+	// Deprecated parameter, objectStorage.gatewayHTTPRoutes should be used instead.
+	// Ingress became deprecated and going to be replaced by Gateway API, for more information
+	// follow https://gateway-api.sigs.k8s.io/guides/getting-started/migrating-from-ingress/
+	// +optional
+	IngressConfig *cephlcmv1alpha1.CephDeploymentIngressConfig `json:"ingressConfig,omitempty"`
+}
+
+type CephBlockStorage struct {
+	// Pools is a list of Ceph RBD Pools configurations
+	// +optional
+	Pools []CephPool `json:"pools,omitempty"`
+}
+
+// CephPool stands for specified Ceph RBD Pool configuration
+type CephPool struct {
+	// Name represents Ceph RBD pool name
+	Name string `json:"name"`
+	// UseAsFullName uses Name as a resulting pool name instead of "<Name>-<DeviceClass>"
+	// +optional
+	UseAsFullName bool `json:"useAsFullName,omitempty"`
+	// Role represents pool role. The following values are reserved for
+	// MOS managed clusters: vms, images, backup, volumes
+	// +nullable
+	Role string `json:"role,omitempty"`
+	// PreserveOnDelete prevents related CephBlockPool object removal
+	// +optional
+	PreserveOnDelete bool `json:"preserveOnDelete,omitempty"`
+	// StorageClassOpts represents options to set on related storage class
+	// +optional
+	StorageClassOpts cephlcmv1alpha1.CephStorageClassSpec `json:"storageClassOpts,omitempty"`
+	// CrushRule is a name of CRUSH rule from crush section to use for pool,
+	// pool spec crushRoot and failureDomain are taken from the rule
+	// +optional
+	CrushRule string `json:"crushRule,omitempty"`
+	// Quota represents pool quotas, applied to pool spec quotas
+	// +optional
+	Quota *cephlcmv1alpha1.CephPoolQuota `json:"quota,omitempty"`
+	// TargetSizeRatio is an expected pool share of device class capacity,
+	// used by PG autoscaler, for example, "0.3". Mutually exclusive with TargetSizeBytes
+	// +optional
+	TargetSizeRatio string `json:"targetSizeRatio,omitempty"`
+	// TargetSizeBytes is an expected pool size used by PG autoscaler,
+	// for example, "500Gi". Mutually exclusive with TargetSizeRatio
+	// +optional
+	TargetSizeBytes string `json:"targetSizeBytes,omitempty"`
+	// PoolSpec represents pool specification
+	// Follow https://rook.io/docs/rook/v1.19/CRDs/Block-Storage/ceph-block-pool-crd
+	// for available options
+	PoolSpec cephv1.PoolSpec `json:"spec"`
+}
+
+// CephClient represents client specification
+// Follow https://rook.io/docs/rook/v1.19/CRDs/ceph-client-crd/
+// for available options
+type CephClient struct {
+	cephv1.ClientSpec `json:",inline"`
+}
+
+// CephObjectStorage contains full RadosGW Object Storage configurations:
+// RGW itself and RGW multisite feature
+type CephObjectStorage struct {
+	// Rgws is a list of Ceph object stores, representing Ceph RadosGW
+	// with its configuration
+	// +optional
+	Rgws []CephObjectStore `json:"objectStores,omitempty"`
+	// Users is a list of user to create for object storage with radosgw-admin
+	// +optional
+	Users []CephObjectStoreUser `json:"users,omitempty"`
+	// GatewayHTTPRoutes stands for adding Gateway API HTTPRoutes for Ceph RGW
+	// public access
+	// +optional
+	GatewayHTTPRoutes []CephDeploymentHTTPRoute `json:"gatewayHTTPRoutes,omitempty"`
+	// Realms is a list of Ceph Object storage multisite realms.
+	// Currently is possible to specify only 1 realm.
+	// +kubebuilder:validation:MaxItems:=1
+	// +optional
+	Realms []CephObjectRealm `json:"realms,omitempty"`
+	// Zonegroups is a list of Ceph Object storage multisite zonegroups.
+	// Currently is possible to specify only 1 zonegroup.
+	// +kubebuilder:validation:MaxItems:=1
+	// +optional
+	Zonegroups []CephObjectZonegroup `json:"zonegroups,omitempty"`
+	// Zones is a list of Ceph Object storage multisite zones.
+	// Currently is possible to specify only 1 zone.
+	// +kubebuilder:validation:MaxItems:=1
+	// +optional
+	Zones []CephObjectZone `json:"zones,omitempty"`
+}
+
+// CephObjectStore stands for configuration of object store.
+type CephObjectStore struct {
+	Name string `json:"name"`
+	// Deprecated option, since Ingress is depreated in favor of Gateway API.
+	// ObjectStore has ingress frontend.
+	// +optional
+	ServedByIngress bool `json:"servedByIngress,omitempty"`
+	// ObjectStore will be used for Openstack.
+	// +optional
+	UsedForOpenstack bool `json:"usedForOpenstack,omitempty"`
+	// AuxiliaryService stands for marking object store as non-client serving.
+	// Storage class and external service will not be created.
+	// +optional
+	AuxiliaryService bool `json:"auxiliaryService,omitempty"`
+	// Spec represents CephObjectStore configuration
+	// Follow https://rook.io/docs/rook/v1.19/CRDs/Object-Storage/ceph-object-store-crd/
+	// for available options
+	Spec cephv1.ObjectStoreSpec `json:"spec"`
+}
+
+// CephObjectStoreUser stands for configuration of object store users.
+type CephObjectStoreUser struct {
+	Name string `json:"name"`
+	// Spec represents CephObjectStoreUser configuration
+	// https://rook.io/docs/rook/v1.19/CRDs/Object-Storage/ceph-object-store-user-crd/
+	// for available options
+	Spec cephv1.ObjectStoreUserSpec `json:"spec"`
+}
+
+// CephDeploymentHTTPRoute represents Gateway API HTTPRoute specification
+type CephDeploymentHTTPRoute struct {
+	// Name of httproute
+	Name string `json:"name"`
+	// Name of related object store object, which will be routed by httproute
+	ObjectStoreName string `json:"objectStoreName"`
+	// Spec represents HTTPRoute specification
+	// Follow https://gateway-api.sigs.k8s.io/api-types/httproute/
+	// for details
+	Spec gatewayapi.HTTPRouteSpec `json:"spec"`
+}
+
+// CephObjectRealm stands for object store multisite realm creation and configuration.
+type CephObjectRealm struct {
+	// Name of realm
+	Name string `json:"name"`
+	// Spec stands for realm configuration.
+	// see https://rook.io/docs/rook/v1.19/CRDs/Object-Storage/ceph-object-realm-crd/
+	// for available options
+	// +optional
+	Spec cephv1.ObjectRealmSpec `json:"spec,omitempty"`
+}
+
+// CephObjectZonegroup stands for object store multisite zonegroup creation and configuration.
+type CephObjectZonegroup struct {
+	// Name of zonegroup
+	Name string `json:"name"`
+	// Spec stands for zonegroup configuration.
+	// https://rook.io/docs/rook/v1.19/CRDs/Object-Storage/ceph-object-zonegroup-crd/
+	// for available options
+	Spec cephv1.ObjectZoneGroupSpec `json:"spec"`
+}
+
+// CephObjectZone stands for object store multisite zone creation and configuration.
+type CephObjectZone struct {
+	// Name of zone
+	Name string `json:"name"`
+	// Spec stands for zone configuration.
+	// https://rook.io/docs/rook/v1.19/CRDs/Object-Storage/ceph-object-zone-crd/
+	// for available options
+	Spec cephv1.ObjectZoneSpec `json:"spec"`
+}
+
+type CephSharedFilesystem struct {
+	// CephFilesystems to create and configure
+	// +optional
+	Filesystems []CephFilesystem `json:"cephFilesystems,omitempty"`
+}
+
+// CephFilesystem stands for CephFS creation and configuration.
+type CephFilesystem struct {
+	// CephFilesystem name
+	Name string `json:"name"`
+	// FsSpec represents CephFilesystem configuration
+	// https://rook.io/docs/rook/v1.19/CRDs/Shared-Filesystem/ceph-filesystem-crd/
+	// for available options
+	FsSpec cephv1.FilesystemSpec `json:"spec"`
+}
+
+// CSI provides an ability to specify CephCSI Drivers and OperatorConfig objects
+type CephCSI struct {
+	// OperatorConfig provides CephCSI OperatorConfig spec description
+	// +optional
+	OperatorConfig *CephCSIOperatorConfig `json:"operatorConfig,omitempty"`
+	// Drivers provides CephCSI Drivers spec description
+	// +optional
+	Drivers []CephCSIDriver `json:"drivers,omitempty"`
+}
+
+type CephCSIOperatorConfig struct {
+	// FullOverride fully override default or manually created OperatorConfig
+	// if present. Otherwise, provided spec will be merged with existed.
+	// +optional
+	FullOverride bool `json:"fullOverride,omitempty"`
+	// Spec represents OperatorConfig configuration
+	// https://github.com/ceph/ceph-csi-operator/blob/v1.0.4/docs/design/operator.md#operatorconfig-crd
+	Spec cephcsi.OperatorConfigSpec `json:"spec"`
+}
+
+type CephCSIDriver struct {
+	// Driver type, could be one of "nvmeof,rbd,cephfs,nfs"
+	// +kubebuilder:validation:Enum=rbd;cephfs;nfs;nvmeof
+	Type cephlcmv1alpha1.CSIDriverType `json:"type"`
+	// FullOverride fully override default or manually created Driver
+	// if present. Otherwise, provided spec will be merged with existed.
+	// +optional
+	FullOverride bool `json:"fullOverride,omitempty"`
+	// Spec represents Driver configuration
+	// https://github.com/ceph/ceph-csi-operator/blob/v1.0.4/docs/design/operator.md#driver-crd
+	Spec cephcsi.DriverSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CephDeploymentList contains a list of CephDeployment
+type CephDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items contains a list of CephDeployment objects
+	Items []CephDeployment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CephDeployment{}, &CephDeploymentList{})
+}
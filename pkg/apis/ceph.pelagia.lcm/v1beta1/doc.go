@@ -0,0 +1,4 @@
+// Package v1beta1 contains API Schema definitions for the cephdeployment v1beta1 API group
+// +k8s:deepcopy-gen=package,register
+// +groupName=lcm.mirantis.com
+package v1beta1
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lcmcommon
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+// CephConfigSectionDelimiter separates section and option name in runtime config
+// and in ignored options, for example 'osd|osd_max_backfills'
+const CephConfigSectionDelimiter = "|"
+
+// cephConfigOptionsManagedByRook are options, which Rook or Ceph daemons set in Ceph
+// config database themselves, so they are not considered as set outside of CephDeployment
+var cephConfigOptionsManagedByRook = []string{
+	"mon_allow_pool_delete",
+	"mon_allow_pool_size_one",
+	"mon_cluster_log_file",
+	"mon_cluster_log_to_file",
+	"mon_warn_on_pool_no_redundancy",
+	"auth_allow_insecure_global_id_reclaim",
+	"osd_scrub_auto_repair",
+	"log_to_file",
+	"rbd_default_features",
+	"public_network",
+	"cluster_network",
+	"mds_join_fs",
+	"rgw_zone",
+	"rgw_zonegroup",
+	"rgw_realm",
+	"rgw_enable_apis",
+	"rgw_enable_usage_log",
+	"rgw_run_sync_thread",
+	"rgw_log_nonexistent_bucket",
+	"rgw_log_object_name_utc",
+	"container_image",
+}
+
+// cephConfigOptionPrefixesManagedByRook are prefixes of options, which are set by
+// Rook, Ceph Manager modules or Ceph daemons in Ceph config database
+var cephConfigOptionPrefixesManagedByRook = []string{
+	"mgr/",
+	"ms_",
+	"osd_mclock_max_capacity_iops_",
+}
+
+// ParseCephConfigOverride parses Ceph config in ini format, as it is stored in
+// rook-config-override ConfigMap, to the map of sections options
+func ParseCephConfigOverride(config string) map[string]map[string]string {
+	sections := map[string]map[string]string{}
+	section := ""
+	for _, line := range strings.Split(config, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found || section == "" {
+			continue
+		}
+		if _, present := sections[section]; !present {
+			sections[section] = map[string]string{}
+		}
+		sections[section][strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return sections
+}
+
+// ParseCephRuntimeConfig parses runtime options, as they are stored in rook-config-override
+// ConfigMap, to the map of '<section>|<option>' keys and values
+func ParseCephRuntimeConfig(runtime string) map[string]string {
+	options := map[string]string{}
+	for _, line := range strings.Split(runtime, "\n") {
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		options[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return options
+}
+
+// AddClusterSpecCephConfig adds options, which Rook sets in Ceph config database from CephCluster
+// spec 'cephConfig' and 'cephConfigFromSecret' sections, to the map of sections options. Options
+// from secrets are added with masked value, so they are not compared by value
+func AddClusterSpecCephConfig(config map[string]map[string]string, clusterSpec cephv1.ClusterSpec) {
+	addOption := func(section, name, value string) {
+		if _, present := config[section]; !present {
+			config[section] = map[string]string{}
+		}
+		config[section][name] = value
+	}
+	for section, options := range clusterSpec.CephConfig {
+		for name, value := range options {
+			addOption(section, name, value)
+		}
+	}
+	for section, options := range clusterSpec.CephConfigFromSecret {
+		for name := range options {
+			addOption(section, name, "*")
+		}
+	}
+}
+
+// IsCephConfigOptionListed checks whether option is present in the list of options,
+// specified either by option name or in '<section>|<option>' format
+func IsCephConfigOptionListed(section, name string, options []string) bool {
+	return Contains(options, name) || Contains(options, fmt.Sprintf("%s%s%s", section, CephConfigSectionDelimiter, name))
+}
+
+func isCephConfigOptionIgnored(section, name string, ignoredOptions []string) bool {
+	if Contains(cephConfigOptionsManagedByRook, name) {
+		return true
+	}
+	for _, prefix := range cephConfigOptionPrefixesManagedByRook {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return IsCephConfigOptionListed(section, name, ignoredOptions)
+}
+
+func maskCephConfigValue(name, value string) string {
+	if value != "" && (strings.Contains(name, "password") || strings.Contains(name, "secret")) {
+		return "*"
+	}
+	return value
+}
+
+// GetCephConfigDrift compares Ceph config database options with expected config file options and
+// runtime options. Returns per section options, which are set outside of CephDeployment, options
+// with value different from expected and expected runtime options, not present in config database.
+// Options with masked expected value are not compared by value.
+func GetCephConfigDrift(config map[string]map[string]string, runtime map[string]string, configDump []CephConfigOption, ignoredOptions []string) map[string]cephlcmv1alpha1.CephConfigSectionDrift {
+	drift := map[string]cephlcmv1alpha1.CephConfigSectionDrift{}
+	present := map[string]bool{}
+	for _, opt := range configDump {
+		section := opt.Section
+		if opt.Mask != "" {
+			section = fmt.Sprintf("%s/%s", opt.Section, opt.Mask)
+		}
+		fullName := fmt.Sprintf("%s%s%s", section, CephConfigSectionDelimiter, opt.Name)
+		present[fullName] = true
+		expected, isRuntime := runtime[fullName]
+		if !isRuntime {
+			expected, isRuntime = config[section][opt.Name]
+			if !isRuntime {
+				if !isCephConfigOptionIgnored(section, opt.Name, ignoredOptions) {
+					sectionDrift := drift[section]
+					sectionDrift.Unexpected = append(sectionDrift.Unexpected, cephlcmv1alpha1.CephConfigOptionDrift{
+						Name: opt.Name, Actual: maskCephConfigValue(opt.Name, opt.Value),
+					})
+					drift[section] = sectionDrift
+				}
+				continue
+			}
+		}
+		if expected != "*" && expected != opt.Value {
+			sectionDrift := drift[section]
+			sectionDrift.Overridden = append(sectionDrift.Overridden, cephlcmv1alpha1.CephConfigOptionDrift{
+				Name: opt.Name, Expected: maskCephConfigValue(opt.Name, expected), Actual: maskCephConfigValue(opt.Name, opt.Value),
+			})
+			drift[section] = sectionDrift
+		}
+	}
+	for fullName, expected := range runtime {
+		if present[fullName] || expected == "" {
+			continue
+		}
+		section, name, _ := strings.Cut(fullName, CephConfigSectionDelimiter)
+		sectionDrift := drift[section]
+		sectionDrift.Missing = append(sectionDrift.Missing, cephlcmv1alpha1.CephConfigOptionDrift{
+			Name: name, Expected: maskCephConfigValue(name, expected),
+		})
+		drift[section] = sectionDrift
+	}
+	for section, sectionDrift := range drift {
+		for _, options := range [][]cephlcmv1alpha1.CephConfigOptionDrift{sectionDrift.Unexpected, sectionDrift.Overridden, sectionDrift.Missing} {
+			sort.Slice(options, func(i, j int) bool { return options[i].Name < options[j].Name })
+		}
+		drift[section] = sectionDrift
+	}
+	return drift
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lcmcommon
+
+import (
+	"testing"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+func TestParseCephConfigOverride(t *testing.T) {
+	config := `[global]
+cluster network = 10.0.0.0/24
+mon_max_pg_per_osd = 300
+# commented = value
+
+[osd]
+osd_max_backfills = 64
+`
+	expected := map[string]map[string]string{
+		"global": {
+			"cluster network":    "10.0.0.0/24",
+			"mon_max_pg_per_osd": "300",
+		},
+		"osd": {
+			"osd_max_backfills": "64",
+		},
+	}
+	assert.Equal(t, expected, ParseCephConfigOverride(config))
+	assert.Equal(t, map[string]map[string]string{}, ParseCephConfigOverride(""))
+}
+
+func TestParseCephRuntimeConfig(t *testing.T) {
+	runtime := "osd|bdev_async_discard_threads = 1\nclient.rgw.rgw.store.a|rgw_keystone_admin_password = *\n"
+	expected := map[string]string{
+		"osd|bdev_async_discard_threads":                     "1",
+		"client.rgw.rgw.store.a|rgw_keystone_admin_password": "*",
+	}
+	assert.Equal(t, expected, ParseCephRuntimeConfig(runtime))
+	assert.Equal(t, map[string]string{}, ParseCephRuntimeConfig(""))
+}
+
+func TestAddClusterSpecCephConfig(t *testing.T) {
+	config := map[string]map[string]string{
+		"global": {"mon_max_pg_per_osd": "300"},
+	}
+	clusterSpec := cephv1.ClusterSpec{
+		CephConfig: map[string]map[string]string{
+			"global": {"osd_pool_default_size": "3"},
+			"osd.0":  {"osd_max_backfills": "2"},
+		},
+		CephConfigFromSecret: map[string]map[string]v1.SecretKeySelector{
+			"client.rgw.rgw.store.a": {
+				"rgw_keystone_admin_password": v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "keystone"},
+					Key:                  "password",
+				},
+			},
+		},
+	}
+	expected := map[string]map[string]string{
+		"global": {
+			"mon_max_pg_per_osd":    "300",
+			"osd_pool_default_size": "3",
+		},
+		"osd.0":                  {"osd_max_backfills": "2"},
+		"client.rgw.rgw.store.a": {"rgw_keystone_admin_password": "*"},
+	}
+	AddClusterSpecCephConfig(config, clusterSpec)
+	assert.Equal(t, expected, config)
+}
+
+func TestGetCephConfigDrift(t *testing.T) {
+	config := map[string]map[string]string{
+		"global": {"mon_max_pg_per_osd": "300"},
+		"osd":    {"osd_max_backfills": "64"},
+	}
+	runtime := map[string]string{
+		"osd|bdev_enable_discard":                            "true",
+		"osd|bdev_async_discard_threads":                     "1",
+		"client.rgw.rgw.store.a|rgw_keystone_admin_password": "*",
+	}
+	tests := []struct {
+		name           string
+		configDump     []CephConfigOption
+		ignoredOptions []string
+		expected       map[string]cephlcmv1alpha1.CephConfigSectionDrift
+	}{
+		{
+			name: "no drift",
+			configDump: []CephConfigOption{
+				{Section: "global", Name: "mon_max_pg_per_osd", Value: "300"},
+				{Section: "global", Name: "mon_allow_pool_delete", Value: "true"},
+				{Section: "global", Name: "ms_cluster_mode", Value: "secure"},
+				{Section: "mgr", Name: "mgr/dashboard/ssl", Value: "false"},
+				{Section: "osd", Name: "bdev_enable_discard", Value: "true"},
+				{Section: "osd", Name: "bdev_async_discard_threads", Value: "1"},
+				{Section: "client.rgw.rgw.store.a", Name: "rgw_keystone_admin_password", Value: "secret-value"},
+			},
+			expected: map[string]cephlcmv1alpha1.CephConfigSectionDrift{},
+		},
+		{
+			name: "drift found",
+			configDump: []CephConfigOption{
+				{Section: "global", Name: "mon_max_pg_per_osd", Value: "500"},
+				{Section: "global", Name: "osd_pool_default_size", Value: "2"},
+				{Section: "osd", Name: "osd_memory_target", Value: "4294967296", Mask: "host:node-1"},
+				{Section: "osd", Name: "osd_recovery_sleep", Value: "0.1"},
+				{Section: "osd", Name: "bdev_enable_discard", Value: "false"},
+				{Section: "client.rgw.rgw.store.a", Name: "rgw_keystone_admin_password", Value: "secret-value"},
+				{Section: "client.rgw.rgw.store.a", Name: "rgw_keystone_barbican_password", Value: "another-secret"},
+			},
+			expected: map[string]cephlcmv1alpha1.CephConfigSectionDrift{
+				"global": {
+					Unexpected: []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "osd_pool_default_size", Actual: "2"}},
+					Overridden: []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "mon_max_pg_per_osd", Expected: "300", Actual: "500"}},
+				},
+				"osd": {
+					Unexpected: []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "osd_recovery_sleep", Actual: "0.1"}},
+					Overridden: []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_enable_discard", Expected: "true", Actual: "false"}},
+					Missing:    []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_async_discard_threads", Expected: "1"}},
+				},
+				"osd/host:node-1": {
+					Unexpected: []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "osd_memory_target", Actual: "4294967296"}},
+				},
+				"client.rgw.rgw.store.a": {
+					Unexpected: []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "rgw_keystone_barbican_password", Actual: "*"}},
+				},
+			},
+		},
+		{
+			name: "drift found, some options are ignored",
+			configDump: []CephConfigOption{
+				{Section: "global", Name: "mon_max_pg_per_osd", Value: "300"},
+				{Section: "global", Name: "osd_pool_default_size", Value: "2"},
+				{Section: "osd", Name: "osd_memory_target", Value: "4294967296", Mask: "host:node-1"},
+				{Section: "osd", Name: "osd_recovery_sleep", Value: "0.1"},
+				{Section: "osd", Name: "bdev_enable_discard", Value: "true"},
+				{Section: "osd", Name: "bdev_async_discard_threads", Value: "1"},
+				{Section: "client.rgw.rgw.store.a", Name: "rgw_keystone_admin_password", Value: "secret-value"},
+			},
+			ignoredOptions: []string{"osd_pool_default_size", "osd/host:node-1|osd_memory_target"},
+			expected: map[string]cephlcmv1alpha1.CephConfigSectionDrift{
+				"osd": {
+					Unexpected: []cephlcmv1alpha1.CephConfigOptionDrift{{Name: "osd_recovery_sleep", Actual: "0.1"}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := GetCephConfigDrift(config, runtime, test.configDump, test.ignoredOptions)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -56,6 +56,12 @@ type MgrModuleLs struct {
 	Enabled  []string `json:"enabled_modules"`
 }
 
+type MgrStat struct {
+	Available  bool   `json:"available"`
+	ActiveName string `json:"active_name"`
+	NumStandby int    `json:"num_standby"`
+}
+
 type ZoneGroupInfo struct {
 	Hostnames []string `json:"hostnames"`
 }
@@ -82,10 +88,44 @@ type PoolStats struct {
 	PercentUsed float64 `json:"percent_used"`
 }
 
+type PgStats struct {
+	PgID           string `json:"pgid"`
+	State          string `json:"state"`
+	Acting         []int  `json:"acting"`
+	LastActive     string `json:"last_active"`
+	LastClean      string `json:"last_clean"`
+	LastUndegraded string `json:"last_undegraded"`
+	LastFullsized  string `json:"last_fullsized"`
+	LastUnstale    string `json:"last_unstale"`
+}
+
+type PgStateCount struct {
+	StateName string `json:"state_name"`
+	Count     int    `json:"count"`
+}
+
+type PgStuckList struct {
+	StuckPgStats []PgStats `json:"stuck_pg_stats"`
+}
+
+type OsdFullRatios struct {
+	FullRatio     float64 `json:"full_ratio"`
+	NearFullRatio float64 `json:"nearfull_ratio"`
+}
+
 type CephVersions struct {
 	Overall map[string]int `json:"overall"`
 }
 
+type CephQuorumStatus struct {
+	QuorumNames []string `json:"quorum_names"`
+	MonMap      struct {
+		Mons []struct {
+			Name string `json:"name"`
+		} `json:"mons"`
+	} `json:"monmap"`
+}
+
 type CephStatus struct {
 	QuorumNames []string `json:"quorum_names"`
 	OsdMap      struct {
@@ -96,6 +136,10 @@ type CephStatus struct {
 	MonMap struct {
 		NumMons int `json:"num_mons"`
 	} `json:"monmap"`
+	PgMap struct {
+		PgsByState []PgStateCount `json:"pgs_by_state"`
+		NumPgs     int            `json:"num_pgs"`
+	} `json:"pgmap"`
 	MgrMap struct {
 		Available bool `json:"available"`
 		Standbys  int  `json:"num_standbys"`
@@ -122,6 +166,27 @@ type CephStatus struct {
 	ProgressEvents map[string]ProgressEvents `json:"progress_events,omitempty"`
 }
 
+// CephConfigOption is an option from 'ceph config dump' output
+type CephConfigOption struct {
+	Section string `json:"section"`
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Mask    string `json:"mask,omitempty"`
+}
+
+// CephConfigOptionHelp is an option schema from 'ceph config help <option>' output
+type CephConfigOptionHelp struct {
+	Name               string      `json:"name"`
+	Type               string      `json:"type"`
+	Level              string      `json:"level"`
+	Services           []string    `json:"services"`
+	EnumValues         []string    `json:"enum_values"`
+	Min                interface{} `json:"min"`
+	Max                interface{} `json:"max"`
+	CanUpdateAtRuntime bool        `json:"can_update_at_runtime"`
+	Flags              []string    `json:"flags"`
+}
+
 type RgwInfo struct {
 	Metadata struct {
 		ID string `json:"id"`
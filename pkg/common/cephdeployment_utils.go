@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +41,19 @@ func IsClusterMaintenanceActing(ctx context.Context, cephLcmclientset lcmclient.
 	return cdm.Status != nil && (cdm.Status.State == cephlcmv1alpha1.MaintenanceActing || cdm.Status.State == cephlcmv1alpha1.MaintenanceFailing), nil
 }
 
+// GetCephDeploymentRookNamespace returns rook namespace specified in CephDeployment for related Ceph cluster,
+// empty value means CephDeployment is not found or uses rook namespace from Pelagia config
+func GetCephDeploymentRookNamespace(ctx context.Context, cephLcmclientset lcmclient.Interface, namespace, name string) (string, error) {
+	cephDpl, err := cephLcmclientset.LcmV1alpha1().CephDeployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "failed to get CephDeployment %s/%s", namespace, name)
+	}
+	return cephDpl.Spec.RookNamespace, nil
+}
+
 func IsOpenStackPoolsPresent(pools []cephlcmv1alpha1.CephPool) bool {
 	// since on validation stage we are checking that pools section is correct
 	// we can just simply check any openstack pool existence now
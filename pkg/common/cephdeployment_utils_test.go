@@ -20,10 +20,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pkg/errors"
 	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
 	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
@@ -351,3 +353,30 @@ func TestGetExpandedCephNodeDevicesList(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCephDeploymentRookNamespace(t *testing.T) {
+	lcmClient := faketestclients.GetFakeLcmclient()
+	cephDpl := cephlcmv1alpha1.CephDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cephcluster-2", Namespace: "lcm-namespace"},
+		Spec:       cephlcmv1alpha1.CephDeploymentSpec{RookNamespace: "rook-ceph-2"},
+	}
+	inputResources := map[string]runtime.Object{
+		"cephdeployments": &cephlcmv1alpha1.CephDeploymentList{Items: []cephlcmv1alpha1.CephDeployment{cephDpl}},
+	}
+	faketestclients.FakeReaction(lcmClient, "get", []string{"cephdeployments"}, inputResources, nil)
+
+	rookNamespace, err := GetCephDeploymentRookNamespace(context.TODO(), lcmClient, "lcm-namespace", "cephcluster-2")
+	assert.Nil(t, err)
+	assert.Equal(t, "rook-ceph-2", rookNamespace)
+
+	rookNamespace, err = GetCephDeploymentRookNamespace(context.TODO(), lcmClient, "lcm-namespace", "cephcluster")
+	assert.Nil(t, err)
+	assert.Equal(t, "", rookNamespace)
+	faketestclients.CleanupFakeClientReactions(lcmClient)
+
+	faketestclients.FakeReaction(lcmClient, "get", []string{"cephdeployments"}, inputResources, map[string]error{"get-cephdeployments": errors.New("get failed")})
+	rookNamespace, err = GetCephDeploymentRookNamespace(context.TODO(), lcmClient, "lcm-namespace", "cephcluster-2")
+	assert.Equal(t, "failed to get CephDeployment lcm-namespace/cephcluster-2: get failed", err.Error())
+	assert.Equal(t, "", rookNamespace)
+	faketestclients.CleanupFakeClientReactions(lcmClient)
+}
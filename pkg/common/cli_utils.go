@@ -64,7 +64,7 @@ func RunAndParseCephToolboxCLI(ctx context.Context, kubeClient kubernetes.Interf
 	return nil
 }
 
-func RunAndParseDiskDaemonCLI(ctx context.Context, kubeClient kubernetes.Interface, config *rest.Config, namespace, nodeName, command string, data any) error {
+func RunAndParseDiskDaemonCLI(ctx context.Context, kubeClient kubernetes.Interface, config *rest.Config, namespace, diskDaemonName, nodeName, command string, data any) error {
 	e := ExecConfig{
 		Context:    ctx,
 		Kubeclient: kubeClient,
@@ -72,7 +72,7 @@ func RunAndParseDiskDaemonCLI(ctx context.Context, kubeClient kubernetes.Interfa
 		Namespace:  namespace,
 		Command:    command,
 		Nodename:   nodeName,
-		Labels:     []string{fmt.Sprintf("app=%s", PelagiaDiskDaemon)},
+		Labels:     []string{fmt.Sprintf("app=%s", diskDaemonName)},
 	}
 	output, _, err := RunPodCmdAndCheckError(e)
 	if err != nil {
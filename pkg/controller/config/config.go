@@ -17,6 +17,8 @@ limitations under the License.
 package lcmconfig
 
 import (
+	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -27,11 +29,15 @@ import (
 
 	"github.com/rook/rook/pkg/operator/k8sutil"
 	"k8s.io/apimachinery/pkg/labels"
+
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
 )
 
 type LcmConfig struct {
 	// main rook deployment namespace
 	RookNamespace string
+	// disk daemon daemonset name, Ceph clusters with own rook namespace have own disk daemon
+	DiskDaemonName string
 	// common params used across all controllers
 	CommonParams CommonParams
 	// params related to health controller
@@ -70,6 +76,22 @@ type HealthParams struct {
 	UsageDetailsClassesFilter string
 	// regexp for collection class usage/capacity details
 	UsageDetailsPoolsFilter string
+	// max number of health issues transitions kept in status history
+	IssuesHistorySize int
+	// generic webhook url to send health notifications as json
+	NotificationsWebhookURL string
+	// alertmanager url to send health notifications as alerts through v2 api
+	NotificationsAlertmanagerURL string
+	// interval to not send the same health notification again
+	NotificationsDedupInterval time.Duration
+	// max number of notification requests per minute for each sink
+	NotificationsRateLimit int
+	// interval between usage samples collected for capacity forecast
+	CapacityForecastSampleInterval time.Duration
+	// max number of usage samples kept for capacity forecast
+	CapacityForecastSamples int
+	// raise issue when nearfull/full is projected to be reached within horizon, 0 disables issues
+	CapacityForecastHorizon time.Duration
 }
 
 type TaskParams struct {
@@ -100,6 +122,16 @@ type DeployParams struct {
 	DrainRequestLabelKey string
 	// drain ready label for nodes
 	DrainReadyLabelKey string
+	// max number of configuration apply steps running in parallel
+	ApplyParallelism int
+	// timeout for a single configuration apply step
+	ApplyStepTimeout time.Duration
+	// fail spec validation if pools target sizes exceed device class capacity
+	PoolsCapacityStrict bool
+	// fail spec validation if rookConfig options are unknown or have invalid values
+	RookConfigStrict bool
+	// max number of applied Ceph config generations kept in history
+	CephConfigHistorySize int
 	// csi related params
 	CSIParams CSIDeployParams
 }
@@ -146,7 +178,8 @@ var (
 	lcmConfigs = map[string]LcmConfig{}
 	// default lcm config var
 	defaultLcmConfig = LcmConfig{
-		RookNamespace: "rook-ceph",
+		RookNamespace:  "rook-ceph",
+		DiskDaemonName: lcmcommon.PelagiaDiskDaemon,
 		CommonParams: CommonParams{
 			BaseGatewayName:          "app-gateway",
 			BaseGatewayNamespace:     "openstack",
@@ -168,19 +201,28 @@ var (
 			"MON_DISK_LOW",
 			"RECENT_CRASH",
 		},
-		ChecksSkip:                []string{},
-		LogLevel:                  zerolog.InfoLevel,
-		UsageDetailsClassesFilter: "",
-		UsageDetailsPoolsFilter:   "",
+		ChecksSkip:                     []string{},
+		LogLevel:                       zerolog.InfoLevel,
+		UsageDetailsClassesFilter:      "",
+		UsageDetailsPoolsFilter:        "",
+		IssuesHistorySize:              50,
+		NotificationsDedupInterval:     15 * time.Minute,
+		NotificationsRateLimit:         10,
+		CapacityForecastSampleInterval: time.Hour,
+		CapacityForecastSamples:        168,
+		CapacityForecastHorizon:        30 * 24 * time.Hour,
 	}
 	defaultTaskConfig = TaskParams{
 		LogLevel:              zerolog.InfoLevel,
 		OsdPgRebalanceTimeout: 30 * time.Minute,
 	}
 	defaultDeployParams = DeployParams{
-		LogLevel:             zerolog.InfoLevel,
-		DrainRequestLabelKey: "kaas.mirantis.com/lcm-drained",
-		DrainReadyLabelKey:   "kaas.mirantis.com/csi-drained",
+		LogLevel:              zerolog.InfoLevel,
+		DrainRequestLabelKey:  "kaas.mirantis.com/lcm-drained",
+		DrainReadyLabelKey:    "kaas.mirantis.com/csi-drained",
+		ApplyParallelism:      1,
+		ApplyStepTimeout:      30 * time.Minute,
+		CephConfigHistorySize: 10,
 		CSIParams: CSIDeployParams{
 			Manage:                    true,
 			KubeletPath:               "/var/lib/kubelet",
@@ -204,11 +246,19 @@ var (
 	rgwPublicAccessServiceSelectorParameter = "RGW_PUBLIC_ACCESS_SERVICE_SELECTOR"
 	ingressSupportParameter                 = "KEEP_INGRESS"
 	// health controller config params
-	healthChecksCephIssuesToIgnoreParameter = "HEALTH_CHECKS_CEPH_ISSUES_TO_IGNORE"
-	healthChecksSkipParameter               = "HEALTH_CHECKS_SKIP"
-	healthChecksUsagelClassFilterParameter  = "HEALTH_CHECKS_USAGE_CLASS_FILTER"
-	healthChecksUsagelPoolsFilterParameter  = "HEALTH_CHECKS_USAGE_POOLS_FILTER"
-	healthLogLevelParameter                 = "HEALTH_LOG_LEVEL"
+	healthChecksCephIssuesToIgnoreParameter       = "HEALTH_CHECKS_CEPH_ISSUES_TO_IGNORE"
+	healthChecksSkipParameter                     = "HEALTH_CHECKS_SKIP"
+	healthChecksUsagelClassFilterParameter        = "HEALTH_CHECKS_USAGE_CLASS_FILTER"
+	healthChecksUsagelPoolsFilterParameter        = "HEALTH_CHECKS_USAGE_POOLS_FILTER"
+	healthIssuesHistorySizeParameter              = "HEALTH_ISSUES_HISTORY_SIZE"
+	healthNotificationsWebhookURLParameter        = "HEALTH_NOTIFICATIONS_WEBHOOK_URL"
+	healthNotificationsAlertmanagerURLParameter   = "HEALTH_NOTIFICATIONS_ALERTMANAGER_URL"
+	healthNotificationsDedupIntervalParameter     = "HEALTH_NOTIFICATIONS_DEDUP_INTERVAL_MIN"
+	healthNotificationsRateLimitParameter         = "HEALTH_NOTIFICATIONS_RATE_LIMIT"
+	healthCapacityForecastSampleIntervalParameter = "HEALTH_CAPACITY_FORECAST_SAMPLE_INTERVAL_MIN"
+	healthCapacityForecastSamplesParameter        = "HEALTH_CAPACITY_FORECAST_SAMPLES"
+	healthCapacityForecastHorizonParameter        = "HEALTH_CAPACITY_FORECAST_HORIZON_DAYS"
+	healthLogLevelParameter                       = "HEALTH_LOG_LEVEL"
 	// params for task controller
 	taskLogLevelParameter             = "TASK_LOG_LEVEL"
 	taskOsdPgRebalanceTimeout         = "TASK_OSD_PG_REBALANCE_TIMEOUT_MIN"
@@ -223,6 +273,11 @@ var (
 	cephDplCephDaemonsetLabelExclude = "DEPLOYMENT_LABEL_TO_EXCLUDE_CEPH_DAEMONSETS"
 	cephDplDrainRequestLabelKeyName  = "DEPLOYMENT_DRAIN_REQUEST_LABEL_KEY"
 	cephDplDrainReadyLabelKeyName    = "DEPLOYMENT_DRAIN_READY_LABEL_KEY"
+	cephDplApplyParallelism          = "DEPLOYMENT_APPLY_PARALLELISM"
+	cephDplApplyStepTimeout          = "DEPLOYMENT_APPLY_STEP_TIMEOUT_MIN"
+	cephDplPoolsCapacityStrict       = "DEPLOYMENT_POOLS_CAPACITY_STRICT"
+	cephDplRookConfigStrict          = "DEPLOYMENT_ROOK_CONFIG_STRICT"
+	cephDplCephConfigHistorySize     = "DEPLOYMENT_CEPH_CONFIG_HISTORY_SIZE"
 	// csi related params for deployment controller
 	cephDplCSIManageKeyName                       = "DEPLOYMENT_CSI_DRIVERS_MANAGE"
 	cephDplCSIRBDDefaultCreateKeyName             = "DEPLOYMENT_CSI_RBD_DEFAULT_DRIVER_CREATE"
@@ -282,9 +337,95 @@ func loadHealthConfiguration(objLog zerolog.Logger, configData map[string]string
 			newHealthConfig.UsageDetailsPoolsFilter = poolsFilter
 		}
 	}
+
+	if historySize, present := configData[healthIssuesHistorySizeParameter]; present {
+		val, err := strconv.Atoi(historySize)
+		if err != nil || val < 1 {
+			objLog.Error().Msgf(errorMsgTmpl, healthIssuesHistorySizeParameter, historySize, "positive integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, healthIssuesHistorySizeParameter, historySize)
+			newHealthConfig.IssuesHistorySize = val
+		}
+	}
+
+	if webhookURL, present := configData[healthNotificationsWebhookURLParameter]; present {
+		if isValidNotificationURL(webhookURL) {
+			objLog.Debug().Msgf(debugMsgTmpl, healthNotificationsWebhookURLParameter, webhookURL)
+			newHealthConfig.NotificationsWebhookURL = webhookURL
+		} else {
+			objLog.Error().Msgf(errorMsgTmpl, healthNotificationsWebhookURLParameter, webhookURL, "valid http or https url")
+		}
+	}
+
+	if alertmanagerURL, present := configData[healthNotificationsAlertmanagerURLParameter]; present {
+		if isValidNotificationURL(alertmanagerURL) {
+			objLog.Debug().Msgf(debugMsgTmpl, healthNotificationsAlertmanagerURLParameter, alertmanagerURL)
+			newHealthConfig.NotificationsAlertmanagerURL = alertmanagerURL
+		} else {
+			objLog.Error().Msgf(errorMsgTmpl, healthNotificationsAlertmanagerURLParameter, alertmanagerURL, "valid http or https url")
+		}
+	}
+
+	if dedupInterval, present := configData[healthNotificationsDedupIntervalParameter]; present {
+		mins, err := strconv.Atoi(dedupInterval)
+		if err != nil || mins < 0 {
+			objLog.Error().Msgf(errorMsgTmpl, healthNotificationsDedupIntervalParameter, dedupInterval, "non-negative integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, healthNotificationsDedupIntervalParameter, dedupInterval)
+			newHealthConfig.NotificationsDedupInterval = time.Duration(mins) * time.Minute
+		}
+	}
+
+	if rateLimit, present := configData[healthNotificationsRateLimitParameter]; present {
+		val, err := strconv.Atoi(rateLimit)
+		if err != nil || val < 1 {
+			objLog.Error().Msgf(errorMsgTmpl, healthNotificationsRateLimitParameter, rateLimit, "positive integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, healthNotificationsRateLimitParameter, rateLimit)
+			newHealthConfig.NotificationsRateLimit = val
+		}
+	}
+
+	if sampleInterval, present := configData[healthCapacityForecastSampleIntervalParameter]; present {
+		mins, err := strconv.Atoi(sampleInterval)
+		if err != nil || mins < 1 {
+			objLog.Error().Msgf(errorMsgTmpl, healthCapacityForecastSampleIntervalParameter, sampleInterval, "positive integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, healthCapacityForecastSampleIntervalParameter, sampleInterval)
+			newHealthConfig.CapacityForecastSampleInterval = time.Duration(mins) * time.Minute
+		}
+	}
+
+	if samples, present := configData[healthCapacityForecastSamplesParameter]; present {
+		val, err := strconv.Atoi(samples)
+		if err != nil || val < 2 {
+			objLog.Error().Msgf(errorMsgTmpl, healthCapacityForecastSamplesParameter, samples, "integer not less than 2")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, healthCapacityForecastSamplesParameter, samples)
+			newHealthConfig.CapacityForecastSamples = val
+		}
+	}
+
+	if horizon, present := configData[healthCapacityForecastHorizonParameter]; present {
+		days, err := strconv.Atoi(horizon)
+		if err != nil || days < 0 {
+			objLog.Error().Msgf(errorMsgTmpl, healthCapacityForecastHorizonParameter, horizon, "non-negative integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, healthCapacityForecastHorizonParameter, horizon)
+			newHealthConfig.CapacityForecastHorizon = time.Duration(days) * 24 * time.Hour
+		}
+	}
 	return &newHealthConfig
 }
 
+func isValidNotificationURL(value string) bool {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
 func loadTaskConfiguration(objLog zerolog.Logger, configData map[string]string) *TaskParams {
 	newTaskConfig := defaultTaskConfig
 
@@ -383,6 +524,56 @@ func loadCephDeploymentConfiguration(objLog zerolog.Logger, configData map[strin
 		newCephDplConfig.DrainReadyLabelKey = drainReadyLabel
 	}
 
+	if applyParallelism, present := configData[cephDplApplyParallelism]; present {
+		val, err := strconv.Atoi(applyParallelism)
+		if err != nil || val < 1 {
+			objLog.Error().Msgf(errorMsgTmpl, cephDplApplyParallelism, applyParallelism, "positive integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, cephDplApplyParallelism, applyParallelism)
+			newCephDplConfig.ApplyParallelism = val
+		}
+	}
+
+	if stepTimeout, present := configData[cephDplApplyStepTimeout]; present {
+		mins, err := strconv.Atoi(stepTimeout)
+		if err != nil || mins < 1 {
+			objLog.Error().Msgf(errorMsgTmpl, cephDplApplyStepTimeout, stepTimeout, "positive integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, cephDplApplyStepTimeout, stepTimeout)
+			newCephDplConfig.ApplyStepTimeout = time.Duration(mins) * time.Minute
+		}
+	}
+
+	if capacityStrict, present := configData[cephDplPoolsCapacityStrict]; present {
+		val, err := strconv.ParseBool(capacityStrict)
+		if err != nil {
+			objLog.Error().Msgf(errorMsgTmpl, cephDplPoolsCapacityStrict, capacityStrict, "bool")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, cephDplPoolsCapacityStrict, capacityStrict)
+			newCephDplConfig.PoolsCapacityStrict = val
+		}
+	}
+
+	if rookConfigStrict, present := configData[cephDplRookConfigStrict]; present {
+		val, err := strconv.ParseBool(rookConfigStrict)
+		if err != nil {
+			objLog.Error().Msgf(errorMsgTmpl, cephDplRookConfigStrict, rookConfigStrict, "bool")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, cephDplRookConfigStrict, rookConfigStrict)
+			newCephDplConfig.RookConfigStrict = val
+		}
+	}
+
+	if historySize, present := configData[cephDplCephConfigHistorySize]; present {
+		val, err := strconv.Atoi(historySize)
+		if err != nil || val < 1 {
+			objLog.Error().Msgf(errorMsgTmpl, cephDplCephConfigHistorySize, historySize, "positive integer")
+		} else {
+			objLog.Debug().Msgf(debugMsgTmpl, cephDplCephConfigHistorySize, historySize)
+			newCephDplConfig.CephConfigHistorySize = val
+		}
+	}
+
 	if csiManage, present := configData[cephDplCSIManageKeyName]; present {
 		val, err := strconv.ParseBool(csiManage)
 		if err != nil {
@@ -597,3 +788,23 @@ func GetConfiguration(namespace string) LcmConfig {
 
 	return config
 }
+
+// GetClusterConfiguration returns config for particular Ceph cluster in namespace,
+// cluster with own rook namespace gets own disk daemon name as well
+func GetClusterConfiguration(namespace, clusterName, rookNamespace string) LcmConfig {
+	return getClusterConfiguration(GetConfiguration(namespace), clusterName, rookNamespace)
+}
+
+// ReadClusterConfiguration parses config data directly, without loaded configuration
+// cache, which is available only for config controller running in leader
+func ReadClusterConfiguration(objLog zerolog.Logger, configData map[string]string, clusterName, rookNamespace string) LcmConfig {
+	return getClusterConfiguration(ReadConfiguration(objLog, configData), clusterName, rookNamespace)
+}
+
+func getClusterConfiguration(config LcmConfig, clusterName, rookNamespace string) LcmConfig {
+	if rookNamespace != "" && rookNamespace != config.RookNamespace {
+		config.RookNamespace = rookNamespace
+		config.DiskDaemonName = fmt.Sprintf("%s-%s", lcmcommon.PelagiaDiskDaemon, clusterName)
+	}
+	return config
+}
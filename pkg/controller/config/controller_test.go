@@ -127,6 +127,14 @@ func TestInitReconcile(t *testing.T) {
 					"HEALTH_CHECKS_SKIP":                            "ceph_daemons,rgw_info",
 					"HEALTH_CHECKS_USAGE_CLASS_FILTER":              "hdd",
 					"HEALTH_CHECKS_USAGE_POOLS_FILTER":              "pool-.+",
+					"HEALTH_ISSUES_HISTORY_SIZE":                    "20",
+					"HEALTH_NOTIFICATIONS_WEBHOOK_URL":              "https://webhook.example.com/notify",
+					"HEALTH_NOTIFICATIONS_ALERTMANAGER_URL":         "http://alertmanager.monitoring.svc:9093",
+					"HEALTH_NOTIFICATIONS_DEDUP_INTERVAL_MIN":       "30",
+					"HEALTH_NOTIFICATIONS_RATE_LIMIT":               "5",
+					"HEALTH_CAPACITY_FORECAST_SAMPLE_INTERVAL_MIN":  "30",
+					"HEALTH_CAPACITY_FORECAST_SAMPLES":              "48",
+					"HEALTH_CAPACITY_FORECAST_HORIZON_DAYS":         "60",
 					"RGW_PUBLIC_ACCESS_SERVICE_SELECTOR":            "custom-access-label=true",
 					"HEALTH_LOG_LEVEL":                              "warn",
 					"TASK_LOG_LEVEL":                                "warn",
@@ -137,6 +145,11 @@ func TestInitReconcile(t *testing.T) {
 					"DEPLOYMENT_LABEL_TO_EXCLUDE_CEPH_DAEMONSETS":   "no-ceph=true",
 					"DEPLOYMENT_DRAIN_REQUEST_LABEL_KEY":            "custom-label/drain-request",
 					"DEPLOYMENT_DRAIN_READY_LABEL_KEY":              "custom-label/csi-drain-ready",
+					"DEPLOYMENT_APPLY_PARALLELISM":                  "4",
+					"DEPLOYMENT_APPLY_STEP_TIMEOUT_MIN":             "10",
+					"DEPLOYMENT_POOLS_CAPACITY_STRICT":              "true",
+					"DEPLOYMENT_ROOK_CONFIG_STRICT":                 "true",
+					"DEPLOYMENT_CEPH_CONFIG_HISTORY_SIZE":           "5",
 					"DEPLOYMENT_CSI_DRIVERS_MANAGE":                 "true",
 					"DEPLOYMENT_CSI_RBD_DEFAULT_DRIVER_CREATE":      "false",
 					"DEPLOYMENT_CSI_CEPHFS_DEFAULT_DRIVER_CREATE":   "false",
@@ -170,11 +183,19 @@ func TestInitReconcile(t *testing.T) {
 					newConfig.CommonParams.KeepIngress = true
 					newConfig.CommonParams.GatewayAPIEnabled = false
 					newConfig.HealthParams = &HealthParams{
-						LogLevel:                  2,
-						ChecksSkip:                []string{"ceph_daemons", "rgw_info"},
-						CephIssuesToIgnore:        []string{"MON_DOWN", "HOST_DOWN"},
-						UsageDetailsClassesFilter: "hdd",
-						UsageDetailsPoolsFilter:   "pool-.+",
+						LogLevel:                       2,
+						ChecksSkip:                     []string{"ceph_daemons", "rgw_info"},
+						CephIssuesToIgnore:             []string{"MON_DOWN", "HOST_DOWN"},
+						UsageDetailsClassesFilter:      "hdd",
+						UsageDetailsPoolsFilter:        "pool-.+",
+						IssuesHistorySize:              20,
+						NotificationsWebhookURL:        "https://webhook.example.com/notify",
+						NotificationsAlertmanagerURL:   "http://alertmanager.monitoring.svc:9093",
+						NotificationsDedupInterval:     30 * time.Minute,
+						NotificationsRateLimit:         5,
+						CapacityForecastSampleInterval: 30 * time.Minute,
+						CapacityForecastSamples:        48,
+						CapacityForecastHorizon:        60 * 24 * time.Hour,
 					}
 					newConfig.TaskParams = &TaskParams{
 						LogLevel:                        2,
@@ -187,6 +208,11 @@ func TestInitReconcile(t *testing.T) {
 						CephDaemonsetPlacementLabelExclude: "no-ceph=true",
 						DrainRequestLabelKey:               "custom-label/drain-request",
 						DrainReadyLabelKey:                 "custom-label/csi-drain-ready",
+						ApplyParallelism:                   4,
+						ApplyStepTimeout:                   10 * time.Minute,
+						PoolsCapacityStrict:                true,
+						RookConfigStrict:                   true,
+						CephConfigHistorySize:              5,
 						CSIParams: CSIDeployParams{
 							Manage:                 true,
 							KubeletPath:            "/var/lib/kubelet-custom",
@@ -256,6 +282,14 @@ func TestInitReconcile(t *testing.T) {
 					"DISK_DAEMON_PLACEMENT_NODES_SELECTOR":          "custom-^^-label=true,asss",
 					"HEALTH_CHECKS_USAGE_CLASS_FILTER":              "(hdd|",
 					"HEALTH_CHECKS_USAGE_POOLS_FILTER":              "(pool-|",
+					"HEALTH_ISSUES_HISTORY_SIZE":                    "-5",
+					"HEALTH_NOTIFICATIONS_WEBHOOK_URL":              "webhook.example.com/notify",
+					"HEALTH_NOTIFICATIONS_ALERTMANAGER_URL":         "ftp://alertmanager.monitoring.svc",
+					"HEALTH_NOTIFICATIONS_DEDUP_INTERVAL_MIN":       "-1",
+					"HEALTH_NOTIFICATIONS_RATE_LIMIT":               "0",
+					"HEALTH_CAPACITY_FORECAST_SAMPLE_INTERVAL_MIN":  "0",
+					"HEALTH_CAPACITY_FORECAST_SAMPLES":              "1",
+					"HEALTH_CAPACITY_FORECAST_HORIZON_DAYS":         "-7",
 					"RGW_PUBLIC_ACCESS_SERVICE_SELECTOR":            "custom&^^^-access-label",
 					"GATEWAY_API_ENABLED":                           "fa;sfla",
 					"KEEP_INGRESS":                                  "asr32",
@@ -339,3 +373,55 @@ func TestInitReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClusterConfiguration(t *testing.T) {
+	lcmConfigs = map[string]LcmConfig{}
+	defaultConfig := GetConfiguration("lcm-namespace")
+	tests := []struct {
+		name               string
+		rookNamespace      string
+		expectedRookNs     string
+		expectedDaemonName string
+	}{
+		{
+			name:               "rook namespace is not specified",
+			expectedRookNs:     "rook-ceph",
+			expectedDaemonName: "pelagia-disk-daemon",
+		},
+		{
+			name:               "rook namespace is the same as default",
+			rookNamespace:      "rook-ceph",
+			expectedRookNs:     "rook-ceph",
+			expectedDaemonName: "pelagia-disk-daemon",
+		},
+		{
+			name:               "own rook namespace is specified",
+			rookNamespace:      "rook-ceph-2",
+			expectedRookNs:     "rook-ceph-2",
+			expectedDaemonName: "pelagia-disk-daemon-cephcluster-2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := GetClusterConfiguration("lcm-namespace", "cephcluster-2", test.rookNamespace)
+			assert.Equal(t, test.expectedRookNs, config.RookNamespace)
+			assert.Equal(t, test.expectedDaemonName, config.DiskDaemonName)
+			assert.Equal(t, defaultConfig.CommonParams, config.CommonParams)
+		})
+	}
+	assert.Equal(t, "rook-ceph", GetConfiguration("lcm-namespace").RookNamespace)
+}
+
+func TestReadClusterConfiguration(t *testing.T) {
+	lcmConfigs = map[string]LcmConfig{}
+	configData := map[string]string{"ROOK_NAMESPACE": "rook-ceph-custom"}
+	config := ReadClusterConfiguration(log.With().Logger(), configData, "cephcluster-2", "")
+	assert.Equal(t, "rook-ceph-custom", config.RookNamespace)
+	assert.Equal(t, "pelagia-disk-daemon", config.DiskDaemonName)
+
+	config = ReadClusterConfiguration(log.With().Logger(), configData, "cephcluster-2", "rook-ceph-2")
+	assert.Equal(t, "rook-ceph-2", config.RookNamespace)
+	assert.Equal(t, "pelagia-disk-daemon-cephcluster-2", config.DiskDaemonName)
+	// loaded configuration cache is not used and not changed
+	assert.Equal(t, map[string]LcmConfig{}, lcmConfigs)
+}
@@ -38,6 +38,19 @@ func AddToManager(m manager.Manager, controllerName string) error {
 	return AddToManagerFuncs[controllerName](m)
 }
 
+// AddWebhooksToManagerFuncs is a list of functions to add admission webhooks to the Manager webhook server
+var AddWebhooksToManagerFuncs = []func(manager.Manager) error{}
+
+// AddWebhooksToManager adds all admission webhooks to the Manager
+func AddWebhooksToManager(m manager.Manager) error {
+	for _, addWebhook := range AddWebhooksToManagerFuncs {
+		if err := addWebhook(m); err != nil {
+			return errors.Wrap(err, "failed to add admission webhook")
+		}
+	}
+	return nil
+}
+
 // AddToManagerFuncs is a list of functions to create controllers and add them to a manager.
 func init() {
 	AddToManagerFuncs[lcmdeployment.ControllerName] = lcmdeployment.Add
@@ -45,4 +58,6 @@ func init() {
 	AddToManagerFuncs[lcminfra.ControllerName] = lcminfra.Add
 	AddToManagerFuncs[lcmosdremove.ControllerName] = lcmosdremove.Add
 	AddToManagerFuncs[lcmsecret.ControllerName] = lcmsecret.Add
+
+	AddWebhooksToManagerFuncs = append(AddWebhooksToManagerFuncs, lcmdeployment.AddWebhook, lcmosdremove.AddWebhook)
 }
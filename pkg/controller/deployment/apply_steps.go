@@ -0,0 +1,152 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+// applyStep is a single configuration apply step
+type applyStep struct {
+	// name is a step name, used in status messages and metrics
+	name string
+	// conditionType is a type of condition step result is reported to
+	conditionType string
+	// dependsOn is a list of steps names, which should be completed before step is started,
+	// dependencies not present in steps list are considered as completed
+	dependsOn []string
+	// ensureFunc is a step func, called for config copy with step own context
+	ensureFunc func(*cephDeploymentConfig) (bool, error)
+}
+
+// applyStepResult is a result of configuration apply step
+type applyStepResult struct {
+	step      applyStep
+	changed   bool
+	err       error
+	duration  time.Duration
+	paused    bool
+	postponed bool
+	// drifts found by step and objects kinds verified for drifts
+	drifts            []cephlcmv1alpha1.CephDeploymentObjectDrift
+	driftCheckedKinds []string
+}
+
+// runApplySteps runs configuration apply steps, respecting steps dependencies. Steps,
+// which are not dependent on each other, are run in parallel with limited parallelism.
+// Results are returned in the same order as steps are passed. Paused steps and all
+// steps if apply is postponed are not run.
+func (c *cephDeploymentConfig) runApplySteps(steps []applyStep, postponed bool) []applyStepResult {
+	results := make([]applyStepResult, len(steps))
+	stepsIdx := map[string]int{}
+	for idx, step := range steps {
+		stepsIdx[step.name] = idx
+		results[idx].step = step
+	}
+	parallelism := c.lcmConfig.DeployParams.ApplyParallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	started := make([]bool, len(steps))
+	completed := make([]bool, len(steps))
+	isReady := func(step applyStep) bool {
+		for _, dependency := range step.dependsOn {
+			if idx, present := stepsIdx[dependency]; present && !completed[idx] {
+				return false
+			}
+		}
+		return true
+	}
+
+	done := make(chan int)
+	running := 0
+	finished := 0
+	for finished < len(steps) {
+		for idx, step := range steps {
+			if running >= parallelism {
+				break
+			}
+			if started[idx] || !isReady(step) {
+				continue
+			}
+			started[idx] = true
+			if c.isSectionPaused(step.conditionType) || postponed {
+				results[idx].paused = !postponed
+				results[idx].postponed = postponed
+				completed[idx] = true
+				finished++
+				continue
+			}
+			running++
+			go func(idx int, step applyStep) {
+				results[idx] = c.runApplyStep(step)
+				done <- idx
+			}(idx, step)
+		}
+		if finished == len(steps) {
+			break
+		}
+		if running == 0 {
+			// should not happen, unless steps have circular dependencies
+			for idx, step := range steps {
+				if !started[idx] {
+					results[idx].err = errors.Errorf("dependencies %v for step '%s' are not resolved", step.dependsOn, step.name)
+				}
+			}
+			break
+		}
+		idx := <-done
+		running--
+		completed[idx] = true
+		finished++
+	}
+	return results
+}
+
+// runApplyStep runs configuration apply step with step timeout
+func (c *cephDeploymentConfig) runApplyStep(step applyStep) applyStepResult {
+	// step is run for config copy with own context and drifts collection,
+	// to allow running few steps in parallel
+	stepConfig := *c
+	stepConfig.cdConfig.drifts = nil
+	stepConfig.cdConfig.driftCheckedKinds = nil
+	cancel := func() {}
+	if c.lcmConfig.DeployParams.ApplyStepTimeout > 0 {
+		stepConfig.context, cancel = context.WithTimeout(c.context, c.lcmConfig.DeployParams.ApplyStepTimeout)
+	}
+	defer cancel()
+
+	started := time.Now()
+	c.log.Debug().Msgf("configuration apply step '%s' is started", step.name)
+	changed, err := step.ensureFunc(&stepConfig)
+	if err != nil && errors.Is(stepConfig.context.Err(), context.DeadlineExceeded) {
+		err = errors.Wrapf(err, "step timed out after %v", c.lcmConfig.DeployParams.ApplyStepTimeout)
+	}
+	return applyStepResult{
+		step:              step,
+		changed:           changed,
+		err:               err,
+		duration:          time.Since(started),
+		drifts:            stepConfig.cdConfig.drifts,
+		driftCheckedKinds: stepConfig.cdConfig.driftCheckedKinds,
+	}
+}
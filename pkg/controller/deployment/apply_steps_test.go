@@ -0,0 +1,219 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestRunApplySteps(t *testing.T) {
+	var lock sync.Mutex
+	executed := []string{}
+	fakeStep := func(name, conditionType string, changed bool, err error, dependsOn ...string) applyStep {
+		return applyStep{
+			name:          name,
+			conditionType: conditionType,
+			dependsOn:     dependsOn,
+			ensureFunc: func(_ *cephDeploymentConfig) (bool, error) {
+				lock.Lock()
+				executed = append(executed, name)
+				lock.Unlock()
+				return changed, err
+			},
+		}
+	}
+	steps := []applyStep{
+		fakeStep("cephcsi", cephlcmv1alpha1.ConditionTypeCSI, false, nil),
+		fakeStep("cephcluster", cephlcmv1alpha1.ConditionTypeCluster, true, nil),
+		fakeStep("cephblockpools", cephlcmv1alpha1.ConditionTypePools, false, errors.New("pools failed"), "cephcluster"),
+		fakeStep("storageclasses", cephlcmv1alpha1.ConditionTypeStorageClasses, true, nil, "cephblockpools", "shared filesystems"),
+	}
+	tests := []struct {
+		name             string
+		pausedSections   []cephlcmv1alpha1.CephDeploymentSection
+		postponed        bool
+		parallelism      int
+		expectedExecuted []string
+		expectedResults  []applyStepResult
+	}{
+		{
+			name:             "steps are run sequentially",
+			parallelism:      1,
+			expectedExecuted: []string{"cephcsi", "cephcluster", "cephblockpools", "storageclasses"},
+			expectedResults: []applyStepResult{
+				{step: steps[0]},
+				{step: steps[1], changed: true},
+				{step: steps[2], err: errors.New("pools failed")},
+				{step: steps[3], changed: true},
+			},
+		},
+		{
+			name:             "steps are run in parallel",
+			parallelism:      4,
+			expectedExecuted: []string{"cephblockpools", "cephcluster", "cephcsi", "storageclasses"},
+			expectedResults: []applyStepResult{
+				{step: steps[0]},
+				{step: steps[1], changed: true},
+				{step: steps[2], err: errors.New("pools failed")},
+				{step: steps[3], changed: true},
+			},
+		},
+		{
+			name:             "some sections are paused",
+			parallelism:      2,
+			pausedSections:   []cephlcmv1alpha1.CephDeploymentSection{cephlcmv1alpha1.ConditionTypeCluster, cephlcmv1alpha1.ConditionTypeStorageClasses},
+			expectedExecuted: []string{"cephblockpools", "cephcsi"},
+			expectedResults: []applyStepResult{
+				{step: steps[0]},
+				{step: steps[1], paused: true},
+				{step: steps[2], err: errors.New("pools failed")},
+				{step: steps[3], paused: true},
+			},
+		},
+		{
+			name:             "steps are postponed",
+			parallelism:      2,
+			postponed:        true,
+			expectedExecuted: []string{},
+			expectedResults: []applyStepResult{
+				{step: steps[0], postponed: true},
+				{step: steps[1], postponed: true},
+				{step: steps[2], postponed: true},
+				{step: steps[3], postponed: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: unitinputs.CephDeployNonMosk.DeepCopy(), pausedSections: test.pausedSections}, nil)
+			c.lcmConfig.DeployParams.ApplyParallelism = test.parallelism
+			executed = []string{}
+
+			results := c.runApplySteps(steps, test.postponed)
+			assert.Equal(t, len(test.expectedResults), len(results))
+			for idx := range results {
+				assert.Equal(t, test.expectedResults[idx].step.name, results[idx].step.name)
+				assert.Equal(t, test.expectedResults[idx].changed, results[idx].changed)
+				assert.Equal(t, test.expectedResults[idx].paused, results[idx].paused)
+				assert.Equal(t, test.expectedResults[idx].postponed, results[idx].postponed)
+				if test.expectedResults[idx].err == nil {
+					assert.Nil(t, results[idx].err)
+				} else {
+					assert.Equal(t, test.expectedResults[idx].err.Error(), results[idx].err.Error())
+				}
+			}
+			// steps run in parallel may be executed in any order
+			if test.parallelism > 1 {
+				assert.ElementsMatch(t, test.expectedExecuted, executed)
+			} else {
+				assert.Equal(t, test.expectedExecuted, executed)
+			}
+		})
+	}
+}
+
+func TestRunApplyStepsDependencies(t *testing.T) {
+	c := fakeDeploymentConfig(&deployConfig{cephDpl: unitinputs.CephDeployNonMosk.DeepCopy()}, nil)
+	c.lcmConfig.DeployParams.ApplyParallelism = 2
+
+	var lock sync.Mutex
+	events := []string{}
+	addEvent := func(event string) {
+		lock.Lock()
+		events = append(events, event)
+		lock.Unlock()
+	}
+	// independent steps wait for each other to verify they are run in parallel
+	var started sync.WaitGroup
+	started.Add(2)
+	independentStep := func(name string) applyStep {
+		return applyStep{
+			name: name,
+			ensureFunc: func(_ *cephDeploymentConfig) (bool, error) {
+				started.Done()
+				started.Wait()
+				addEvent(name + " done")
+				return false, nil
+			},
+		}
+	}
+	steps := []applyStep{
+		independentStep("cephcsi"),
+		independentStep("cephcluster"),
+		{
+			name:      "cephblockpools",
+			dependsOn: []string{"cephcsi", "cephcluster"},
+			ensureFunc: func(_ *cephDeploymentConfig) (bool, error) {
+				addEvent("cephblockpools done")
+				return true, nil
+			},
+		},
+	}
+	results := c.runApplySteps(steps, false)
+	assert.Equal(t, 3, len(results))
+	assert.True(t, results[2].changed)
+	assert.ElementsMatch(t, []string{"cephcsi done", "cephcluster done"}, events[:2])
+	assert.Equal(t, "cephblockpools done", events[2])
+
+	// circular dependencies are reported as errors
+	steps = []applyStep{
+		{name: "step-1", dependsOn: []string{"step-2"}},
+		{name: "step-2", dependsOn: []string{"step-1"}},
+	}
+	results = c.runApplySteps(steps, false)
+	assert.Equal(t, "dependencies [step-2] for step 'step-1' are not resolved", results[0].err.Error())
+	assert.Equal(t, "dependencies [step-1] for step 'step-2' are not resolved", results[1].err.Error())
+}
+
+func TestRunApplyStep(t *testing.T) {
+	c := fakeDeploymentConfig(&deployConfig{cephDpl: unitinputs.CephDeployNonMosk.DeepCopy()}, nil)
+	c.lcmConfig.DeployParams.ApplyStepTimeout = 10 * time.Millisecond
+
+	result := c.runApplyStep(applyStep{
+		name: "cephcluster",
+		ensureFunc: func(stepConfig *cephDeploymentConfig) (bool, error) {
+			<-stepConfig.context.Done()
+			return false, errors.Wrap(stepConfig.context.Err(), "failed to get cephcluster")
+		},
+	})
+	assert.Equal(t, "step timed out after 10ms: failed to get cephcluster: context deadline exceeded", result.err.Error())
+	assert.Nil(t, c.context.Err())
+
+	drift := cephlcmv1alpha1.CephDeploymentObjectDrift{Kind: "CephCluster", Namespace: "rook-ceph", Name: "cephcluster"}
+	result = c.runApplyStep(applyStep{
+		name: "cephcluster",
+		ensureFunc: func(stepConfig *cephDeploymentConfig) (bool, error) {
+			stepConfig.markDriftChecked("CephCluster")
+			stepConfig.cdConfig.drifts = append(stepConfig.cdConfig.drifts, drift)
+			return true, nil
+		},
+	})
+	assert.Nil(t, result.err)
+	assert.True(t, result.changed)
+	assert.Equal(t, []cephlcmv1alpha1.CephDeploymentObjectDrift{drift}, result.drifts)
+	assert.Equal(t, []string{"CephCluster"}, result.driftCheckedKinds)
+	assert.Nil(t, c.cdConfig.drifts)
+	assert.Nil(t, c.cdConfig.driftCheckedKinds)
+}
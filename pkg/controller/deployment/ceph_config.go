@@ -28,6 +28,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
 	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
 )
 
@@ -140,7 +141,7 @@ var runtimeKeys = passwordKeys
 
 func (c *cephDeploymentConfig) ensureCephConfig(cephClusterPresent bool) (bool, error) {
 	c.log.Debug().Msg("ensure ceph config")
-	cephOverrideConfig, runtimeConfig, configHashes, err := c.buildCephConfig()
+	cephOverrideConfig, runtimeConfig, configHashes, err := c.buildTargetCephConfig()
 	if err != nil {
 		c.log.Error().Err(err).Msg("failed to build ceph config")
 		return false, errors.Wrap(err, "failed to prepare ceph config map")
@@ -156,19 +157,8 @@ func (c *cephDeploymentConfig) ensureCephConfig(cephClusterPresent bool) (bool,
 			},
 		},
 		Data: map[string]string{
-			"config": cephOverrideConfig,
-			"runtime": func() string {
-				var runtimeString strings.Builder
-				for _, k := range lcmcommon.SortedMapKeys(runtimeConfig) {
-					_, key := getSectionAndKey(k)
-					if lcmcommon.Contains(passwordKeys, key) {
-						runtimeString.WriteString(fmt.Sprintf("%s = *\n", k))
-					} else {
-						runtimeString.WriteString(fmt.Sprintf("%s = %s\n", k, runtimeConfig[k]))
-					}
-				}
-				return runtimeString.String()
-			}(),
+			"config":  cephOverrideConfig,
+			"runtime": getRuntimeConfigString(runtimeConfig),
 		},
 	}
 	for section, hash := range configHashes {
@@ -185,6 +175,7 @@ func (c *cephDeploymentConfig) ensureCephConfig(cephClusterPresent bool) (bool,
 				for section := range configHashes {
 					resourceUpdateTimestamps.cephConfigMap[section] = currentGenTime
 				}
+				c.recordCephConfigHistory(nil, newRookCm.Data)
 				return true, nil
 			}
 		}
@@ -209,6 +200,11 @@ func (c *cephDeploymentConfig) ensureCephConfig(cephClusterPresent bool) (bool,
 		if err != nil {
 			return false, err
 		}
+		foreignRemoved, err := c.removeForeignCephConfigOptions(cephOverrideConfig, runtimeConfig)
+		if err != nil {
+			return false, err
+		}
+		stateChanged = stateChanged || foreignRemoved
 	}
 	actualTimestamps := map[string]string{}
 	pickTimestamp := func(annotation, timestamp string) string {
@@ -253,6 +249,7 @@ func (c *cephDeploymentConfig) ensureCephConfig(cephClusterPresent bool) (bool,
 		newAnnotations[cephRuntimeOsdParametersUpdateTimestampLabel] = resourceUpdateTimestamps.osdRuntimeParams
 	}
 	configMapUpdated := !reflect.DeepEqual(currentRookCm.Data, newRookCm.Data)
+	previousRookCm := currentRookCm.DeepCopy()
 	annotationsUpdated := !reflect.DeepEqual(currentRookCm.Annotations, newAnnotations)
 	labelsUpdated := lcmcommon.AlignBaseLabels(*c.log, "configmap", &currentRookCm.ObjectMeta, newRookCm.Labels)
 	if configMapUpdated || annotationsUpdated || labelsUpdated {
@@ -271,13 +268,34 @@ func (c *cephDeploymentConfig) ensureCephConfig(cephClusterPresent bool) (bool,
 		if err != nil {
 			return false, err
 		}
+		if configMapUpdated {
+			c.recordCephConfigHistory(previousRookCm, newRookCm.Data)
+		}
 		stateChanged = true
 	}
+	if cephConfigStatus := c.cdConfig.cephDpl.Status.CephConfig; cephConfigStatus != nil {
+		cephConfigStatus.RollbackGeneration = getCephConfigRollbackGeneration(c.cdConfig.cephDpl)
+	}
 	// always set timestamps, based on actual timestamps
 	resourceUpdateTimestamps.cephConfigMap = actualTimestamps
 	return stateChanged, nil
 }
 
+// getRuntimeConfigString returns runtime parameters as a sorted list
+// of key-value lines with masked passwords
+func getRuntimeConfigString(runtimeConfig map[string]string) string {
+	var runtimeString strings.Builder
+	for _, k := range lcmcommon.SortedMapKeys(runtimeConfig) {
+		_, key := getSectionAndKey(k)
+		if lcmcommon.Contains(passwordKeys, key) {
+			runtimeString.WriteString(fmt.Sprintf("%s = *\n", k))
+		} else {
+			runtimeString.WriteString(fmt.Sprintf("%s = %s\n", k, runtimeConfig[k]))
+		}
+	}
+	return runtimeString.String()
+}
+
 func (c *cephDeploymentConfig) updateRuntimeParameters(runtimeConfig map[string]string) (bool, error) {
 	if len(runtimeConfig) == 0 {
 		return false, nil
@@ -329,6 +347,54 @@ func (c *cephDeploymentConfig) updateRuntimeParameters(runtimeConfig map[string]
 	return runtimeUpdated, nil
 }
 
+// removeForeignCephConfigOptions removes options from Ceph config database, which are set
+// outside of CephDeployment, if ceph config drift policy is set to remove them. Since Rook
+// and Ceph daemons set options in Ceph config database as well, only options explicitly
+// listed in drift policy are removed
+func (c *cephDeploymentConfig) removeForeignCephConfigOptions(cephOverrideConfig string, runtimeConfig map[string]string) (bool, error) {
+	driftPolicy := c.cdConfig.cephDpl.Spec.DriftPolicy
+	if driftPolicy == nil || driftPolicy.CephConfig == nil || driftPolicy.CephConfig.Policy != cephlcmv1alpha1.CephConfigDriftRemove {
+		return false, nil
+	}
+	configDump, err := c.getCephConfigDump()
+	if err != nil {
+		return false, err
+	}
+	expectedConfig := lcmcommon.ParseCephConfigOverride(cephOverrideConfig)
+	expectedRuntime := map[string]string{}
+	for key, value := range runtimeConfig {
+		if value != "" {
+			expectedRuntime[key] = value
+		}
+	}
+	lcmcommon.AddClusterSpecCephConfig(expectedConfig, *c.cdConfig.clusterSpec)
+	drift := lcmcommon.GetCephConfigDrift(expectedConfig, expectedRuntime, configDump, driftPolicy.CephConfig.IgnoredOptions)
+	sections := make([]string, 0, len(drift))
+	for section := range drift {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	removed := false
+	for _, section := range sections {
+		for _, option := range drift[section].Unexpected {
+			if !lcmcommon.IsCephConfigOptionListed(section, option.Name, driftPolicy.CephConfig.RemoveOptions) {
+				c.log.Debug().Msgf("skipping removal of ceph config parameter '[%s] %s', it is not listed in drift policy remove options", section, option.Name)
+				continue
+			}
+			cmd := fmt.Sprintf("ceph config rm %s %s", section, option.Name)
+			_, err := lcmcommon.RunCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd)
+			if err != nil {
+				errMsg := fmt.Sprintf("failed to remove '[%s] %s' parameter set outside of CephDeployment", section, option.Name)
+				c.log.Error().Err(err).Msg(errMsg)
+				return removed, errors.Wrap(err, errMsg)
+			}
+			c.log.Info().Msgf("removed ceph config parameter '[%s] %s' set outside of CephDeployment", section, option.Name)
+			removed = true
+		}
+	}
+	return removed, nil
+}
+
 func (c *cephDeploymentConfig) buildCephConfig() (string, map[string]string, map[string]string, error) {
 	baseCephConfig := map[string]configOption{}
 	mergeConfig := func(options []configOption) {
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+const (
+	cephConfigHistoryName = "pelagia-ceph-config-history"
+	// history configmap data key template for applied ceph config generation
+	cephConfigHistoryKeyTmpl = "generation-%d"
+)
+
+// cephConfigHistoryEntry is an applied Ceph config generation kept in history
+type cephConfigHistoryEntry struct {
+	Generation               int64  `json:"generation"`
+	CephDeploymentGeneration int64  `json:"cephDeploymentGeneration,omitempty"`
+	Timestamp                string `json:"timestamp"`
+	RollbackOf               int64  `json:"rollbackOf,omitempty"`
+	Config                   string `json:"config"`
+	Runtime                  string `json:"runtime"`
+}
+
+func getCephConfigRollbackGeneration(cephDpl *cephlcmv1alpha1.CephDeployment) int64 {
+	if cephDpl.Spec.ExtraOpts == nil {
+		return 0
+	}
+	return cephDpl.Spec.ExtraOpts.CephConfigRollbackGeneration
+}
+
+// getCephConfigHistory returns history configmap, if present, and applied
+// ceph config generations sorted from the oldest to the latest
+func (c *cephDeploymentConfig) getCephConfigHistory() (*v1.ConfigMap, []cephConfigHistoryEntry, error) {
+	historyCm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Get(c.context, cephConfigHistoryName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, errors.Wrapf(err, "failed to get configmap %s/%s", c.lcmConfig.RookNamespace, cephConfigHistoryName)
+	}
+	entries := make([]cephConfigHistoryEntry, 0, len(historyCm.Data))
+	for key, data := range historyCm.Data {
+		entry := cephConfigHistoryEntry{}
+		if err := json.Unmarshal([]byte(data), &entry); err != nil {
+			c.log.Warn().Err(err).Msgf("skipping corrupted ceph config history entry '%s' in configmap %s/%s", key, historyCm.Namespace, historyCm.Name)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Generation < entries[j].Generation })
+	return historyCm, entries, nil
+}
+
+// buildTargetCephConfig builds ceph config from spec or, if rollback is requested,
+// restores ceph config from history generation
+func (c *cephDeploymentConfig) buildTargetCephConfig() (string, map[string]string, map[string]string, error) {
+	cephOverrideConfig, runtimeConfig, configHashes, err := c.buildCephConfig()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	rollbackGeneration := getCephConfigRollbackGeneration(c.cdConfig.cephDpl)
+	if rollbackGeneration == 0 {
+		return cephOverrideConfig, runtimeConfig, configHashes, nil
+	}
+	_, entries, err := c.getCephConfigHistory()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	for _, entry := range entries {
+		if entry.Generation != rollbackGeneration {
+			continue
+		}
+		c.log.Warn().Msgf("ceph config rollback to generation %d (applied at %s) is requested, ceph config from spec is not applied", entry.Generation, entry.Timestamp)
+		restoredConfig, restoredHashes := getCephOverrideConfigStringAndHashes(lcmcommon.ParseCephConfigOverride(entry.Config))
+		restoredRuntime := map[string]string{}
+		for key, value := range lcmcommon.ParseCephRuntimeConfig(entry.Runtime) {
+			// masked values are not kept in history, so use actual ones
+			if value == "*" {
+				actual, present := runtimeConfig[key]
+				if !present {
+					continue
+				}
+				value = actual
+			}
+			restoredRuntime[key] = value
+		}
+		return restoredConfig, restoredRuntime, restoredHashes, nil
+	}
+	return "", nil, nil, errors.Errorf("ceph config generation %d is not found in history, available generations: %s",
+		rollbackGeneration, getCephConfigHistoryGenerations(entries))
+}
+
+func getCephConfigHistoryGenerations(entries []cephConfigHistoryEntry) string {
+	generations := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		generations = append(generations, fmt.Sprintf("%d", entry.Generation))
+	}
+	return strings.Join(generations, ",")
+}
+
+// recordCephConfigHistory records applied ceph config in history, history is not
+// critical for ceph config apply, so failure is reported but not returned
+func (c *cephDeploymentConfig) recordCephConfigHistory(previousRookCm *v1.ConfigMap, appliedData map[string]string) {
+	if err := c.ensureCephConfigHistory(previousRookCm, appliedData); err != nil {
+		c.log.Error().Err(err).Msg("failed to record applied ceph config in history")
+	}
+}
+
+// ensureCephConfigHistory records applied ceph config as a new generation in history
+// and keeps history size bounded. If history is empty, previous ceph config is recorded
+// first, so it is always possible to roll back to the config before the latest change
+func (c *cephDeploymentConfig) ensureCephConfigHistory(previousRookCm *v1.ConfigMap, appliedData map[string]string) error {
+	historyCm, entries, err := c.getCephConfigHistory()
+	if err != nil {
+		return err
+	}
+	rollbackGeneration := getCephConfigRollbackGeneration(c.cdConfig.cephDpl)
+	if len(entries) == 0 && previousRookCm != nil {
+		entries = append(entries, cephConfigHistoryEntry{
+			Generation: 1,
+			Timestamp:  previousRookCm.Annotations[cephConfigMapUpdateTimestampLabel],
+			Config:     previousRookCm.Data["config"],
+			Runtime:    previousRookCm.Data["runtime"],
+		})
+	}
+	newEntry := cephConfigHistoryEntry{
+		Generation:               1,
+		CephDeploymentGeneration: c.cdConfig.cephDpl.Generation,
+		Timestamp:                lcmcommon.GetCurrentTimeString(),
+		RollbackOf:               rollbackGeneration,
+		Config:                   appliedData["config"],
+		Runtime:                  appliedData["runtime"],
+	}
+	if len(entries) > 0 {
+		newEntry.Generation = entries[len(entries)-1].Generation + 1
+	}
+	entries = append(entries, newEntry)
+	if historySize := c.lcmConfig.DeployParams.CephConfigHistorySize; historySize > 0 && len(entries) > historySize {
+		entries = entries[len(entries)-historySize:]
+	}
+	newData := map[string]string{}
+	for _, entry := range entries {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return errors.Wrapf(err, "failed to prepare ceph config history generation %d", entry.Generation)
+		}
+		newData[fmt.Sprintf(cephConfigHistoryKeyTmpl, entry.Generation)] = string(raw)
+	}
+	if historyCm == nil {
+		c.log.Info().Msgf("creating configmap %s/%s with ceph config generation %d", c.lcmConfig.RookNamespace, cephConfigHistoryName, newEntry.Generation)
+		historyCm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cephConfigHistoryName,
+				Namespace: c.lcmConfig.RookNamespace,
+				Labels:    baseResourceLabels,
+			},
+			Data: newData,
+		}
+		_, err = c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Create(c.context, historyCm, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create configmap %s/%s", c.lcmConfig.RookNamespace, cephConfigHistoryName)
+		}
+	} else {
+		c.log.Info().Msgf("updating configmap %s/%s with ceph config generation %d", c.lcmConfig.RookNamespace, cephConfigHistoryName, newEntry.Generation)
+		historyCm.Data = newData
+		_, err = c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Update(c.context, historyCm, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to update configmap %s/%s", c.lcmConfig.RookNamespace, cephConfigHistoryName)
+		}
+	}
+	c.cdConfig.cephDpl.Status.CephConfig = &cephlcmv1alpha1.CephDeploymentCephConfigStatus{
+		Generation:         newEntry.Generation,
+		AppliedAt:          newEntry.Timestamp,
+		RollbackGeneration: rollbackGeneration,
+	}
+	return nil
+}
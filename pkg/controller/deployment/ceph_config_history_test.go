@@ -0,0 +1,287 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func getCephConfigHistoryCm(entries ...cephConfigHistoryEntry) v1.ConfigMap {
+	cm := v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cephConfigHistoryName,
+			Namespace: "rook-ceph",
+			Labels:    baseResourceLabels,
+		},
+		Data: map[string]string{},
+	}
+	for _, entry := range entries {
+		raw, _ := json.Marshal(entry)
+		cm.Data[fmt.Sprintf(cephConfigHistoryKeyTmpl, entry.Generation)] = string(raw)
+	}
+	return cm
+}
+
+func TestEnsureCephConfigHistory(t *testing.T) {
+	baseConfig := unitinputs.BaseRookConfigOverride.Data["config"]
+	baseRuntime := unitinputs.BaseRookConfigOverride.Data["runtime"]
+	newData := map[string]string{
+		"config":  baseConfig + "\n[mds]\nmds_cache_memory_limit = 10G\n",
+		"runtime": baseRuntime,
+	}
+	tests := []struct {
+		name               string
+		cephDpl            *cephlcmv1alpha1.CephDeployment
+		previousRookCm     *v1.ConfigMap
+		historyCm          *v1.ConfigMap
+		extraLcmConfig     map[string]string
+		apiErrors          map[string]error
+		expectedResources  map[string]runtime.Object
+		expectedCephConfig *cephlcmv1alpha1.CephDeploymentCephConfigStatus
+		expectedError      string
+	}{
+		{
+			name:          "failed to get ceph config history",
+			cephDpl:       unitinputs.BaseCephDeployment.DeepCopy(),
+			apiErrors:     map[string]error{"get-configmaps": errors.New("failed to get configmap")},
+			expectedError: "failed to get configmap rook-ceph/pelagia-ceph-config-history: failed to get configmap",
+		},
+		{
+			name:    "history is created for created config",
+			cephDpl: unitinputs.BaseCephDeployment.DeepCopy(),
+			expectedResources: map[string]runtime.Object{
+				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
+					getCephConfigHistoryCm(cephConfigHistoryEntry{Generation: 1, Timestamp: "time-5", Config: newData["config"], Runtime: newData["runtime"]}),
+				}},
+			},
+			expectedCephConfig: &cephlcmv1alpha1.CephDeploymentCephConfigStatus{Generation: 1, AppliedAt: "time-5"},
+		},
+		{
+			name: "history is created for updated config, previous config is kept",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.BaseCephDeployment.DeepCopy()
+				cd.Generation = 3
+				return cd
+			}(),
+			previousRookCm: func() *v1.ConfigMap {
+				cm := unitinputs.BaseRookConfigOverride.DeepCopy()
+				cm.Annotations[cephConfigMapUpdateTimestampLabel] = "time-1"
+				return cm
+			}(),
+			expectedResources: map[string]runtime.Object{
+				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1, Timestamp: "time-1", Config: baseConfig, Runtime: baseRuntime},
+						cephConfigHistoryEntry{Generation: 2, CephDeploymentGeneration: 3, Timestamp: "time-5", Config: newData["config"], Runtime: newData["runtime"]},
+					),
+				}},
+			},
+			expectedCephConfig: &cephlcmv1alpha1.CephDeploymentCephConfigStatus{Generation: 2, AppliedAt: "time-5"},
+		},
+		{
+			name: "history is updated and trimmed, rollback is recorded",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.BaseCephDeployment.DeepCopy()
+				cd.Generation = 7
+				cd.Spec.ExtraOpts = &cephlcmv1alpha1.CephDeploymentExtraOpts{CephConfigRollbackGeneration: 4}
+				return cd
+			}(),
+			previousRookCm: unitinputs.BaseRookConfigOverride.DeepCopy(),
+			historyCm: func() *v1.ConfigMap {
+				cm := getCephConfigHistoryCm(
+					cephConfigHistoryEntry{Generation: 4, CephDeploymentGeneration: 5, Timestamp: "time-2", Config: newData["config"], Runtime: newData["runtime"]},
+					cephConfigHistoryEntry{Generation: 5, CephDeploymentGeneration: 6, Timestamp: "time-3", Config: baseConfig, Runtime: baseRuntime},
+					cephConfigHistoryEntry{Generation: 3, CephDeploymentGeneration: 4, Timestamp: "time-1", Config: baseConfig, Runtime: baseRuntime},
+				)
+				cm.Data["generation-2"] = "corrupted"
+				return &cm
+			}(),
+			extraLcmConfig: map[string]string{"DEPLOYMENT_CEPH_CONFIG_HISTORY_SIZE": "2"},
+			expectedResources: map[string]runtime.Object{
+				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 5, CephDeploymentGeneration: 6, Timestamp: "time-3", Config: baseConfig, Runtime: baseRuntime},
+						cephConfigHistoryEntry{Generation: 6, CephDeploymentGeneration: 7, Timestamp: "time-5", RollbackOf: 4, Config: newData["config"], Runtime: newData["runtime"]},
+					),
+				}},
+			},
+			expectedCephConfig: &cephlcmv1alpha1.CephDeploymentCephConfigStatus{Generation: 6, AppliedAt: "time-5", RollbackGeneration: 4},
+		},
+		{
+			name:           "failed to update ceph config history",
+			cephDpl:        unitinputs.BaseCephDeployment.DeepCopy(),
+			previousRookCm: unitinputs.BaseRookConfigOverride.DeepCopy(),
+			historyCm: func() *v1.ConfigMap {
+				cm := getCephConfigHistoryCm(cephConfigHistoryEntry{Generation: 1, Timestamp: "time-1", Config: baseConfig, Runtime: baseRuntime})
+				return &cm
+			}(),
+			apiErrors:     map[string]error{"update-configmaps": errors.New("failed to update configmap")},
+			expectedError: "failed to update configmap rook-ceph/pelagia-ceph-config-history: failed to update configmap",
+		},
+	}
+	oldTimeFunc := lcmcommon.GetCurrentTimeString
+	lcmcommon.GetCurrentTimeString = func() string {
+		return "time-5"
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: test.cephDpl}, test.extraLcmConfig)
+			inputResources := map[string]runtime.Object{
+				"configmaps": unitinputs.ConfigMapListEmpty.DeepCopy(),
+			}
+			if test.historyCm != nil {
+				inputResources["configmaps"] = &v1.ConfigMapList{Items: []v1.ConfigMap{*test.historyCm}}
+			}
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "get", []string{"configmaps"}, inputResources, test.apiErrors)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "create", []string{"configmaps"}, inputResources, test.apiErrors)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "update", []string{"configmaps"}, inputResources, test.apiErrors)
+			test.expectedResources = faketestclients.PrepareExpectedResources(inputResources, test.expectedResources)
+
+			err := c.ensureCephConfigHistory(test.previousRookCm, newData)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.expectedResources, inputResources)
+			assert.Equal(t, test.expectedCephConfig, c.cdConfig.cephDpl.Status.CephConfig)
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+		})
+	}
+	lcmcommon.GetCurrentTimeString = oldTimeFunc
+}
+
+func TestBuildTargetCephConfig(t *testing.T) {
+	baseConfig := unitinputs.BaseRookConfigOverride.Data["config"]
+	historyCm := getCephConfigHistoryCm(
+		cephConfigHistoryEntry{Generation: 1, Timestamp: "time-1", Config: baseConfig,
+			Runtime: "client.rgw.rgw.store.a|rgw_keystone_admin_password = *\nclient.rgw.rgw.store.a|rgw_keystone_barbican_password = *\nosd|bdev_enable_discard = false\n"},
+		cephConfigHistoryEntry{Generation: 2, Timestamp: "time-2", Config: rookConfigRgwOpenstackNoBarbicanNoOverride,
+			Runtime: "client.rgw.rgw.store.a|rgw_keystone_admin_password = *\nosd|bdev_async_discard_threads = 1\nosd|bdev_enable_discard = true\n"},
+	)
+	getCephDpl := func(rollbackGeneration int64) *cephlcmv1alpha1.CephDeployment {
+		cd := unitinputs.CephDeployMoskWithoutIngress.DeepCopy()
+		if rollbackGeneration > 0 {
+			cd.Spec.ExtraOpts = &cephlcmv1alpha1.CephDeploymentExtraOpts{CephConfigRollbackGeneration: rollbackGeneration}
+		}
+		return cd
+	}
+	tests := []struct {
+		name            string
+		cephDpl         *cephlcmv1alpha1.CephDeployment
+		historyCm       *v1.ConfigMap
+		apiErrors       map[string]error
+		expectedConfig  string
+		expectedRuntime map[string]string
+		expectedHashes  map[string]string
+		expectedError   string
+	}{
+		{
+			name:          "failed to build ceph config",
+			cephDpl:       getCephDpl(1),
+			apiErrors:     map[string]error{"get-secrets-openstack-rgw-creds": errors.New("failed to get openstack rgw secret")},
+			expectedError: "failed to get openstack rgw secret",
+		},
+		{
+			name:           "no rollback requested, config from spec",
+			cephDpl:        getCephDpl(0),
+			historyCm:      &historyCm,
+			expectedConfig: rookConfigRgwOpenstackNoBarbicanNoOverride,
+			expectedRuntime: map[string]string{
+				"client.rgw.rgw.store.a|rgw_keystone_admin_password": "auth-password",
+				"osd|bdev_async_discard_threads":                     "1",
+				"osd|bdev_enable_discard":                            "true",
+			},
+			expectedHashes: map[string]string{
+				"global":                 "95b401f9fc7db148cf2cc3bbcbbe09f7722b2060acf714c142fdf07ee249f0bb",
+				"mon":                    "52235ccf3c9f953de0fc2b8e2928f8119e1be19c14a4cf300c55e8498ec81fa2",
+				"client.rgw.rgw.store.a": "c8761bc0ae63593c4bdeb9309fea60abf6dea624f92b18d2dd398c742bdd1750",
+			},
+		},
+		{
+			name:          "failed to get ceph config history",
+			cephDpl:       getCephDpl(1),
+			historyCm:     &historyCm,
+			apiErrors:     map[string]error{"get-configmaps-pelagia-ceph-config-history": errors.New("failed to get configmap")},
+			expectedError: "failed to get configmap rook-ceph/pelagia-ceph-config-history: failed to get configmap",
+		},
+		{
+			name:          "rollback generation is not found",
+			cephDpl:       getCephDpl(5),
+			historyCm:     &historyCm,
+			expectedError: "ceph config generation 5 is not found in history, available generations: 1,2",
+		},
+		{
+			name:           "rollback to generation, masked passwords are restored",
+			cephDpl:        getCephDpl(1),
+			historyCm:      &historyCm,
+			expectedConfig: baseConfig,
+			expectedRuntime: map[string]string{
+				"client.rgw.rgw.store.a|rgw_keystone_admin_password": "auth-password",
+				"osd|bdev_enable_discard":                            "false",
+			},
+			expectedHashes: map[string]string{
+				"global": "95b401f9fc7db148cf2cc3bbcbbe09f7722b2060acf714c142fdf07ee249f0bb",
+				"mon":    "52235ccf3c9f953de0fc2b8e2928f8119e1be19c14a4cf300c55e8498ec81fa2",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: test.cephDpl}, nil)
+			c.cdConfig.currentCephVersion = lcmcommon.LatestRelease
+			err := c.castExtensions()
+			assert.Nil(t, err)
+			inputResources := map[string]runtime.Object{
+				"secrets":    &v1.SecretList{Items: []v1.Secret{*unitinputs.OpenstackRgwCredsSecretNoBarbican.DeepCopy()}},
+				"configmaps": unitinputs.ConfigMapListEmpty.DeepCopy(),
+			}
+			if test.historyCm != nil {
+				inputResources["configmaps"] = &v1.ConfigMapList{Items: []v1.ConfigMap{*test.historyCm.DeepCopy()}}
+			}
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "get", []string{"configmaps", "secrets"}, inputResources, test.apiErrors)
+
+			config, runtimeConfig, hashes, err := c.buildTargetCephConfig()
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.expectedConfig, config)
+			assert.Equal(t, test.expectedRuntime, runtimeConfig)
+			assert.Equal(t, test.expectedHashes, hashes)
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+		})
+	}
+	// unset global var to avoid intersection
+	unsetTimestampsVar()
+}
@@ -182,6 +182,152 @@ func TestUpdateRuntimeParameters(t *testing.T) {
 	lcmcommon.RunPodCommandWithValidation = oldCmdFunc
 }
 
+func TestRemoveForeignCephConfigOptions(t *testing.T) {
+	overrideConfig := "[global]\nosd_pool_default_pg_autoscale_mode = on\n"
+	runtimeConfig := map[string]string{
+		"osd|bdev_async_discard_threads": "1",
+		"osd|bdev_enable_discard":        "true",
+		"global|osd_max_backfills":       "",
+	}
+	cephDplWithPolicy := func(policy cephlcmv1alpha1.CephConfigDriftPolicy) *cephlcmv1alpha1.CephDeployment {
+		cd := unitinputs.BaseCephDeployment.DeepCopy()
+		cd.Spec.DriftPolicy = &cephlcmv1alpha1.CephDeploymentDriftPolicy{CephConfig: &policy}
+		return cd
+	}
+	tests := []struct {
+		name            string
+		cephDpl         *cephlcmv1alpha1.CephDeployment
+		configDump      string
+		configError     bool
+		expectedActions map[string]bool
+		expectedError   string
+	}{
+		{
+			name:            "no drift policy specified, nothing to remove",
+			cephDpl:         &unitinputs.BaseCephDeployment,
+			configDump:      unitinputs.CephConfigDumpOverride,
+			expectedActions: map[string]bool{},
+		},
+		{
+			name:            "report-only drift policy, nothing to remove",
+			cephDpl:         cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{Policy: cephlcmv1alpha1.CephConfigDriftReportOnly}),
+			configDump:      unitinputs.CephConfigDumpOverride,
+			expectedActions: map[string]bool{},
+		},
+		{
+			name:          "remove drift policy, config dump error",
+			cephDpl:       cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{Policy: cephlcmv1alpha1.CephConfigDriftRemove}),
+			configDump:    "{||}",
+			expectedError: "failed to parse output for command 'ceph config dump --format json': invalid character '|' looking for beginning of object key string",
+		},
+		{
+			name:            "remove drift policy, not listed foreign options are kept",
+			cephDpl:         cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{Policy: cephlcmv1alpha1.CephConfigDriftRemove}),
+			configDump:      unitinputs.CephConfigDumpOverride,
+			expectedActions: map[string]bool{},
+		},
+		{
+			name: "remove drift policy, listed foreign options removed",
+			cephDpl: cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{
+				Policy:        cephlcmv1alpha1.CephConfigDriftRemove,
+				RemoveOptions: []string{"osd_max_backfills", "global|osd_recovery_max_active"},
+			}),
+			configDump: unitinputs.CephConfigDumpOverride,
+			expectedActions: map[string]bool{
+				"ceph config rm global osd_max_backfills":       true,
+				"ceph config rm global osd_recovery_max_active": true,
+			},
+		},
+		{
+			name: "remove drift policy, ignored options are kept",
+			cephDpl: cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{
+				Policy:         cephlcmv1alpha1.CephConfigDriftRemove,
+				IgnoredOptions: []string{"global|osd_recovery_max_active"},
+				RemoveOptions:  []string{"osd_max_backfills", "osd_recovery_max_active"},
+			}),
+			configDump: unitinputs.CephConfigDumpOverride,
+			expectedActions: map[string]bool{
+				"ceph config rm global osd_max_backfills": true,
+			},
+		},
+		{
+			name: "remove drift policy, cluster spec ceph config options are kept",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{
+					Policy:        cephlcmv1alpha1.CephConfigDriftRemove,
+					RemoveOptions: []string{"osd_max_backfills", "osd_recovery_max_active"},
+				})
+				clusterSpec, _ := cd.Spec.Cluster.GetSpec()
+				clusterSpec.CephConfig = map[string]map[string]string{
+					"global": {"osd_recovery_max_active": "16"},
+				}
+				cd.Spec.Cluster.Raw = unitinputs.ConvertStructToRaw(clusterSpec)
+				return cd
+			}(),
+			configDump: unitinputs.CephConfigDumpOverride,
+			expectedActions: map[string]bool{
+				"ceph config rm global osd_max_backfills": true,
+			},
+		},
+		{
+			name: "remove drift policy, listed options from any section removed",
+			cephDpl: cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{
+				Policy:        cephlcmv1alpha1.CephConfigDriftRemove,
+				RemoveOptions: []string{"client.rgw.rgw.store.a|rgw_keystone_admin_password", "osd_max_backfills"},
+			}),
+			configDump: unitinputs.CephConfigDumpOverrideWithRgw,
+			expectedActions: map[string]bool{
+				"ceph config rm client.rgw.rgw.store.a rgw_keystone_admin_password": true,
+				"ceph config rm global osd_max_backfills":                           true,
+			},
+		},
+		{
+			name: "remove drift policy, failed to remove option",
+			cephDpl: cephDplWithPolicy(cephlcmv1alpha1.CephConfigDriftPolicy{
+				Policy:        cephlcmv1alpha1.CephConfigDriftRemove,
+				RemoveOptions: []string{"osd_max_backfills"},
+			}),
+			configDump:    unitinputs.CephConfigDumpOverride,
+			configError:   true,
+			expectedError: "failed to remove '[global] osd_max_backfills' parameter set outside of CephDeployment: failed to run command 'ceph config rm global osd_max_backfills': failed to process parameter",
+		},
+	}
+	actions := map[string]bool{}
+	oldCmdFunc := lcmcommon.RunPodCommandWithValidation
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: testCase.cephDpl}, nil)
+			err := c.castExtensions()
+			assert.Nil(t, err)
+			actions = map[string]bool{}
+
+			lcmcommon.RunPodCommandWithValidation = func(e lcmcommon.ExecConfig) (string, string, error) {
+				if strings.Contains(e.Command, "config dump") {
+					return testCase.configDump, "", nil
+				}
+				if strings.Contains(e.Command, "config rm") {
+					actions[e.Command] = true
+					if testCase.configError {
+						return "", "", errors.New("failed to process parameter")
+					}
+					return "", "", nil
+				}
+				return "", "", errors.New("cant run ceph cmd: unknown command")
+			}
+
+			removed, err := c.removeForeignCephConfigOptions(overrideConfig, runtimeConfig)
+			if testCase.expectedError != "" {
+				assert.Equal(t, testCase.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.expectedActions, actions)
+			}
+			assert.Equal(t, len(testCase.expectedActions) > 0, removed)
+		})
+	}
+	lcmcommon.RunPodCommandWithValidation = oldCmdFunc
+}
+
 var rookConfigNoRgwNoOpenstackNoOverrideMultus = `[global]
 mon_max_pg_per_osd = 300
 mon_target_pg_per_osd = 100
@@ -1098,6 +1244,7 @@ func TestEnsureCephConfig(t *testing.T) {
 							newCM.Annotations[globalUpdatedAnnotation] = "time-3"
 							return *newCM
 						}(),
+						getCephConfigHistoryCm(cephConfigHistoryEntry{Generation: 1, Timestamp: "time-3", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]}),
 					},
 				},
 			},
@@ -1193,6 +1340,10 @@ func TestEnsureCephConfig(t *testing.T) {
 						newCM.Annotations[cephRuntimeOsdParametersUpdateTimestampLabel] = "some-osd-time"
 						return *newCM
 					}(),
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1},
+						cephConfigHistoryEntry{Generation: 2, Timestamp: "time-7", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+					),
 				}},
 			},
 		},
@@ -1240,6 +1391,10 @@ func TestEnsureCephConfig(t *testing.T) {
 						newCM.Data["runtime"] = "global|osd_max_backfills = 64\nglobal|osd_recovery_max_active = 16\nglobal|osd_recovery_op_priority = 3\nglobal|osd_recovery_sleep_hdd = 0.000000\nosd|bdev_async_discard_threads = 1\nosd|bdev_enable_discard = true\n"
 						return *newCM
 					}(),
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1, Timestamp: "time-7", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+						cephConfigHistoryEntry{Generation: 2, Timestamp: "time-8", Config: rookConfigNoRgwNoOpenstackOverride, Runtime: "global|osd_max_backfills = 64\nglobal|osd_recovery_max_active = 16\nglobal|osd_recovery_op_priority = 3\nglobal|osd_recovery_sleep_hdd = 0.000000\nosd|bdev_async_discard_threads = 1\nosd|bdev_enable_discard = true\n"},
+					),
 				}},
 			},
 		},
@@ -1287,6 +1442,10 @@ func TestEnsureCephConfig(t *testing.T) {
 						newCM.Annotations["cephdeployment.lcm.mirantis.com/config-client.rgw.rgw.store.a-hash"] = "c8761bc0ae63593c4bdeb9309fea60abf6dea624f92b18d2dd398c742bdd1750"
 						return *newCM
 					}(),
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1, Timestamp: "time-8", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+						cephConfigHistoryEntry{Generation: 2, Timestamp: "time-9", Config: rookConfigRgwOpenstackNoBarbicanNoOverride, Runtime: "client.rgw.rgw.store.a|rgw_keystone_admin_password = *\nosd|bdev_async_discard_threads = 1\nosd|bdev_enable_discard = true\n"},
+					),
 				}},
 			},
 		},
@@ -1393,6 +1552,10 @@ func TestEnsureCephConfig(t *testing.T) {
 						newCM.Annotations[cephRuntimeOsdParametersUpdateTimestampLabel] = "time-11"
 						return *newCM
 					}(),
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1, Timestamp: "time-9", Config: rookConfigRgwOpenstackNoBarbicanNoOverride, Runtime: "client.rgw.rgw.store.a|rgw_keystone_admin_password = *\nosd|bdev_async_discard_threads = 1\nosd|bdev_enable_discard = true\n"},
+						cephConfigHistoryEntry{Generation: 2, Timestamp: "time-11", Config: rookConfigNoRgwNoOpenstackOverride, Runtime: "global|osd_max_backfills = 64\nglobal|osd_recovery_max_active = 16\nglobal|osd_recovery_op_priority = 3\nglobal|osd_recovery_sleep_hdd = 0.000000\nosd|bdev_async_discard_threads = 1\nosd|bdev_enable_discard = true\n"},
+					),
 				}},
 			},
 		},
@@ -1454,6 +1617,10 @@ func TestEnsureCephConfig(t *testing.T) {
 						newCM.Annotations[cephRuntimeOsdParametersUpdateTimestampLabel] = "time-11"
 						return *newCM
 					}(),
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1, Timestamp: "time-9", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+						cephConfigHistoryEntry{Generation: 2, Timestamp: "time-12", Config: rookConfigNoRgwNoOpenstackNoOverrideWithMDS, Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+					),
 				}},
 			},
 		},
@@ -1504,6 +1671,10 @@ func TestEnsureCephConfig(t *testing.T) {
 						newCM.Annotations[cephRuntimeOsdParametersUpdateTimestampLabel] = "time-11"
 						return *newCM
 					}(),
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1, Timestamp: "time-12", Config: rookConfigNoRgwNoOpenstackNoOverrideWithMDS, Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+						cephConfigHistoryEntry{Generation: 2, Timestamp: "time-13", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+					),
 				}},
 			},
 		},
@@ -146,6 +146,7 @@ func (c *cephDeploymentConfig) processCephClients(process objectProcess, client
 		c.log.Error().Err(err).Msg("")
 		return err
 	}
+	c.recordObjectEvent(process, "CephClient", client.Namespace, client.Name)
 	return nil
 }
 
@@ -82,7 +82,7 @@ func (c *cephDeploymentConfig) ensureCluster() (bool, error) {
 	}
 
 	// Generate new ceph cluster spec
-	generatedClusterSpec := generateCephClusterSpec(c.cdConfig.clusterSpec, c.cdConfig.currentCephImage, c.cdConfig.nodesListExpanded)
+	generatedClusterSpec := generateCephClusterSpec(c.cdConfig.clusterSpec, c.cdConfig.currentCephImage, c.cdConfig.nodesListExpanded, resourceUpdateTimestamps.cephConfigMap)
 	// Create/Update/Skip ceph cluster
 	if !cephClusterFound {
 		newCluster := &cephv1.CephCluster{
@@ -93,26 +93,38 @@ func (c *cephDeploymentConfig) ensureCluster() (bool, error) {
 			},
 			Spec: generatedClusterSpec,
 		}
+		markSpecApplied(&newCluster.ObjectMeta, generatedClusterSpec)
 		c.log.Info().Msgf("creating cephcluster %s/%s", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name)
 		_, err := c.api.Rookclientset.CephV1().CephClusters(c.lcmConfig.RookNamespace).Create(c.context, newCluster, metav1.CreateOptions{})
 		if err != nil {
 			return false, errors.Wrapf(err, "failed to create cephcluster %s/%s", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name)
 		}
+		c.recordObjectEvent(objectCreate, "CephCluster", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name)
 		return true, nil
 	}
 
-	// since osd params usually updated at runtime, no restart is required,
-	// but if operator specified manually reason for restart - set it
-	// keep annotations in cephcluster to control changes
-	if c.cdConfig.cephDpl.Spec.ExtraOpts != nil && c.cdConfig.cephDpl.Spec.ExtraOpts.OsdRestartReason != "" {
-		if cephCluster.Annotations == nil {
-			cephCluster.Annotations = map[string]string{}
+	// monitors removal is handled one by one, so monitors count is
+	// decreased only when obsolete monitor left monmap
+	if !c.cdConfig.clusterSpec.External.Enable && cephDeployed {
+		monCount, monsChanged, err := c.ensureMonitorsPlacement(generatedClusterSpec.Mon.Count)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to ensure Ceph Monitors placement")
 		}
-		if cephCluster.Annotations[cephRestartOsdLabel] != c.cdConfig.cephDpl.Spec.ExtraOpts.OsdRestartReason {
-			cephCluster.Annotations[cephRestartOsdLabel] = c.cdConfig.cephDpl.Spec.ExtraOpts.OsdRestartReason
-			cephCluster.Annotations[cephRestartOsdTimestampLabel] = lcmcommon.GetCurrentTimeString()
+		generatedClusterSpec.Mon.Count = monCount
+		changed = changed || monsChanged
+		// managers are moved from nodes without mgr role with active manager fail over
+		mgrCount, mgrsChanged, err := c.ensureManagersPlacement(generatedClusterSpec.Mgr.Count)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to ensure Ceph Managers placement")
 		}
+		generatedClusterSpec.Mgr.Count = mgrCount
+		changed = changed || mgrsChanged
 	}
+
+	// since osd params usually updated at runtime, no restart is required,
+	// if operator specified manually reason for restart - osds are restarted
+	// one failure domain at a time, see ensureOsdRestart. Keep osd annotations,
+	// which are set by previous versions, to not restart all osds at once
 	if _, ok := cephCluster.Annotations[cephRestartOsdLabel]; ok {
 		if _, ok := generatedClusterSpec.Annotations[cephv1.KeyOSD]; !ok {
 			generatedClusterSpec.Annotations[cephv1.KeyOSD] = map[string]string{}
@@ -122,8 +134,8 @@ func (c *cephDeploymentConfig) ensureCluster() (bool, error) {
 	}
 
 	labelsUpdated := lcmcommon.AlignBaseLabels(*c.log, "CephCluster", &cephCluster.ObjectMeta, baseResourceLabels)
-	specUpdated := !reflect.DeepEqual(cephCluster.Spec, generatedClusterSpec)
-	if specUpdated || labelsUpdated {
+	specUpdated, annotationsUpdated := c.checkObjectDrift("CephCluster", &cephCluster.ObjectMeta, cephCluster.Spec, generatedClusterSpec)
+	if specUpdated || labelsUpdated || annotationsUpdated {
 		c.log.Info().Msgf("updating cephcluster %s/%s", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name)
 		if specUpdated {
 			lcmcommon.ShowObjectDiff(*c.log, cephCluster.Spec, generatedClusterSpec)
@@ -161,6 +173,7 @@ func (c *cephDeploymentConfig) deleteCluster() (bool, error) {
 	if err != nil {
 		return false, errors.Wrap(err, "failed to delete ceph cluster")
 	}
+	c.recordObjectEvent(objectDelete, "CephCluster", c.lcmConfig.RookNamespace, cluster.Name)
 	return false, nil
 }
 
@@ -221,7 +234,7 @@ func (c *cephDeploymentConfig) healthCluster(cephStatus *cephv1.CephStatus) bool
 	return true
 }
 
-func generateCephClusterSpec(cephClusterSpec *cephv1.ClusterSpec, image string, nodesExpanded []cephlcmv1alpha1.CephDeploymentNode) cephv1.ClusterSpec {
+func generateCephClusterSpec(cephClusterSpec *cephv1.ClusterSpec, image string, nodesExpanded []cephlcmv1alpha1.CephDeploymentNode, configTimestamps map[string]string) cephv1.ClusterSpec {
 	clusterSpec := cephClusterSpec.DeepCopy()
 	clusterSpec.CephVersion.Image = image
 
@@ -247,13 +260,13 @@ func generateCephClusterSpec(cephClusterSpec *cephv1.ClusterSpec, image string,
 	if clusterSpec.Annotations == nil {
 		clusterSpec.Annotations = map[cephv1.KeyType]cephv1.Annotations{}
 	}
-	monAnnotations := map[string]string{fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "global"): resourceUpdateTimestamps.cephConfigMap["global"]}
-	if resourceUpdateTimestamps.cephConfigMap["mon"] != "" {
-		monAnnotations[fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "mon")] = resourceUpdateTimestamps.cephConfigMap["mon"]
+	monAnnotations := map[string]string{fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "global"): configTimestamps["global"]}
+	if configTimestamps["mon"] != "" {
+		monAnnotations[fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "mon")] = configTimestamps["mon"]
 	}
-	mgrAnnotations := map[string]string{fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "global"): resourceUpdateTimestamps.cephConfigMap["global"]}
-	if resourceUpdateTimestamps.cephConfigMap["mgr"] != "" {
-		mgrAnnotations[fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "mgr")] = resourceUpdateTimestamps.cephConfigMap["mgr"]
+	mgrAnnotations := map[string]string{fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "global"): configTimestamps["global"]}
+	if configTimestamps["mgr"] != "" {
+		mgrAnnotations[fmt.Sprintf(cephConfigParametersUpdateTimestampLabel, "mgr")] = configTimestamps["mgr"]
 	}
 	clusterSpec.Annotations[cephv1.KeyMon] = cephv1.GetMonAnnotations(clusterSpec.Annotations).Merge(monAnnotations)
 	clusterSpec.Annotations[cephv1.KeyMgr] = cephv1.GetMgrAnnotations(clusterSpec.Annotations).Merge(mgrAnnotations)
@@ -439,6 +452,14 @@ func buildStorageNodes(cephDplNodes []cephlcmv1alpha1.CephDeploymentNode) []ceph
 	for _, nodeName := range nodeNames {
 		node := cephDplNodes[nodeMap[nodeName]]
 		newNode := node.Node
+		// place node OSDs to crush root, if node is assigned to non-default one
+		if root := node.Crush[crushRootKey]; root != "" && root != defaultCrushRoot {
+			newNode.Config = map[string]string{}
+			for k, v := range node.Config {
+				newNode.Config[k] = v
+			}
+			newNode.Config["crushRoot"] = root
+		}
 		if len(node.Devices) > 0 {
 			devices := []cephv1.Device{}
 			for _, dev := range node.Devices {
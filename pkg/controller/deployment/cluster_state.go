@@ -174,7 +174,7 @@ func (c *cephDeploymentConfig) verifyBuiltinPools() (bool, error) {
 					Name:          cephpool,
 					UseAsFullName: true,
 					PoolSpec:      runtime.RawExtension{Raw: poolData},
-				}, c.lcmConfig.RookNamespace)
+				}, nil, c.lcmConfig.RookNamespace)
 				builtinCephPool.Spec.EnableCrushUpdates = lcmcommon.PtrTo(true)
 				builtinPoolsToProcess = append(builtinPoolsToProcess, *builtinCephPool)
 			} else {
@@ -188,7 +188,8 @@ func (c *cephDeploymentConfig) verifyBuiltinPools() (bool, error) {
 								Name:          cephpool,
 								UseAsFullName: true,
 								PoolSpec:      cephDplPool.PoolSpec,
-							}, c.lcmConfig.RookNamespace)
+								CrushRule:     cephDplPool.CrushRule,
+							}, c.cdConfig.cephDpl.Spec.Crush, c.lcmConfig.RookNamespace)
 
 							builtinCephPool.Spec.EnableCrushUpdates = &foundDefault
 							// unset target size ratio for builtin pools, if default pool has targetsize ratio set
@@ -271,13 +271,53 @@ placement:
 			c := fakeDeploymentConfig(&deployConfig{cephDpl: test.cephDpl}, nil)
 			err := c.castExtensions()
 			assert.Nil(t, err)
-			cephClusterSpec := generateCephClusterSpec(c.cdConfig.clusterSpec, unitinputs.PelagiaConfig.Data["DEPLOYMENT_CEPH_IMAGE"], c.cdConfig.nodesListExpanded)
+			cephClusterSpec := generateCephClusterSpec(c.cdConfig.clusterSpec, unitinputs.PelagiaConfig.Data["DEPLOYMENT_CEPH_IMAGE"], c.cdConfig.nodesListExpanded, resourceUpdateTimestamps.cephConfigMap)
 			assert.Equal(t, test.expectedClusterSpec, cephClusterSpec)
 		})
 	}
 	unsetTimestampsVar()
 }
 
+func TestBuildStorageNodes(t *testing.T) {
+	cephDplNodes := []cephlcmv1alpha1.CephDeploymentNode{
+		{
+			Node: cephv1.Node{
+				Name:      "node-2",
+				Selection: cephv1.Selection{DeviceFilter: "vdb"},
+				Config:    map[string]string{"deviceClass": "nvme"},
+			},
+			Crush: map[string]string{"root": "nvme", "rack": "rack-1"},
+		},
+		{
+			Node: cephv1.Node{
+				Name:      "node-1",
+				Selection: cephv1.Selection{DeviceFilter: "vdb"},
+				Config:    map[string]string{"deviceClass": "hdd"},
+			},
+			Crush: map[string]string{"root": "default"},
+		},
+		{
+			Node:  cephv1.Node{Name: "node-3"},
+			Roles: []string{"mon", "mgr"},
+		},
+	}
+	expectedNodes := []cephv1.Node{
+		{
+			Name:      "node-1",
+			Selection: cephv1.Selection{DeviceFilter: "vdb"},
+			Config:    map[string]string{"deviceClass": "hdd"},
+		},
+		{
+			Name:      "node-2",
+			Selection: cephv1.Selection{DeviceFilter: "vdb"},
+			Config:    map[string]string{"deviceClass": "nvme", "crushRoot": "nvme"},
+		},
+	}
+	assert.Equal(t, expectedNodes, buildStorageNodes(cephDplNodes))
+	// spec node config is not changed
+	assert.Equal(t, map[string]string{"deviceClass": "nvme"}, cephDplNodes[0].Config)
+}
+
 func TestEnsureCluster(t *testing.T) {
 	getCluster := func(annotations map[cephv1.KeyType]cephv1.Annotations) cephv1.CephCluster {
 		cc := unitinputs.CephClusterGenerated.DeepCopy()
@@ -348,6 +388,7 @@ func TestEnsureCluster(t *testing.T) {
 						cm.Annotations["cephdeployment.lcm.mirantis.com/config-global-updated"] = "time-3"
 						return *cm
 					}(),
+					getCephConfigHistoryCm(cephConfigHistoryEntry{Generation: 1, Timestamp: "time-3", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]}),
 				}},
 			},
 			expectedError: "failed to create cephcluster rook-ceph/cephcluster: failed to create cluster",
@@ -362,7 +403,7 @@ func TestEnsureCluster(t *testing.T) {
 			expectedResources: map[string]runtime.Object{
 				"cephclusters": &cephv1.CephClusterList{
 					Items: []cephv1.CephCluster{
-						getCluster(map[cephv1.KeyType]cephv1.Annotations{
+						getAppliedCluster(getCluster(map[cephv1.KeyType]cephv1.Annotations{
 							cephv1.KeyMon: map[string]string{
 								"cephdeployment.lcm.mirantis.com/config-global-updated": "time-4",
 								"cephdeployment.lcm.mirantis.com/config-mon-updated":    "time-4",
@@ -370,7 +411,7 @@ func TestEnsureCluster(t *testing.T) {
 							cephv1.KeyMgr: map[string]string{
 								"cephdeployment.lcm.mirantis.com/config-global-updated": "time-4",
 							},
-						}),
+						})),
 					},
 				},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
@@ -381,6 +422,7 @@ func TestEnsureCluster(t *testing.T) {
 						cm.Annotations["cephdeployment.lcm.mirantis.com/config-global-updated"] = "time-4"
 						return *cm
 					}(),
+					getCephConfigHistoryCm(cephConfigHistoryEntry{Generation: 1, Timestamp: "time-4", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]}),
 				}},
 			},
 			updated: true,
@@ -389,7 +431,7 @@ func TestEnsureCluster(t *testing.T) {
 			name:    "update cluster failed",
 			cephDpl: &unitinputs.BaseCephDeployment,
 			inputResources: map[string]runtime.Object{
-				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterGenerated.DeepCopy()}},
+				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(unitinputs.CephClusterGenerated)}},
 				"configmaps":   &v1.ConfigMapList{Items: []v1.ConfigMap{*unitinputs.RookCephMonEndpoints.DeepCopy()}},
 			},
 			apiErrors: map[string]error{"update-cephclusters": errors.New("failed to update cluster")},
@@ -403,6 +445,7 @@ func TestEnsureCluster(t *testing.T) {
 						cm.Annotations["cephdeployment.lcm.mirantis.com/config-global-updated"] = "time-5"
 						return *cm
 					}(),
+					getCephConfigHistoryCm(cephConfigHistoryEntry{Generation: 1, Timestamp: "time-5", Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]}),
 				}},
 			},
 			expectedError: "failed to update cephcluster rook-ceph/cephcluster: failed to update cluster",
@@ -412,7 +455,7 @@ func TestEnsureCluster(t *testing.T) {
 			cephDpl: &unitinputs.CephDeployRookConfigNoRuntimeNoOsd,
 			inputResources: map[string]runtime.Object{
 				"cephclusters": &cephv1.CephClusterList{
-					Items: []cephv1.CephCluster{*getClusterEnsure.DeepCopy()},
+					Items: []cephv1.CephCluster{getAppliedCluster(getClusterEnsure)},
 				},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
 					func() v1.ConfigMap {
@@ -424,6 +467,21 @@ func TestEnsureCluster(t *testing.T) {
 					unitinputs.RookCephMonEndpoints,
 				}},
 			},
+			expectedResources: map[string]runtime.Object{
+				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
+					func() v1.ConfigMap {
+						cm := unitinputs.BaseRookConfigOverride.DeepCopy()
+						cm.Annotations["cephdeployment.lcm.mirantis.com/config-mon-updated"] = "time-6"
+						cm.Annotations["cephdeployment.lcm.mirantis.com/config-global-updated"] = "time-6"
+						return *cm
+					}(),
+					unitinputs.RookCephMonEndpoints,
+					getCephConfigHistoryCm(
+						cephConfigHistoryEntry{Generation: 1, Config: unitinputs.BaseRookConfigOverride.Data["config"], Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+						cephConfigHistoryEntry{Generation: 2, Timestamp: "time-6", Config: rookConfigNoRgwNoOpenstackOverride, Runtime: unitinputs.BaseRookConfigOverride.Data["runtime"]},
+					),
+				}},
+			},
 			updated: true,
 		},
 		{
@@ -431,7 +489,7 @@ func TestEnsureCluster(t *testing.T) {
 			cephDpl: &unitinputs.BaseCephDeployment,
 			inputResources: map[string]runtime.Object{
 				"cephclusters": &cephv1.CephClusterList{
-					Items: []cephv1.CephCluster{*getClusterEnsure.DeepCopy()},
+					Items: []cephv1.CephCluster{getAppliedCluster(getClusterEnsure)},
 				},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
 					func() v1.ConfigMap {
@@ -450,7 +508,7 @@ func TestEnsureCluster(t *testing.T) {
 			cephDpl: &unitinputs.BaseCephDeployment,
 			inputResources: map[string]runtime.Object{
 				"cephclusters": &cephv1.CephClusterList{
-					Items: []cephv1.CephCluster{*unitinputs.CephClusterGenerated.DeepCopy()},
+					Items: []cephv1.CephCluster{getAppliedCluster(unitinputs.CephClusterGenerated)},
 				},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
 					func() v1.ConfigMap {
@@ -464,13 +522,13 @@ func TestEnsureCluster(t *testing.T) {
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephclusters": &cephv1.CephClusterList{
-					Items: []cephv1.CephCluster{*getClusterEnsure.DeepCopy()},
+					Items: []cephv1.CephCluster{getAppliedCluster(getClusterEnsure)},
 				},
 			},
 			updated: true,
 		},
 		{
-			name: "update cluster - set osd restart reason and labels",
+			name: "update cluster - osd restart reason is not set to cephcluster",
 			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
 				mc := unitinputs.BaseCephDeployment.DeepCopy()
 				mc.Spec.ExtraOpts = &cephlcmv1alpha1.CephDeploymentExtraOpts{
@@ -482,9 +540,9 @@ func TestEnsureCluster(t *testing.T) {
 				"cephclusters": &cephv1.CephClusterList{
 					Items: []cephv1.CephCluster{
 						func() cephv1.CephCluster {
-							cl := unitinputs.CephClusterGenerated.DeepCopy()
+							cl := getAppliedCluster(unitinputs.CephClusterGenerated)
 							cl.Labels = nil
-							return *cl
+							return cl
 						}(),
 					},
 				},
@@ -499,6 +557,22 @@ func TestEnsureCluster(t *testing.T) {
 				}},
 			},
 			expectedResources: map[string]runtime.Object{
+				"cephclusters": &cephv1.CephClusterList{
+					Items: []cephv1.CephCluster{getAppliedCluster(getClusterEnsure)},
+				},
+			},
+			updated: true,
+		},
+		{
+			name: "no update cluster - osd restart reason not changed",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				mc := unitinputs.BaseCephDeployment.DeepCopy()
+				mc.Spec.ExtraOpts = &cephlcmv1alpha1.CephDeploymentExtraOpts{
+					OsdRestartReason: "cephcluster unit test",
+				}
+				return mc
+			}(),
+			inputResources: map[string]runtime.Object{
 				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{
 					func() cephv1.CephCluster {
 						cl := *getClusterEnsure.DeepCopy()
@@ -510,18 +584,26 @@ func TestEnsureCluster(t *testing.T) {
 							"cephdeployment.lcm.mirantis.com/restart-osd-reason":    "cephcluster unit test",
 							"cephdeployment.lcm.mirantis.com/restart-osd-requested": "time-9",
 						}
-						return cl
+						return getAppliedCluster(cl)
+					}(),
+				}},
+				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
+					func() v1.ConfigMap {
+						cm := unitinputs.BaseRookConfigOverride.DeepCopy()
+						cm.Annotations["cephdeployment.lcm.mirantis.com/config-generated"] = "time-6"
+						cm.Annotations["cephdeployment.lcm.mirantis.com/config-mon-updated"] = "time-6"
+						cm.Annotations["cephdeployment.lcm.mirantis.com/config-global-updated"] = "time-6"
+						return *cm
 					}(),
 				}},
 			},
-			updated: true,
 		},
 		{
-			name: "no update cluster - osd restart reason not changed",
+			name: "no update cluster - osd restart reason changed, osds are not restarted by rook",
 			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
 				mc := unitinputs.BaseCephDeployment.DeepCopy()
 				mc.Spec.ExtraOpts = &cephlcmv1alpha1.CephDeploymentExtraOpts{
-					OsdRestartReason: "cephcluster unit test",
+					OsdRestartReason: "new cephcluster unit test",
 				}
 				return mc
 			}(),
@@ -537,7 +619,7 @@ func TestEnsureCluster(t *testing.T) {
 							"cephdeployment.lcm.mirantis.com/restart-osd-reason":    "cephcluster unit test",
 							"cephdeployment.lcm.mirantis.com/restart-osd-requested": "time-9",
 						}
-						return cl
+						return getAppliedCluster(cl)
 					}(),
 				}},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
@@ -566,7 +648,7 @@ func TestEnsureCluster(t *testing.T) {
 							"cephdeployment.lcm.mirantis.com/restart-osd-reason":    "cephcluster unit test",
 							"cephdeployment.lcm.mirantis.com/restart-osd-requested": "time-9",
 						}
-						return cl
+						return getAppliedCluster(cl)
 					}(),
 				}},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
@@ -602,9 +684,9 @@ func TestEnsureCluster(t *testing.T) {
 			expectedResources: map[string]runtime.Object{
 				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{
 					func() cephv1.CephCluster {
-						cl := unitinputs.CephClusterExternal.DeepCopy()
+						cl := getAppliedCluster(unitinputs.CephClusterExternal)
 						cl.Status = cephv1.ClusterStatus{}
-						return *cl
+						return cl
 					}(),
 				}},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{unitinputs.RookCephMonEndpointsExternal}},
@@ -618,7 +700,7 @@ func TestEnsureCluster(t *testing.T) {
 			name:    "update cluster - external",
 			cephDpl: unitinputs.CephDeployExternal.DeepCopy(),
 			inputResources: map[string]runtime.Object{
-				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterExternal.DeepCopy()}},
+				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(unitinputs.CephClusterExternal)}},
 				"configmaps":   &v1.ConfigMapList{Items: []v1.ConfigMap{*unitinputs.RookCephMonEndpointsExternal.DeepCopy()}},
 				"secrets": &v1.SecretList{
 					Items: []v1.Secret{*unitinputs.RookCephMonSecretNonAdmin.DeepCopy(), unitinputs.ExternalConnectionSecretWithAdmin},
@@ -630,7 +712,7 @@ func TestEnsureCluster(t *testing.T) {
 			name:    "no update cluster - external",
 			cephDpl: unitinputs.CephDeployExternal.DeepCopy(),
 			inputResources: map[string]runtime.Object{
-				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterExternal.DeepCopy()}},
+				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(unitinputs.CephClusterExternal)}},
 				"configmaps": &v1.ConfigMapList{Items: []v1.ConfigMap{
 					func() v1.ConfigMap {
 						cm := unitinputs.RookCephMonEndpointsExternal.DeepCopy()
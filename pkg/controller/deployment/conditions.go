@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// applyConditionTypes is an ordered list of all condition types
+// set on CephDeployment status during configuration apply
+var applyConditionTypes = []string{
+	cephlcmv1alpha1.ConditionTypeCluster,
+	cephlcmv1alpha1.ConditionTypePools,
+	cephlcmv1alpha1.ConditionTypeSharedFilesystem,
+	cephlcmv1alpha1.ConditionTypeStorageClasses,
+	cephlcmv1alpha1.ConditionTypeClients,
+	cephlcmv1alpha1.ConditionTypeObjectStorage,
+	cephlcmv1alpha1.ConditionTypeRBDMirror,
+	cephlcmv1alpha1.ConditionTypeCSI,
+	cephlcmv1alpha1.ConditionTypeNetworkPolicy,
+	cephlcmv1alpha1.ConditionTypeOpenstackSecret,
+	cephlcmv1alpha1.ConditionTypeClusterState,
+}
+
+// applyConditionResult collects ensure steps results related to one condition type
+type applyConditionResult struct {
+	changed []string
+	failed  []string
+	pending []string
+	paused  bool
+}
+
+type applyConditionResults map[string]*applyConditionResult
+
+func (results applyConditionResults) get(conditionType string) *applyConditionResult {
+	if _, present := results[conditionType]; !present {
+		results[conditionType] = &applyConditionResult{}
+	}
+	return results[conditionType]
+}
+
+func (results applyConditionResults) addResult(conditionType, ensureResource string, changed bool, err error) {
+	result := results.get(conditionType)
+	if err != nil {
+		result.failed = append(result.failed, ensureResource)
+	} else if changed {
+		result.changed = append(result.changed, ensureResource)
+	}
+}
+
+func (results applyConditionResults) addPending(conditionType, ensureResource string) {
+	result := results.get(conditionType)
+	result.pending = append(result.pending, ensureResource)
+}
+
+func (results applyConditionResults) setPaused(conditionType string) {
+	results.get(conditionType).paused = true
+}
+
+func getConditionTransitionTime() metav1.Time {
+	transitionTime, err := time.Parse(time.RFC3339, lcmcommon.GetCurrentTimeString())
+	if err != nil {
+		transitionTime = time.Now()
+	}
+	return metav1.NewTime(transitionTime.Local())
+}
+
+func newApplyCondition(conditionType string, result *applyConditionResult, generation int64) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		ObservedGeneration: generation,
+		LastTransitionTime: getConditionTransitionTime(),
+	}
+	if result.paused {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = cephlcmv1alpha1.ConditionReasonPaused
+		condition.Message = "configuration apply is paused"
+	} else if len(result.failed) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = cephlcmv1alpha1.ConditionReasonFailed
+		condition.Message = fmt.Sprintf("failed to ensure %s", strings.Join(result.failed, ", "))
+	} else if len(result.changed) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = cephlcmv1alpha1.ConditionReasonInProgress
+		condition.Message = fmt.Sprintf("configuration apply is in progress: %s", strings.Join(result.changed, ", "))
+	} else if len(result.pending) > 0 {
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = cephlcmv1alpha1.ConditionReasonPending
+		condition.Message = fmt.Sprintf("configuration apply is postponed until nodes and network policies are configured: %s", strings.Join(result.pending, ", "))
+	} else {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = cephlcmv1alpha1.ConditionReasonApplied
+		condition.Message = "configuration successfully applied"
+	}
+	return condition
+}
+
+// setApplyConditions updates CephDeployment status conditions with configuration
+// apply results, conditions for not applicable subsystems are removed
+func (c *cephDeploymentConfig) setApplyConditions(results applyConditionResults) {
+	status := &c.cdConfig.cephDpl.Status
+	for _, conditionType := range applyConditionTypes {
+		result, present := results[conditionType]
+		if !present {
+			meta.RemoveStatusCondition(&status.Conditions, conditionType)
+			continue
+		}
+		meta.SetStatusCondition(&status.Conditions, newApplyCondition(conditionType, result, c.cdConfig.cephDpl.Generation))
+	}
+}
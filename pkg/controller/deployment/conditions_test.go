@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func getTestApplyConditions(generation int64, transitionTime string, conditions ...metav1.Condition) []metav1.Condition {
+	parsedTime, _ := time.Parse(time.RFC3339, transitionTime)
+	for idx := range conditions {
+		conditions[idx].ObservedGeneration = generation
+		conditions[idx].LastTransitionTime = metav1.NewTime(parsedTime.Local())
+	}
+	return conditions
+}
+
+func appliedTestCondition(conditionType string) metav1.Condition {
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  cephlcmv1alpha1.ConditionReasonApplied,
+		Message: "configuration successfully applied",
+	}
+}
+
+func inProgressTestCondition(conditionType, steps string) metav1.Condition {
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  cephlcmv1alpha1.ConditionReasonInProgress,
+		Message: "configuration apply is in progress: " + steps,
+	}
+}
+
+func failedTestCondition(conditionType, steps string) metav1.Condition {
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  cephlcmv1alpha1.ConditionReasonFailed,
+		Message: "failed to ensure " + steps,
+	}
+}
+
+func pendingTestCondition(conditionType, steps string) metav1.Condition {
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionUnknown,
+		Reason:  cephlcmv1alpha1.ConditionReasonPending,
+		Message: "configuration apply is postponed until nodes and network policies are configured: " + steps,
+	}
+}
+
+func pausedTestCondition(conditionType string) metav1.Condition {
+	return metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionUnknown,
+		Reason:  cephlcmv1alpha1.ConditionReasonPaused,
+		Message: "configuration apply is paused",
+	}
+}
+
+func TestSetApplyConditions(t *testing.T) {
+	oldTimeFunc := lcmcommon.GetCurrentTimeString
+	cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+	c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl}, nil)
+
+	lcmcommon.GetCurrentTimeString = func() string {
+		return "2021-08-15T14:30:45+04:00"
+	}
+	results := applyConditionResults{}
+	results.addResult(cephlcmv1alpha1.ConditionTypeCluster, "label nodes", false, nil)
+	results.addResult(cephlcmv1alpha1.ConditionTypeCluster, "cephcluster", true, nil)
+	results.addResult(cephlcmv1alpha1.ConditionTypeNetworkPolicy, "network policies", false, nil)
+	results.addResult(cephlcmv1alpha1.ConditionTypeClients, "cephclients", true, errors.New("failed"))
+	results.addPending(cephlcmv1alpha1.ConditionTypePools, "cephblockpools")
+	results.addResult(cephlcmv1alpha1.ConditionTypeObjectStorage, "ceph object storage", false, nil)
+	results.addPending(cephlcmv1alpha1.ConditionTypeObjectStorage, "ingress proxy")
+	results.setPaused(cephlcmv1alpha1.ConditionTypeCSI)
+	c.setApplyConditions(results)
+	assert.Equal(t, getTestApplyConditions(10, "2021-08-15T14:30:45+04:00",
+		inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "cephcluster"),
+		pendingTestCondition(cephlcmv1alpha1.ConditionTypePools, "cephblockpools"),
+		failedTestCondition(cephlcmv1alpha1.ConditionTypeClients, "cephclients"),
+		pendingTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage, "ingress proxy"),
+		pausedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+		appliedTestCondition(cephlcmv1alpha1.ConditionTypeNetworkPolicy),
+	), cephDpl.Status.Conditions)
+
+	// transition time is updated only for conditions with changed status
+	lcmcommon.GetCurrentTimeString = func() string {
+		return "2021-08-15T14:35:45+04:00"
+	}
+	cephDpl.Generation = 11
+	results = applyConditionResults{}
+	results.addResult(cephlcmv1alpha1.ConditionTypeCluster, "label nodes", false, nil)
+	results.addResult(cephlcmv1alpha1.ConditionTypeCluster, "cephcluster", false, nil)
+	results.addResult(cephlcmv1alpha1.ConditionTypeClients, "cephclients", true, nil)
+	results.addResult(cephlcmv1alpha1.ConditionTypeObjectStorage, "ceph object storage", false, nil)
+	results.addResult(cephlcmv1alpha1.ConditionTypeObjectStorage, "ingress proxy", false, nil)
+	c.setApplyConditions(results)
+	expected := []metav1.Condition{
+		getTestApplyConditions(11, "2021-08-15T14:35:45+04:00", appliedTestCondition(cephlcmv1alpha1.ConditionTypeCluster))[0],
+		getTestApplyConditions(11, "2021-08-15T14:30:45+04:00", inProgressTestCondition(cephlcmv1alpha1.ConditionTypeClients, "cephclients"))[0],
+		getTestApplyConditions(11, "2021-08-15T14:35:45+04:00", appliedTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage))[0],
+	}
+	assert.Equal(t, expected, cephDpl.Status.Conditions)
+	lcmcommon.GetCurrentTimeString = oldTimeFunc
+}
@@ -34,6 +34,10 @@ const (
 
 	rookConfigOverrideName      = "rook-config-override"
 	rookCephMonEndpointsMapName = "rook-ceph-mon-endpoints"
+	// rook deployment name template for Ceph Monitor
+	rookCephMonDeploymentTemplate = "rook-ceph-mon-%s"
+	// rook label selector for Ceph Manager pods and deployments
+	rookCephMgrLabelSelector = "app=rook-ceph-mgr"
 	// ceph csi operator resources
 	cephCsiOperatorConfigName         = "ceph-csi-operator-config"
 	cephCsiOperatorImageConfigMapName = "rook-csi-operator-image-set-configmap"
@@ -76,8 +80,21 @@ const (
 
 	//PoolPreserveOnDeleteAnnotation label prevents removing CephBlockPool by Pelagia controller
 	poolPreserveOnDeleteAnnotation = "cephdeployment.lcm.mirantis.com/preserve-on-delete"
+	// planModeAnnotation enables plan mode: configuration changes are calculated
+	// and published to the plan ConfigMap, but not applied
+	planModeAnnotation = "cephdeployment.lcm.mirantis.com/plan"
+	// appliedSpecHashAnnotation keeps hash of the last spec applied to managed Rook object
+	appliedSpecHashAnnotation = "cephdeployment.lcm.mirantis.com/applied-spec-hash"
+	// adoptedSpecHashAnnotation keeps hash of generated spec, for which changes
+	// made outside of CephDeployment are adopted
+	adoptedSpecHashAnnotation = "cephdeployment.lcm.mirantis.com/adopted-spec-hash"
+	// planConfigMapTemplate is a name template for ConfigMap with configuration plan
+	planConfigMapTemplate = "%s-plan"
 	// subVolumeGroupName is default subvolumegroup name to create for cephfs csi
 	subVolumeGroupName = "csi"
+	// pool parameters used by PG autoscaler to estimate expected pool size
+	poolTargetSizeRatioParam = "target_size_ratio"
+	poolTargetSizeBytesParam = "target_size_bytes"
 )
 
 type objectProcess string
@@ -113,6 +130,10 @@ var (
 		"region":     "topology.kubernetes.io/region",
 		"zone":       "topology.kubernetes.io/zone",
 	}
+	// node crush section key to assign node to crush root, which is not a topology label
+	crushRootKey = "root"
+	// crush root used for nodes and rules without root specified
+	defaultCrushRoot = "default"
 	// template for config map to track section changes
 	cephConfigSectionHashLabel = "cephdeployment.lcm.mirantis.com/config-%s-hash"
 	// template for keeping last update for parameters under specific section, max lentgh after / is 63 symbols
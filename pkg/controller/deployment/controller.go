@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -88,6 +89,7 @@ func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 		Claimclientset:   claimClientset,
 		Gatewayclientset: gatewayClient,
 		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder(ControllerName),
 	}
 }
 
@@ -155,6 +157,7 @@ type ReconcileCephDeployment struct {
 	Gatewayclientset gatewayclient.Interface
 	Scheme           *runtime.Scheme
 	Config           *rest.Config
+	Recorder         events.EventRecorder
 }
 
 func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -167,20 +170,32 @@ func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{RequeueAfter: requeueAfterInterval},
 			errors.Wrapf(err, "failed to list CephDeployments %s namespace", request.Namespace)
 	}
-	if len(cephDplList.Items) == 0 {
+	var cephDpl *cephlcmv1alpha1.CephDeployment
+	for idx := range cephDplList.Items {
+		if cephDplList.Items[idx].Name == request.Name {
+			cephDpl = &cephDplList.Items[idx]
+			break
+		}
+	}
+	if cephDpl == nil {
+		sublog.Debug().Msgf("CephDeployment %s is not found, skipping", request.NamespacedName)
+		delete(clustersRuntimeVars, request.String())
 		return reconcile.Result{}, nil
 	}
-	if len(cephDplList.Items) > 1 {
-		msg := fmt.Sprintf("incorrect number of CephDeployments in %s namespace", request.Namespace)
+	// each CephDeployment manages own Ceph cluster in own rook namespace,
+	// so load runtime vars related to the current cluster only
+	loadClusterRuntimeVars(request.String())
+	defer storeClusterRuntimeVars(request.String())
+
+	if owner := getRookNamespaceOwner(cephDplList.Items, cephDpl, lcmConfig.RookNamespace); owner != nil {
+		msg := fmt.Sprintf("rook namespace '%s' is already used by CephDeployment %s/%s", getCephDeploymentRookNamespace(cephDpl, lcmConfig.RookNamespace), owner.Namespace, owner.Name)
 		sublog.Error().Msg(msg)
-		r.setCephDeploymentPhaseFailed(ctx, sublog, request.Name, request.Namespace, cephlcmv1alpha1.CephDeploymentStatus{Phase: cephlcmv1alpha1.PhaseFailed, Message: msg})
+		cephDpl.Status.Phase = cephlcmv1alpha1.PhaseFailed
+		cephDpl.Status.Message = msg
+		r.setCephDeploymentPhaseFailed(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
 		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
 	}
-	cephDpl := &cephDplList.Items[0]
-	if cephDpl.Name != request.Name {
-		sublog.Error().Msgf("incorrect CephDeployment %s/%s, expected %s/%s", cephDpl.Namespace, cephDpl.Name, request.Namespace, request.Name)
-		return reconcile.Result{RequeueAfter: requeueAfterInterval}, errors.New("incorrect CephDeployment object")
-	}
+	lcmConfig = lcmconfig.GetClusterConfiguration(request.Namespace, request.Name, cephDpl.Spec.RookNamespace)
 
 	cephDplConfig := &cephDeploymentConfig{
 		context:   ctx,
@@ -238,6 +253,7 @@ func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconci
 						sublog.Info().Msgf("Finished CephDeployment resource cleanup for %s/%s", cephDpl.Namespace, cephDpl.Name)
 						// Remove finalizer. Once all finalizers have been removed, the object will be deleted.
 						if cephDplConfig.updateFinalizer(false) == nil {
+							cleanupMetrics(cephDpl.Namespace, cephDpl.Name)
 							return reconcile.Result{}, nil
 						}
 						cephDpl.Status.Message = "Ceph cluster is removed, failed to cleanup CephDeployment"
@@ -266,6 +282,7 @@ func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconci
 		if validationResult.Result == cephlcmv1alpha1.ValidationFailed {
 			cephDpl.Status.Phase = cephlcmv1alpha1.PhaseFailed
 			cephDpl.Status.Message = "Validation of CephDeployment spec is failed"
+			r.recordValidationFailedEvent(cephDpl, validationResult)
 		} else {
 			cephDpl.Status.Message = "Validation of CephDeployment spec is ok"
 		}
@@ -289,6 +306,9 @@ func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{RequeueAfter: lcmcommon.DefaultImmediateRequeueInterval}, nil
 	}
 
+	// check whether configuration apply is paused fully or for some sections
+	cephDplConfig.processPause()
+
 	cephRuntimeVersion, cephImageToUse, cephStatusVersion, err := cephDplConfig.verifyCephVersions()
 	if err != nil {
 		sublog.Error().Err(err).Msg("failed to verify Ceph version")
@@ -309,23 +329,40 @@ func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconci
 	}
 	cephDplConfig.cdConfig.currentCephImage = cephImageToUse
 
-	objRefs, objRefsErr := cephDplConfig.createSubObjects()
-	if objRefsErr != "" {
-		sublog.Error().Msg("failed to create sub resources")
-		cephDpl.Status.Phase = cephlcmv1alpha1.PhaseFailed
-		cephDpl.Status.Message = fmt.Sprintf("Ceph cluster %s", objRefsErr)
-		r.setCephDeploymentPhaseFailed(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
-		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
-	}
-	cephDpl.Status.ObjectsRefs = objRefs
+	// in plan mode nothing is applied, so sub resources and setup are not ensured
+	planMode := isPlanModeEnabled(cephDpl)
+	if !planMode {
+		objRefs, objRefsErr := cephDplConfig.createSubObjects()
+		if objRefsErr != "" {
+			sublog.Error().Msg("failed to create sub resources")
+			cephDpl.Status.Phase = cephlcmv1alpha1.PhaseFailed
+			cephDpl.Status.Message = fmt.Sprintf("Ceph cluster %s", objRefsErr)
+			r.setCephDeploymentPhaseFailed(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
+			return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+		}
+		cephDpl.Status.ObjectsRefs = objRefs
+
+		// whole configuration apply is paused, keep Rook resources as is
+		if cephDplConfig.isApplyPaused() {
+			sublog.Info().Msgf("configuration apply is paused for CephDeployment %s/%s, skipping", cephDpl.Namespace, cephDpl.Name)
+			cephDplConfig.setPausedConditions()
+			cephDpl.Status.Phase = cephlcmv1alpha1.PhasePaused
+			cephDpl.Status.Message = fmt.Sprintf("Ceph cluster %s", cephDplConfig.getPauseMessage())
+			err = r.updateCephDeploymentStatus(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
+			if err != nil {
+				sublog.Error().Err(err).Msg("failed to write CephDeployment status")
+			}
+			return reconcile.Result{RequeueAfter: cephDplConfig.getRequeueInterval()}, nil
+		}
 
-	err = cephDplConfig.verifySetup()
-	if err != nil {
-		sublog.Error().Err(err).Msg("failed to verify Ceph setup")
-		cephDpl.Status.Phase = cephlcmv1alpha1.PhaseFailed
-		cephDpl.Status.Message = err.Error()
-		r.setCephDeploymentPhaseFailed(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
-		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+		err = cephDplConfig.verifySetup()
+		if err != nil {
+			sublog.Error().Err(err).Msg("failed to verify Ceph setup")
+			cephDpl.Status.Phase = cephlcmv1alpha1.PhaseFailed
+			cephDpl.Status.Message = err.Error()
+			r.setCephDeploymentPhaseFailed(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
+			return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+		}
 	}
 
 	lcmPhaseActive, lcmPhase, err := cephDplConfig.checkLcmState()
@@ -354,6 +391,23 @@ func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
 	}
 
+	// in plan mode only calculate and publish changes, nothing is applied
+	if planMode {
+		sublog.Info().Msgf("plan mode is enabled for CephDeployment %s/%s, configuration apply is skipped", cephDpl.Namespace, cephDpl.Name)
+		planMsg, err := cephDplConfig.processConfigurationPlan()
+		if err != nil {
+			sublog.Error().Err(err).Msg("failed to process configuration plan")
+			planMsg = fmt.Sprintf("Ceph cluster configuration apply is skipped by plan mode, failed to build plan: %v", err)
+		}
+		cephDpl.Status.Phase = cephlcmv1alpha1.PhasePlanning
+		cephDpl.Status.Message = planMsg
+		err = r.updateCephDeploymentStatus(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
+		if err != nil {
+			sublog.Error().Err(err).Msg("failed to write CephDeployment status")
+		}
+		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+	}
+
 	// do not set deploying phase if current one ready - set validation phase
 	// setting deploying state means definitely some ops required
 	// and previous validation phase was not finished/completed
@@ -388,12 +442,15 @@ func (r *ReconcileCephDeployment) Reconcile(ctx context.Context, request reconci
 		cephDpl.Status.Phase = cephlcmv1alpha1.PhaseReady
 		cephDpl.Status.Message = "Ceph cluster configuration successfully applied"
 	}
+	if pauseMsg := cephDplConfig.getPauseMessage(); pauseMsg != "" {
+		cephDpl.Status.Message = fmt.Sprintf("%s; %s", cephDpl.Status.Message, pauseMsg)
+	}
 	err = r.updateCephDeploymentStatus(ctx, sublog, cephDpl.Name, cephDpl.Namespace, cephDpl.Status)
 	if err != nil {
 		sublog.Error().Err(err).Msg("failed to write CephDeployment status")
 	}
 	sublog.Debug().Msgf("reconcile for CephDeployment %q is finished", request.String())
-	return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+	return reconcile.Result{RequeueAfter: cephDplConfig.getRequeueInterval()}, nil
 }
 
 func (c *cephDeploymentConfig) createSubObjects() ([]v1.ObjectReference, string) {
@@ -532,6 +589,10 @@ func (c *cephDeploymentConfig) verifySetup() error {
 	}
 
 	c.log.Debug().Msgf("running Ceph cluster version %s %s.%s", c.cdConfig.currentCephVersion.Name, c.cdConfig.currentCephVersion.MajorVersion, c.cdConfig.currentCephVersion.MinorVersion)
+	if c.isSectionPaused(cephlcmv1alpha1.ConditionTypeCluster) {
+		c.log.Info().Msgf("configuration apply is paused for %s section, skipping Rook and Ceph images verification", cephlcmv1alpha1.ConditionTypeCluster)
+		return nil
+	}
 	// ensure rook image is actual in Rook apps
 	err := c.ensureRookImage()
 	if err != nil {
@@ -623,76 +684,99 @@ func (c *cephDeploymentConfig) applyConfiguration() (string, string) {
 			}
 		}
 	}
-	var err error
-	var changed bool
-
-	if !c.cdConfig.clusterSpec.External.Enable {
-		// Ensure node labels and topology
-		changed, err = c.ensureLabelNodes()
-		handleEnsureResult(changed, err, "label nodes")
-
-		// ensure nodes annotations if any
-		changed, err = c.ensureNodesAnnotation()
-		handleEnsureResult(changed, err, "annotate nodes")
+	conditionResults := applyConditionResults{}
+	// helper func to collect ensure steps results with metrics and conditions
+	collectResults := func(results []applyStepResult) {
+		for _, result := range results {
+			conditionType, ensureResource := result.step.conditionType, result.step.name
+			if result.paused {
+				c.log.Debug().Msgf("configuration apply is paused for %s section, skipping %s", conditionType, ensureResource)
+				conditionResults.setPaused(conditionType)
+				continue
+			}
+			if result.postponed {
+				conditionResults.addPending(conditionType, ensureResource)
+				continue
+			}
+			recordEnsureMetrics(c.cdConfig.cephDpl.Namespace, c.cdConfig.cephDpl.Name, ensureResource, result.duration, result.changed, result.err)
+			conditionResults.addResult(conditionType, ensureResource, result.changed, result.err)
+			handleEnsureResult(result.changed, result.err, ensureResource)
+			c.cdConfig.drifts = append(c.cdConfig.drifts, result.drifts...)
+			for _, kind := range result.driftCheckedKinds {
+				c.markDriftChecked(kind)
+			}
+		}
 	}
 
-	// ensure network policies
-	netPoolChanged := false
+	// ensure steps are postponed till nodes and network policies are not configured
+	applyPostponed := false
 	if !c.cdConfig.clusterSpec.External.Enable {
-		netPoolChanged, err = c.ensureNetworkPolicy()
-		handleEnsureResult(netPoolChanged, err, "network policies")
-	}
-
-	// continue if labeling/netpool are not failed and no netpool changes
-	if len(errCollector) == 0 && !netPoolChanged {
+		preparationResults := c.runApplySteps([]applyStep{
+			// Ensure node labels and topology
+			{name: "label nodes", conditionType: cephlcmv1alpha1.ConditionTypeCluster, ensureFunc: (*cephDeploymentConfig).ensureLabelNodes},
+			// ensure nodes annotations if any
+			{name: "annotate nodes", conditionType: cephlcmv1alpha1.ConditionTypeCluster, ensureFunc: (*cephDeploymentConfig).ensureNodesAnnotation,
+				dependsOn: []string{"label nodes"}},
+			// ensure network policies
+			{name: "network policies", conditionType: cephlcmv1alpha1.ConditionTypeNetworkPolicy, ensureFunc: (*cephDeploymentConfig).ensureNetworkPolicy},
+		}, false)
+		collectResults(preparationResults)
+		// continue if labeling/netpool are not failed and no netpool changes
+		applyPostponed = len(errCollector) > 0 || preparationResults[2].changed
+	}
+
+	// Steps are run in parallel if possible, steps which depend on created
+	// Ceph cluster objects or pools, or update the same resources, wait for each other
+	steps := []applyStep{
 		// Ensure CephCSI resources
-		changed, err := c.ensureCsiResources()
-		handleEnsureResult(changed, err, "cephcsi")
-
+		{name: "cephcsi", conditionType: cephlcmv1alpha1.ConditionTypeCSI, ensureFunc: (*cephDeploymentConfig).ensureCsiResources},
 		// Ensure ceph cluster processing
-		changed, err = c.ensureCluster()
-		handleEnsureResult(changed, err, "cephcluster")
-
-		if !c.cdConfig.clusterSpec.External.Enable {
+		{name: "cephcluster", conditionType: cephlcmv1alpha1.ConditionTypeCluster, ensureFunc: (*cephDeploymentConfig).ensureCluster},
+	}
+	if !c.cdConfig.clusterSpec.External.Enable {
+		steps = append(steps,
 			// Ensure ceph block pools processing for non-external cluster
-			changed, err = c.ensurePools()
-			handleEnsureResult(changed, err, "cephblockpools")
-
+			applyStep{name: "cephblockpools", conditionType: cephlcmv1alpha1.ConditionTypePools, ensureFunc: (*cephDeploymentConfig).ensurePools,
+				dependsOn: []string{"cephcluster"}},
 			// Ensure shared filesystems (CephFS) for non-external cluster
-			changed, err = c.ensureSharedFilesystem()
-			handleEnsureResult(changed, err, "shared filesystems")
-		}
-
+			applyStep{name: "shared filesystems", conditionType: cephlcmv1alpha1.ConditionTypeSharedFilesystem, ensureFunc: (*cephDeploymentConfig).ensureSharedFilesystem,
+				dependsOn: []string{"cephcluster"}},
+			// Ensure osds rolling restart for non-external cluster
+			applyStep{name: "osd restart", conditionType: cephlcmv1alpha1.ConditionTypeCluster, ensureFunc: (*cephDeploymentConfig).ensureOsdRestart,
+				dependsOn: []string{"cephcluster"}},
+		)
+	}
+	steps = append(steps,
 		// Ensure storage classes of ceph pools
-		changed, err = c.ensureStorageClasses()
-		handleEnsureResult(changed, err, "storageclasses")
-
+		applyStep{name: "storageclasses", conditionType: cephlcmv1alpha1.ConditionTypeStorageClasses, ensureFunc: (*cephDeploymentConfig).ensureStorageClasses,
+			dependsOn: []string{"cephcluster", "cephblockpools", "shared filesystems"}},
 		// Ensure ceph clients processing
-		changed, err = c.ensureCephClients()
-		handleEnsureResult(changed, err, "cephclients")
-
-		// Ensure ceph object storage processing
-		changed, err = c.ensureObjectStorage()
-		handleEnsureResult(changed, err, "ceph object storage")
-
-		if !c.cdConfig.clusterSpec.External.Enable {
+		applyStep{name: "cephclients", conditionType: cephlcmv1alpha1.ConditionTypeClients, ensureFunc: (*cephDeploymentConfig).ensureCephClients,
+			dependsOn: []string{"cephcluster", "cephblockpools"}},
+		// Ensure ceph object storage processing, shared filesystems and object storage
+		// both update ceph config sections, so are not run in parallel
+		applyStep{name: "ceph object storage", conditionType: cephlcmv1alpha1.ConditionTypeObjectStorage, ensureFunc: (*cephDeploymentConfig).ensureObjectStorage,
+			dependsOn: []string{"cephcluster", "shared filesystems"}},
+	)
+	if !c.cdConfig.clusterSpec.External.Enable {
+		steps = append(steps,
 			// Ensure RBD Mirror processing
-			changed, err = c.ensureRBDMirroring()
-			handleEnsureResult(changed, err, "RBD Mirroring")
-
+			applyStep{name: "RBD Mirroring", conditionType: cephlcmv1alpha1.ConditionTypeRBDMirror, ensureFunc: (*cephDeploymentConfig).ensureRBDMirroring,
+				dependsOn: []string{"cephblockpools"}},
 			// Ensure openstack shared secret processing for non-external cluster
-			changed, err = c.ensureOpenstackSecret()
-			handleEnsureResult(changed, err, "Openstack secret")
-
+			applyStep{name: "Openstack secret", conditionType: cephlcmv1alpha1.ConditionTypeOpenstackSecret, ensureFunc: (*cephDeploymentConfig).ensureOpenstackSecret,
+				dependsOn: []string{"cephclients", "ceph object storage"}},
 			// Ensure Ingress proxy for non-external
-			changed, err = c.ensureIngressProxy()
-			handleEnsureResult(changed, err, "ingress proxy")
-
+			applyStep{name: "ingress proxy", conditionType: cephlcmv1alpha1.ConditionTypeObjectStorage, ensureFunc: (*cephDeploymentConfig).ensureIngressProxy,
+				dependsOn: []string{"ceph object storage"}},
 			// Ensure overal cluster state
-			changed, err = c.ensureClusterState()
-			handleEnsureResult(changed, err, "cluster state")
-		}
+			applyStep{name: "cluster state", conditionType: cephlcmv1alpha1.ConditionTypeClusterState, ensureFunc: (*cephDeploymentConfig).ensureClusterState,
+				dependsOn: []string{"cephblockpools", "ceph object storage"}},
+		)
 	}
+	collectResults(c.runApplySteps(steps, applyPostponed))
+	c.setApplyConditions(conditionResults)
+	c.setDriftStatus()
 
 	applyRes := ""
 	if len(changedCollector) > 0 {
@@ -759,10 +843,13 @@ func (r *ReconcileCephDeployment) updateCephDeploymentStatus(ctx context.Context
 	}
 	status.LastRun = lcmcommon.GetCurrentTimeString()
 	log.Info().Msg(logMsg)
+	oldPhase := cephDpl.Status.Phase
 	err = cephlcmv1alpha1.UpdateCephDeploymentStatus(ctx, cephDpl, status, r.Client)
 	if err != nil {
 		return errors.Wrapf(err, "failed to update CephDeployment %s/%s status", cephDpl.Namespace, cephDpl.Name)
 	}
+	r.recordPhaseChangedEvent(cephDpl, oldPhase, status)
+	recordStatusMetrics(cephDpl.Namespace, cephDpl.Name, status)
 	return nil
 }
 
@@ -104,13 +104,15 @@ func TestStatusUpdatePhases(t *testing.T) {
 	}
 	// check that update to failed is done only after 3 tries
 	mc := *unitinputs.CephDeployNonMosk.DeepCopy()
+	anotherMc := *unitinputs.CephDeployNonMosk.DeepCopy()
+	anotherMc.Name = "another-cluster"
 	clientMc := unitinputs.CephDeployNonMosk.DeepCopy()
-	inputs := map[string]runtime.Object{"cephdeployments": &cephlcmv1alpha1.CephDeploymentList{Items: []cephlcmv1alpha1.CephDeployment{mc, mc}}}
+	inputs := map[string]runtime.Object{"cephdeployments": &cephlcmv1alpha1.CephDeploymentList{Items: []cephlcmv1alpha1.CephDeployment{mc, anotherMc}}}
 	faketestclients.FakeReaction(r.CephLcmclientset, "list", []string{"cephdeployments"}, inputs, nil)
 	r.Client = faketestclients.GetClient(faketestclients.GetClientBuilder().WithStatusSubresource(clientMc).WithObjects(clientMc))
 	for i := 0; i <= 4; i++ {
 		if i == 3 {
-			doReconcile(cephlcmv1alpha1.PhaseFailed, "incorrect number of CephDeployments in lcm-namespace namespace")
+			doReconcile(cephlcmv1alpha1.PhaseFailed, "rook namespace 'rook-ceph' is already used by CephDeployment lcm-namespace/another-cluster")
 			clientMc.Status.Phase = cephlcmv1alpha1.PhaseReady
 			clientMc.Status.Message = ""
 			r.Client = faketestclients.GetClient(faketestclients.GetClientBuilder().WithStatusSubresource(clientMc).WithObjects(clientMc))
@@ -127,6 +129,7 @@ func TestStatusUpdatePhases(t *testing.T) {
 	clientMc.Status.Phase = cephlcmv1alpha1.PhaseMaintenance
 	r.Client = faketestclients.GetClient(faketestclients.GetClientBuilder().WithStatusSubresource(clientMc).WithObjects(clientMc))
 	doReconcile(cephlcmv1alpha1.PhaseMaintenance, "")
+	clustersRuntimeVars = map[string]clusterRuntimeVars{}
 }
 
 var cephAPIResources = []string{"cephclusters", "cephblockpools", "cephclients", "cephfilesystems", "cephrbdmirrors", "cephobjectstores", "cephobjectstoreusers"}
@@ -137,6 +140,8 @@ func TestReconcile(t *testing.T) {
 	requeueAfterInterval := reconcile.Result{RequeueAfter: requeueAfterInterval}
 	noRequeue := reconcile.Result{}
 	immediateRequeue := reconcile.Result{RequeueAfter: lcmcommon.DefaultImmediateRequeueInterval}
+	anotherCephDpl := unitinputs.BaseCephDeployment.DeepCopy()
+	anotherCephDpl.Name = "another-cluster"
 	//latestClusterVersion = lcmcommon.LatestRelease
 	latestClusterVersion := &lcmcommon.CephVersion{
 		Name:            "Tentacle",
@@ -168,25 +173,35 @@ func TestReconcile(t *testing.T) {
 			result: noRequeue,
 		},
 		{
-			name: "reconcile cephdeployment - cephdeployment number > 1, status update succeed",
+			name: "reconcile cephdeployment - rook namespace is used by another cephdeployment, status update succeed",
 			inputResources: map[string]runtime.Object{
 				"cephdeployments": &cephlcmv1alpha1.CephDeploymentList{
-					Items: []cephlcmv1alpha1.CephDeployment{unitinputs.BaseCephDeployment, unitinputs.BaseCephDeployment},
+					Items: []cephlcmv1alpha1.CephDeployment{
+						func() cephlcmv1alpha1.CephDeployment {
+							cephDpl := unitinputs.BaseCephDeployment.DeepCopy()
+							cephDpl.Status.ClusterVersion = "v19.2.3"
+							cephDpl.Status.Validation = cephlcmv1alpha1.CephDeploymentValidation{Result: cephlcmv1alpha1.ValidationSucceed, LastValidatedGeneration: 1}
+							return *cephDpl
+						}(),
+						*anotherCephDpl,
+					},
 				},
 			},
 			testclient: faketestclients.GetClientBuilder().WithStatusSubresource(unitinputs.BaseCephDeployment.DeepCopy()).WithObjects(unitinputs.BaseCephDeployment.DeepCopy()),
 			expectedStatus: &cephlcmv1alpha1.CephDeploymentStatus{
-				Phase:   cephlcmv1alpha1.PhaseFailed,
-				Message: "incorrect number of CephDeployments in lcm-namespace namespace",
-				LastRun: "2021-08-15T14:30:12+04:00",
+				Phase:          cephlcmv1alpha1.PhaseFailed,
+				Message:        "rook namespace 'rook-ceph' is already used by CephDeployment lcm-namespace/another-cluster",
+				LastRun:        "2021-08-15T14:30:12+04:00",
+				ClusterVersion: "v19.2.3",
+				Validation:     cephlcmv1alpha1.CephDeploymentValidation{Result: cephlcmv1alpha1.ValidationSucceed, LastValidatedGeneration: 1},
 			},
 			result: requeueAfterInterval,
 		},
 		{
-			name: "reconcile cephdeployment - cephdeployment number > 1, status update failed",
+			name: "reconcile cephdeployment - rook namespace is used by another cephdeployment, status update failed",
 			inputResources: map[string]runtime.Object{
 				"cephdeployments": &cephlcmv1alpha1.CephDeploymentList{
-					Items: []cephlcmv1alpha1.CephDeployment{unitinputs.BaseCephDeployment, unitinputs.BaseCephDeployment},
+					Items: []cephlcmv1alpha1.CephDeployment{unitinputs.BaseCephDeployment, *anotherCephDpl},
 				},
 			},
 			result: requeueAfterInterval,
@@ -198,8 +213,7 @@ func TestReconcile(t *testing.T) {
 					Items: []cephlcmv1alpha1.CephDeployment{{ObjectMeta: metav1.ObjectMeta{Name: "fake-cephdeployment"}}},
 				},
 			},
-			expectedError: "incorrect CephDeployment object",
-			result:        requeueAfterInterval,
+			result: noRequeue,
 		},
 		{
 			name: "reconcile cephdeployment - failed to build cephdeployment nodes",
@@ -584,6 +598,19 @@ func TestReconcile(t *testing.T) {
 				},
 				LastRun:     "2021-08-15T14:30:30+04:00",
 				ObjectsRefs: unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(10, "2021-08-15T14:30:30+04:00",
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "label nodes"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypePools, "cephblockpools"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeSharedFilesystem, "shared filesystems"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses, "storageclasses"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeClients, "cephclients"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage, "ceph object storage, ingress proxy"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeRBDMirror, "RBD Mirroring"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeCSI, "cephcsi"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeNetworkPolicy, "network policies"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeOpenstackSecret, "Openstack secret"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeClusterState, "cluster state"),
+				),
 			},
 			result: requeueAfterInterval,
 		},
@@ -602,7 +629,7 @@ func TestReconcile(t *testing.T) {
 					Items: []appsv1.Deployment{*unitinputs.RookDeploymentLatestVersion.DeepCopy(), *unitinputs.ToolBoxDeploymentReady},
 				},
 				"pods":         unitinputs.ToolBoxPodList,
-				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{unitinputs.TestCephCluster}},
+				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(unitinputs.TestCephCluster)}},
 			},
 			testclient:      faketestclients.GetClientBuilder().WithStatusSubresource(unitinputs.BaseCephDeployment.DeepCopy()).WithObjects(unitinputs.BaseCephDeployment.DeepCopy()),
 			expectedVersion: latestClusterVersion,
@@ -641,7 +668,7 @@ func TestReconcile(t *testing.T) {
 				"pods":           unitinputs.ToolBoxPodList,
 				"storageclasses": &storagev1.StorageClassList{},
 				"cephblockpools": &cephv1.CephBlockPoolList{Items: []cephv1.CephBlockPool{
-					unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true),
+					getAppliedPool(unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true)),
 					func() cephv1.CephBlockPool {
 						pool := unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true)
 						pool.Name = "test-cephfs-some-pool-name"
@@ -650,9 +677,9 @@ func TestReconcile(t *testing.T) {
 				}},
 				"httproutes":           &gatewayapi.HTTPRouteList{},
 				"cephclients":          &cephv1.CephClientList{Items: []cephv1.CephClient{}},
-				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{unitinputs.TestCephCluster}},
+				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(unitinputs.TestCephCluster)}},
 				"cephfilesystems":      unitinputs.CephFSListReady.DeepCopy(),
-				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreBase.DeepCopy()}},
+				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreBase)}},
 				"cephrbdmirrors":       &cephv1.CephRBDMirrorList{},
 				"cephobjectstoreusers": &cephv1.CephObjectStoreUserList{Items: []cephv1.CephObjectStoreUser{*unitinputs.RgwUserWithStatus(unitinputs.RgwUserBase, "Ready")}},
 			},
@@ -669,6 +696,19 @@ func TestReconcile(t *testing.T) {
 				ClusterVersion: "v20.2.3",
 				LastRun:        "2021-08-15T14:30:32+04:00",
 				ObjectsRefs:    unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(10, "2021-08-15T14:30:32+04:00",
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "label nodes, cephcluster"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypePools, "cephblockpools"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeSharedFilesystem, "shared filesystems"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses, "storageclasses"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeClients, "cephclients"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage, "ceph object storage"),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeRBDMirror),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCSI, "cephcsi"),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeNetworkPolicy),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeOpenstackSecret),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeClusterState, "cluster state"),
+				),
 			},
 		},
 		{
@@ -719,7 +759,7 @@ func TestReconcile(t *testing.T) {
 					},
 				},
 				"cephblockpools": &cephv1.CephBlockPoolList{Items: []cephv1.CephBlockPool{
-					unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true), *unitinputs.BuiltinMgrPool,
+					getAppliedPool(unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true)), *unitinputs.BuiltinMgrPool,
 				}},
 				"cephclients":    &cephv1.CephClientList{Items: []cephv1.CephClient{*unitinputs.TestCephClientReady.DeepCopy()}},
 				"cephrbdmirrors": &cephv1.CephRBDMirrorList{},
@@ -736,7 +776,7 @@ func TestReconcile(t *testing.T) {
 									"cephdeployment.lcm.mirantis.com/config-global-updated": "2021-08-15T14:30:45+04:00",
 								},
 							}
-							return *cluster
+							return getAppliedCluster(*cluster)
 						}(),
 					},
 				},
@@ -767,6 +807,19 @@ func TestReconcile(t *testing.T) {
 				ClusterVersion: "v20.2.3",
 				LastRun:        "2021-08-15T14:30:33+04:00",
 				ObjectsRefs:    unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(10, "2021-08-15T14:30:33+04:00",
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCluster),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypePools),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeSharedFilesystem),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeClients),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeRBDMirror),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeNetworkPolicy),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeOpenstackSecret),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeClusterState),
+				),
 			},
 		},
 		{
@@ -792,7 +845,7 @@ func TestReconcile(t *testing.T) {
 					func() cephv1.CephCluster {
 						cluster := unitinputs.TestCephCluster.DeepCopy()
 						cluster.Spec.CephVersion.Image = "fake/fake:v20.2.3-0"
-						return *cluster
+						return getAppliedCluster(*cluster)
 					}(),
 				}},
 				"httproutes": &gatewayapi.HTTPRouteList{},
@@ -856,6 +909,19 @@ func TestReconcile(t *testing.T) {
 				},
 				LastRun:     "2021-08-15T14:30:35+04:00",
 				ObjectsRefs: unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(0, "2021-08-15T14:30:35+04:00",
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "label nodes"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypePools, "cephblockpools"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeSharedFilesystem, "shared filesystems"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses, "storageclasses"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeClients, "cephclients"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage, "ceph object storage, ingress proxy"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeRBDMirror, "RBD Mirroring"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeCSI, "cephcsi"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeNetworkPolicy, "network policies"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeOpenstackSecret, "Openstack secret"),
+					pendingTestCondition(cephlcmv1alpha1.ConditionTypeClusterState, "cluster state"),
+				),
 			},
 		},
 		{
@@ -896,15 +962,15 @@ func TestReconcile(t *testing.T) {
 					*unitinputs.GetNamedStorageClass("backup-hdd", false),
 				}},
 				"httproutes": &gatewayapi.HTTPRouteList{},
-				"cephblockpools": &cephv1.CephBlockPoolList{Items: append([]cephv1.CephBlockPool{unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true)},
-					unitinputs.OpenstackCephBlockPoolsListReady.DeepCopy().Items...)},
+				"cephblockpools": &cephv1.CephBlockPoolList{Items: append([]cephv1.CephBlockPool{getAppliedPool(unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true))},
+					getAppliedPoolList(unitinputs.OpenstackCephBlockPoolsListReady).Items...)},
 				"cephclients": &cephv1.CephClientList{Items: []cephv1.CephClient{
 					*unitinputs.CephClientGlance.DeepCopy(), *unitinputs.GetCephClientWithStatus(unitinputs.CephClientNova, true), *unitinputs.GetCephClientWithStatus(unitinputs.CephClientCinder, true),
 				}},
-				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterOpenstack()}},
+				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(*unitinputs.CephClusterOpenstack())}},
 				"cephfilesystems":      &cephv1.CephFilesystemList{},
 				"cephrbdmirrors":       &cephv1.CephRBDMirrorList{},
-				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreBase.DeepCopy()}},
+				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreBase)}},
 				"cephobjectstoreusers": unitinputs.CephObjectStoreUserListMetrics.DeepCopy(),
 			},
 			testclient:      faketestclients.GetClientBuilder().WithStatusSubresource(unitinputs.BaseCephDeployment.DeepCopy()).WithObjects(unitinputs.BaseCephDeployment.DeepCopy()),
@@ -920,6 +986,19 @@ func TestReconcile(t *testing.T) {
 				ClusterVersion: "v20.2.3",
 				LastRun:        "2021-08-15T14:30:36+04:00",
 				ObjectsRefs:    unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(0, "2021-08-15T14:30:36+04:00",
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "label nodes, cephcluster"),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypePools),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeSharedFilesystem),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses, "storageclasses"),
+					failedTestCondition(cephlcmv1alpha1.ConditionTypeClients, "cephclients"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage, "ceph object storage"),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeRBDMirror),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeNetworkPolicy),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeOpenstackSecret, "Openstack secret"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeClusterState, "cluster state"),
+				),
 			},
 		},
 		{
@@ -961,6 +1040,13 @@ func TestReconcile(t *testing.T) {
 				},
 				LastRun:     "2021-08-15T14:30:37+04:00",
 				ObjectsRefs: unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(0, "2021-08-15T14:30:37+04:00",
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "cephcluster"),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeClients),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+				),
 			},
 		},
 		{
@@ -1000,6 +1086,13 @@ func TestReconcile(t *testing.T) {
 				},
 				LastRun:     "2021-08-15T14:30:38+04:00",
 				ObjectsRefs: unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(0, "2021-08-15T14:30:38+04:00",
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "cephcluster"),
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses, "storageclasses"),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeClients),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+				),
 			},
 		},
 		{
@@ -1027,7 +1120,7 @@ func TestReconcile(t *testing.T) {
 				"nodes":                &corev1.NodeList{Items: []corev1.Node{unitinputs.GetAvailableNode("node-1"), unitinputs.GetAvailableNode("node-2"), unitinputs.GetAvailableNode("node-3")}},
 				"pods":                 unitinputs.ToolBoxPodList,
 				"storageclasses":       &storagev1.StorageClassList{Items: []storagev1.StorageClass{*unitinputs.ExternalStorageClassDefault.DeepCopy()}},
-				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterExternal.DeepCopy()}},
+				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(*unitinputs.CephClusterExternal)}},
 				"cephclients":          &cephv1.CephClientList{},
 				"cephrbdmirrors":       &cephv1.CephRBDMirrorList{},
 				"cephobjectstores":     &cephv1.CephObjectStoreList{},
@@ -1046,6 +1139,13 @@ func TestReconcile(t *testing.T) {
 				ClusterVersion: "v20.2.3",
 				LastRun:        "2021-08-15T14:30:39+04:00",
 				ObjectsRefs:    unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(0, "2021-08-15T14:30:39+04:00",
+					inProgressTestCondition(cephlcmv1alpha1.ConditionTypeCluster, "cephcluster"),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeClients),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+				),
 			},
 		},
 		{
@@ -1099,7 +1199,7 @@ func TestReconcile(t *testing.T) {
 				"nodes":                &corev1.NodeList{Items: []corev1.Node{unitinputs.GetAvailableNode("node-1"), unitinputs.GetAvailableNode("node-2"), unitinputs.GetAvailableNode("node-3")}},
 				"pods":                 unitinputs.ToolBoxPodList,
 				"storageclasses":       &storagev1.StorageClassList{Items: []storagev1.StorageClass{*unitinputs.ExternalStorageClassDefault.DeepCopy()}},
-				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterExternal.DeepCopy()}},
+				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(*unitinputs.CephClusterExternal)}},
 				"cephclients":          &cephv1.CephClientList{},
 				"cephrbdmirrors":       &cephv1.CephRBDMirrorList{},
 				"cephobjectstores":     &cephv1.CephObjectStoreList{},
@@ -1118,6 +1218,13 @@ func TestReconcile(t *testing.T) {
 				ClusterVersion: "v20.2.3",
 				LastRun:        "2021-08-15T14:30:40+04:00",
 				ObjectsRefs:    unitinputs.CephDeploymentObjectsRefs,
+				Conditions: getTestApplyConditions(0, "2021-08-15T14:30:40+04:00",
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCluster),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeStorageClasses),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeClients),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeObjectStorage),
+					appliedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+				),
 			},
 		},
 		{
@@ -1135,7 +1242,7 @@ func TestReconcile(t *testing.T) {
 					Items: []appsv1.Deployment{*unitinputs.RookDeploymentLatestVersion.DeepCopy(), *unitinputs.ToolBoxDeploymentReady}},
 				"nodes":        &corev1.NodeList{},
 				"pods":         unitinputs.ToolBoxPodList,
-				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterExternal.DeepCopy()}},
+				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(*unitinputs.CephClusterExternal)}},
 			},
 			testclient:      faketestclients.GetClientBuilder().WithStatusSubresource(unitinputs.BaseCephDeployment.DeepCopy()).WithObjects(unitinputs.BaseCephDeployment.DeepCopy()),
 			result:          requeueAfterInterval,
@@ -1185,7 +1292,7 @@ func TestReconcile(t *testing.T) {
 				"nodes":                &corev1.NodeList{Items: []corev1.Node{unitinputs.GetAvailableNode("node-1"), unitinputs.GetAvailableNode("node-2"), unitinputs.GetAvailableNode("node-3")}},
 				"pods":                 unitinputs.ToolBoxPodList,
 				"storageclasses":       &storagev1.StorageClassList{},
-				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{*unitinputs.CephClusterExternal.DeepCopy()}},
+				"cephclusters":         &cephv1.CephClusterList{Items: []cephv1.CephCluster{getAppliedCluster(*unitinputs.CephClusterExternal)}},
 				"cephclients":          &cephv1.CephClientList{},
 				"cephrbdmirrors":       &cephv1.CephRBDMirrorList{},
 				"cephobjectstores":     &cephv1.CephObjectStoreList{},
@@ -1366,6 +1473,7 @@ func TestReconcile(t *testing.T) {
 	versionCheckPollInterval = oldInterval
 	versionCheckPollTimeout = oldTimeout
 	unsetTimestampsVar()
+	clustersRuntimeVars = map[string]clusterRuntimeVars{}
 }
 
 func TestCleanCephDeployment(t *testing.T) {
@@ -2005,8 +2113,8 @@ func TestApplyConfiguration(t *testing.T) {
 			Items: []storagev1.StorageClass{*unitinputs.RgwStorageClass.DeepCopy()},
 		},
 		"cephblockpools": &cephv1.CephBlockPoolList{
-			Items: append(unitinputs.OpenstackCephBlockPoolsListReady.DeepCopy().Items,
-				unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true), *unitinputs.BuiltinMgrPool.DeepCopy(), *unitinputs.BuiltinRgwRootPool.DeepCopy()),
+			Items: append(getAppliedPoolList(unitinputs.OpenstackCephBlockPoolsListReady).Items,
+				getAppliedPool(unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolReplicated, true)), *unitinputs.BuiltinMgrPool.DeepCopy(), *unitinputs.BuiltinRgwRootPool.DeepCopy()),
 		},
 		"cephclients": &cephv1.CephClientList{
 			Items: []cephv1.CephClient{
@@ -2017,7 +2125,7 @@ func TestApplyConfiguration(t *testing.T) {
 			},
 		},
 		"cephclusters": &cephv1.CephClusterList{
-			Items: []cephv1.CephCluster{*unitinputs.CephClusterOpenstack()},
+			Items: []cephv1.CephCluster{getAppliedCluster(*unitinputs.CephClusterOpenstack())},
 		},
 		"cephfilesystems": unitinputs.CephFSListReady.DeepCopy(),
 		"cephrbdmirrors": &cephv1.CephRBDMirrorList{
@@ -2027,7 +2135,7 @@ func TestApplyConfiguration(t *testing.T) {
 		},
 		"cephobjectstores": &cephv1.CephObjectStoreList{
 			Items: []cephv1.CephObjectStore{
-				*unitinputs.CephObjectStoreBase.DeepCopy(),
+				getAppliedRgw(*unitinputs.CephObjectStoreBase),
 			},
 		},
 		"cephobjectstoreusers": unitinputs.CephObjectStoreUserListMetrics.DeepCopy(),
@@ -2054,12 +2162,12 @@ func TestApplyConfiguration(t *testing.T) {
 		"cephclients": &cephv1.CephClientList{},
 		"cephclusters": &cephv1.CephClusterList{
 			Items: []cephv1.CephCluster{
-				*unitinputs.CephClusterExternal.DeepCopy(),
+				getAppliedCluster(*unitinputs.CephClusterExternal),
 			},
 		},
 		"cephobjectstores": &cephv1.CephObjectStoreList{
 			Items: []cephv1.CephObjectStore{
-				*unitinputs.CephObjectStoreExternal.DeepCopy(),
+				getAppliedRgw(*unitinputs.CephObjectStoreExternal),
 			},
 		},
 		"cephobjectstoreusers": &cephv1.CephObjectStoreUserList{
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const (
+	cephDeploymentCRDName = "cephdeployments.lcm.mirantis.com"
+	webhookCACertName     = "ca.crt"
+)
+
+var conversionCABundleBackoff = wait.Backoff{
+	Duration: 5 * time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    10,
+	Cap:      5 * time.Minute,
+}
+
+// addConversionWebhookCABundle registers runnable, which injects webhook CA bundle
+// to CephDeployment CRD conversion, since CA bundle may be unknown on chart render;
+// conversion webhook itself and served versions are configured by chart CRD
+func addConversionWebhookCABundle(mgr manager.Manager, c client.Client) error {
+	server, ok := mgr.GetWebhookServer().(*webhook.DefaultServer)
+	if !ok {
+		return errors.New("unexpected webhook server type, failed to get webhook certs directory")
+	}
+	caCertPath := filepath.Join(server.Options.CertDir, webhookCACertName)
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		err := wait.ExponentialBackoffWithContext(ctx, conversionCABundleBackoff, func(ctx context.Context) (bool, error) {
+			caBundle, err := os.ReadFile(caCertPath)
+			if err != nil {
+				log.Error().Err(err).Msgf("failed to read webhook CA certificate '%s', retrying", caCertPath)
+				return false, nil
+			}
+			if err := ensureCRDConversionCABundle(ctx, c, cephDeploymentCRDName, caBundle); err != nil {
+				log.Error().Err(err).Msg("failed to inject CA bundle for CephDeployment conversion webhook, retrying")
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			log.Error().Err(err).Msgf("CA bundle is not injected for CRD '%s' conversion webhook", cephDeploymentCRDName)
+		}
+		return nil
+	}))
+}
+
+func ensureCRDConversionCABundle(ctx context.Context, c client.Client, crdName string, caBundle []byte) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetName(crdName)
+	crd.SetAPIVersion("apiextensions.k8s.io/v1")
+	crd.SetKind("CustomResourceDefinition")
+	if err := c.Get(ctx, client.ObjectKeyFromObject(crd), crd); err != nil {
+		return errors.Wrapf(err, "failed to get CRD '%s'", crdName)
+	}
+	strategy, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "strategy")
+	if strategy != "Webhook" {
+		return errors.Errorf("CRD '%s' has '%s' conversion strategy instead of 'Webhook'", crdName, strategy)
+	}
+	encoded := base64.StdEncoding.EncodeToString(caBundle)
+	present, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "webhook", "clientConfig", "caBundle")
+	if present == encoded {
+		return nil
+	}
+	if err := unstructured.SetNestedField(crd.Object, encoded, "spec", "conversion", "webhook", "clientConfig", "caBundle"); err != nil {
+		return errors.Wrapf(err, "failed to set conversion CA bundle for CRD '%s'", crdName)
+	}
+	log.Info().Msgf("updating CRD '%s' conversion webhook CA bundle", crdName)
+	if err := c.Update(ctx, crd); err != nil {
+		return errors.Wrapf(err, "failed to update CRD '%s'", crdName)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+)
+
+func TestEnsureCRDConversionCABundle(t *testing.T) {
+	getCRD := func(strategy, caBundle string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetName(cephDeploymentCRDName)
+		u.SetAPIVersion("apiextensions.k8s.io/v1")
+		u.SetKind("CustomResourceDefinition")
+		if strategy != "" {
+			_ = unstructured.SetNestedField(u.Object, strategy, "spec", "conversion", "strategy")
+		}
+		if strategy == "Webhook" {
+			_ = unstructured.SetNestedMap(u.Object, map[string]any{
+				"name":      "pelagia-webhook",
+				"namespace": "lcm-namespace",
+				"path":      "/convert",
+				"port":      int64(443),
+			}, "spec", "conversion", "webhook", "clientConfig", "service")
+			_ = unstructured.SetNestedStringSlice(u.Object, []string{"v1"}, "spec", "conversion", "webhook", "conversionReviewVersions")
+		}
+		if caBundle != "" {
+			_ = unstructured.SetNestedField(u.Object, caBundle, "spec", "conversion", "webhook", "clientConfig", "caBundle")
+		}
+		return u
+	}
+	tests := []struct {
+		name          string
+		crd           *unstructured.Unstructured
+		expectedError string
+	}{
+		{
+			name:          "crd is not found",
+			expectedError: "failed to get CRD 'cephdeployments.lcm.mirantis.com'",
+		},
+		{
+			name:          "crd has no webhook conversion, skip",
+			crd:           getCRD("None", ""),
+			expectedError: "CRD 'cephdeployments.lcm.mirantis.com' has 'None' conversion strategy instead of 'Webhook'",
+		},
+		{
+			name: "crd has no ca bundle, set",
+			crd:  getCRD("Webhook", ""),
+		},
+		{
+			name: "crd has outdated ca bundle, update",
+			crd:  getCRD("Webhook", "b2xkLWNh"),
+		},
+		{
+			name: "crd has actual ca bundle, nothing to do",
+			crd:  getCRD("Webhook", "ZmFrZS1jYQ=="),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			builder := faketestclients.GetClientBuilder()
+			if test.crd != nil {
+				builder = builder.WithObjects(test.crd)
+			}
+			c := faketestclients.GetClient(builder)
+			err := ensureCRDConversionCABundle(context.TODO(), c, cephDeploymentCRDName, []byte("fake-ca"))
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+				return
+			}
+			assert.Nil(t, err)
+			crd := getCRD("", "")
+			err = c.Get(context.TODO(), crclient.ObjectKeyFromObject(crd), crd)
+			assert.Nil(t, err)
+			expected := getCRD("Webhook", "ZmFrZS1jYQ==")
+			conversion, _, _ := unstructured.NestedMap(crd.Object, "spec", "conversion")
+			expectedConversion, _, _ := unstructured.NestedMap(expected.Object, "spec", "conversion")
+			assert.Equal(t, expectedConversion, conversion)
+		})
+	}
+}
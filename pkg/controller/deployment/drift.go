@@ -0,0 +1,220 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// driftMaxFields limits amount of changed fields reported per object
+const driftMaxFields = 20
+
+func getManagedObjectKey(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+func getSpecHash(spec interface{}) string {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return ""
+	}
+	return lcmcommon.GetStringSha256(string(raw))
+}
+
+// getChangedSpecFields returns sorted list of spec fields paths, which values differ
+func getChangedSpecFields(presentSpec, desiredSpec interface{}) []string {
+	toMap := func(spec interface{}) interface{} {
+		var res interface{}
+		raw, err := json.Marshal(spec)
+		if err == nil {
+			_ = json.Unmarshal(raw, &res)
+		}
+		return res
+	}
+	fields := []string{}
+	collectChangedFields("spec", toMap(presentSpec), toMap(desiredSpec), &fields)
+	sort.Strings(fields)
+	if len(fields) > driftMaxFields {
+		fields = append(fields[:driftMaxFields], fmt.Sprintf("and %d more", len(fields)-driftMaxFields))
+	}
+	return fields
+}
+
+func collectChangedFields(path string, present, desired interface{}, fields *[]string) {
+	presentMap, presentIsMap := present.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if presentIsMap && desiredIsMap {
+		keys := map[string]bool{}
+		for key := range presentMap {
+			keys[key] = true
+		}
+		for key := range desiredMap {
+			keys[key] = true
+		}
+		for key := range keys {
+			collectChangedFields(fmt.Sprintf("%s.%s", path, key), presentMap[key], desiredMap[key], fields)
+		}
+		return
+	}
+	if !reflect.DeepEqual(present, desired) {
+		*fields = append(*fields, path)
+	}
+}
+
+// getDriftPolicy returns drift policy for a particular managed object
+func (c *cephDeploymentConfig) getDriftPolicy(kind, name string) cephlcmv1alpha1.DriftPolicy {
+	driftPolicy := c.cdConfig.cephDpl.Spec.DriftPolicy
+	if driftPolicy == nil {
+		return cephlcmv1alpha1.DriftPolicyRevert
+	}
+	for _, object := range driftPolicy.Objects {
+		if object.Kind == kind && object.Name == name {
+			return object.Policy
+		}
+	}
+	if driftPolicy.Default != "" {
+		return driftPolicy.Default
+	}
+	return cephlcmv1alpha1.DriftPolicyRevert
+}
+
+// markDriftChecked marks objects kind as verified for drifts during current apply
+func (c *cephDeploymentConfig) markDriftChecked(kind string) {
+	if !lcmcommon.Contains(c.cdConfig.driftCheckedKinds, kind) {
+		c.cdConfig.driftCheckedKinds = append(c.cdConfig.driftCheckedKinds, kind)
+	}
+}
+
+// markSpecApplied saves hash of spec applied to managed object in object annotations,
+// changes made outside of CephDeployment are detected by comparing it with present spec
+func markSpecApplied(objMeta *metav1.ObjectMeta, spec interface{}) {
+	if objMeta.Annotations == nil {
+		objMeta.Annotations = map[string]string{}
+	}
+	objMeta.Annotations[appliedSpecHashAnnotation] = getSpecHash(spec)
+	delete(objMeta.Annotations, adoptedSpecHashAnnotation)
+}
+
+// refreshAppliedSpecHash updates applied spec hash for managed object, which spec
+// is changed by controller not during regular apply, hash is not updated if object
+// is changed outside of CephDeployment to keep drift detectable
+func refreshAppliedSpecHash(objMeta *metav1.ObjectMeta, prevSpec, spec interface{}) {
+	if appliedHash, tracked := objMeta.Annotations[appliedSpecHashAnnotation]; tracked && appliedHash == getSpecHash(prevSpec) {
+		objMeta.Annotations[appliedSpecHashAnnotation] = getSpecHash(spec)
+	}
+}
+
+// checkObjectDrift compares present managed object spec with generated one and returns
+// whether object spec and whether object annotations should be updated. Object is
+// considered as changed outside of CephDeployment, if its spec differs from the last
+// applied one, saved in object annotations, or if object has no such annotation and
+// its spec differs from generated one. Drift is reported according to drift policy.
+func (c *cephDeploymentConfig) checkObjectDrift(kind string, objMeta *metav1.ObjectMeta, presentSpec, desiredSpec interface{}) (bool, bool) {
+	c.markDriftChecked(kind)
+	desiredHash := getSpecHash(desiredSpec)
+	appliedHash, tracked := objMeta.Annotations[appliedSpecHashAnnotation]
+	if reflect.DeepEqual(presentSpec, desiredSpec) {
+		// object in sync becomes a new baseline, that covers objects
+		// created before drift detection and not tracked yet
+		if !tracked || appliedHash != desiredHash || objMeta.Annotations[adoptedSpecHashAnnotation] != "" {
+			markSpecApplied(objMeta, desiredSpec)
+			return false, true
+		}
+		return false, false
+	}
+	presentHash := getSpecHash(presentSpec)
+	if tracked && appliedHash == presentHash {
+		// no changes made outside since the last apply, keep adopted changes
+		// until object spec is changed in CephDeployment
+		if objMeta.Annotations[adoptedSpecHashAnnotation] == desiredHash {
+			return false, false
+		}
+		markSpecApplied(objMeta, desiredSpec)
+		return true, false
+	}
+	policy := c.getDriftPolicy(kind, objMeta.Name)
+	fields := getChangedSpecFields(presentSpec, desiredSpec)
+	c.log.Warn().Msgf("%s %s/%s is changed outside of CephDeployment, changed fields: %s, drift policy '%s'",
+		kind, objMeta.Namespace, objMeta.Name, strings.Join(fields, ", "), policy)
+	c.cdConfig.drifts = append(c.cdConfig.drifts, cephlcmv1alpha1.CephDeploymentObjectDrift{
+		Kind:      kind,
+		Namespace: objMeta.Namespace,
+		Name:      objMeta.Name,
+		Fields:    fields,
+		Policy:    policy,
+	})
+	switch policy {
+	case cephlcmv1alpha1.DriftPolicyReportOnly:
+		return false, false
+	case cephlcmv1alpha1.DriftPolicyAdopt:
+		// present spec becomes a new baseline, adopted changes are kept
+		// while generated spec is not changed
+		if objMeta.Annotations == nil {
+			objMeta.Annotations = map[string]string{}
+		}
+		objMeta.Annotations[appliedSpecHashAnnotation] = presentHash
+		objMeta.Annotations[adoptedSpecHashAnnotation] = desiredHash
+		return false, true
+	}
+	markSpecApplied(objMeta, desiredSpec)
+	return true, false
+}
+
+// setDriftStatus updates CephDeployment status with drifts found during current apply,
+// drifts for objects kinds not verified during current apply are kept as is
+func (c *cephDeploymentConfig) setDriftStatus() {
+	status := &c.cdConfig.cephDpl.Status
+	detectedAt := getConditionTransitionTime()
+	drifts := []cephlcmv1alpha1.CephDeploymentObjectDrift{}
+	for _, drift := range c.cdConfig.drifts {
+		drift.DetectedAt = detectedAt
+		found := false
+		for _, prevDrift := range status.Drifts {
+			if prevDrift.Kind == drift.Kind && prevDrift.Namespace == drift.Namespace && prevDrift.Name == drift.Name {
+				drift.DetectedAt = prevDrift.DetectedAt
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.recordDriftDetectedEvent(drift)
+		}
+		drifts = append(drifts, drift)
+	}
+	for _, prevDrift := range status.Drifts {
+		if !lcmcommon.Contains(c.cdConfig.driftCheckedKinds, prevDrift.Kind) {
+			drifts = append(drifts, prevDrift)
+		}
+	}
+	if len(drifts) == 0 {
+		status.Drifts = nil
+		return
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		return getManagedObjectKey(drifts[i].Kind, drifts[i].Namespace, drifts[i].Name) < getManagedObjectKey(drifts[j].Kind, drifts[j].Namespace, drifts[j].Name)
+	})
+	status.Drifts = drifts
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestGetChangedSpecFields(t *testing.T) {
+	desired := cephv1.PoolSpec{
+		FailureDomain: "host",
+		DeviceClass:   "hdd",
+		Replicated:    cephv1.ReplicatedSpec{Size: 3},
+	}
+	present := desired.DeepCopy()
+	assert.Equal(t, []string{}, getChangedSpecFields(present, desired))
+
+	present.Replicated.Size = 2
+	present.DeviceClass = "ssd"
+	present.Parameters = map[string]string{"pg_num": "64"}
+	assert.Equal(t, []string{"spec.deviceClass", "spec.parameters", "spec.replicated.size"}, getChangedSpecFields(present, desired))
+
+	desired.Parameters = map[string]string{"target_size_ratio": "0.1"}
+	present.Parameters = map[string]string{}
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v"} {
+		present.Parameters[key] = "value"
+	}
+	fields := getChangedSpecFields(present, desired)
+	assert.Equal(t, driftMaxFields+1, len(fields))
+	assert.Equal(t, "spec.deviceClass", fields[0])
+	assert.Equal(t, "and 5 more", fields[driftMaxFields])
+}
+
+func getAppliedCluster(cluster cephv1.CephCluster) cephv1.CephCluster {
+	cl := cluster.DeepCopy()
+	markSpecApplied(&cl.ObjectMeta, cl.Spec)
+	return *cl
+}
+
+func getAppliedPool(pool cephv1.CephBlockPool) cephv1.CephBlockPool {
+	p := pool.DeepCopy()
+	markSpecApplied(&p.ObjectMeta, p.Spec)
+	return *p
+}
+
+func getAppliedRgw(rgw cephv1.CephObjectStore) cephv1.CephObjectStore {
+	r := rgw.DeepCopy()
+	markSpecApplied(&r.ObjectMeta, r.Spec)
+	return *r
+}
+
+func getAppliedPoolList(list cephv1.CephBlockPoolList) *cephv1.CephBlockPoolList {
+	res := &cephv1.CephBlockPoolList{}
+	for _, pool := range list.Items {
+		res.Items = append(res.Items, getAppliedPool(pool))
+	}
+	return res
+}
+
+func getAppliedRgwList(list cephv1.CephObjectStoreList) *cephv1.CephObjectStoreList {
+	res := &cephv1.CephObjectStoreList{}
+	for _, rgw := range list.Items {
+		res.Items = append(res.Items, getAppliedRgw(rgw))
+	}
+	return res
+}
+
+func TestCheckObjectDrift(t *testing.T) {
+	desiredSpec := cephv1.PoolSpec{
+		FailureDomain: "host",
+		DeviceClass:   "hdd",
+		Replicated:    cephv1.ReplicatedSpec{Size: 3},
+	}
+	changedSpec := desiredSpec.DeepCopy()
+	changedSpec.Replicated.Size = 2
+	oldSpec := desiredSpec.DeepCopy()
+	oldSpec.Replicated.Size = 4
+	applied := func(appliedSpec, adoptedSpec interface{}) map[string]string {
+		annotations := map[string]string{appliedSpecHashAnnotation: getSpecHash(appliedSpec)}
+		if adoptedSpec != nil {
+			annotations[adoptedSpecHashAnnotation] = getSpecHash(adoptedSpec)
+		}
+		return annotations
+	}
+	tests := []struct {
+		name                string
+		driftPolicy         *cephlcmv1alpha1.CephDeploymentDriftPolicy
+		annotations         map[string]string
+		presentSpec         cephv1.PoolSpec
+		expectedAnnotations map[string]string
+		expectedDrift       bool
+		expectUpdate        bool
+		expectMetaUpdate    bool
+	}{
+		{
+			name:                "object is not tracked and in sync",
+			presentSpec:         desiredSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectMetaUpdate:    true,
+		},
+		{
+			name:                "object is not tracked and not in sync",
+			presentSpec:         *changedSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectedDrift:       true,
+			expectUpdate:        true,
+		},
+		{
+			name:                "object is tracked and in sync",
+			annotations:         applied(desiredSpec, nil),
+			presentSpec:         desiredSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+		},
+		{
+			name:                "object is tracked, spec is changed in CephDeployment",
+			annotations:         applied(changedSpec, nil),
+			presentSpec:         *changedSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectUpdate:        true,
+		},
+		{
+			name:                "object is changed outside, but in sync",
+			annotations:         applied(oldSpec, nil),
+			presentSpec:         desiredSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectMetaUpdate:    true,
+		},
+		{
+			name:                "object is changed outside, default revert policy",
+			annotations:         applied(desiredSpec, nil),
+			presentSpec:         *changedSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectedDrift:       true,
+			expectUpdate:        true,
+		},
+		{
+			name: "object is changed outside, report-only policy",
+			driftPolicy: &cephlcmv1alpha1.CephDeploymentDriftPolicy{
+				Default: cephlcmv1alpha1.DriftPolicyReportOnly,
+			},
+			annotations:         applied(desiredSpec, nil),
+			presentSpec:         *changedSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectedDrift:       true,
+		},
+		{
+			name: "object is changed outside, adopt policy for object",
+			driftPolicy: &cephlcmv1alpha1.CephDeploymentDriftPolicy{
+				Default: cephlcmv1alpha1.DriftPolicyReportOnly,
+				Objects: []cephlcmv1alpha1.CephDeploymentObjectDriftPolicy{
+					{Kind: "CephBlockPool", Name: "pool1-hdd", Policy: cephlcmv1alpha1.DriftPolicyAdopt},
+				},
+			},
+			annotations:         applied(desiredSpec, nil),
+			presentSpec:         *changedSpec,
+			expectedAnnotations: applied(changedSpec, desiredSpec),
+			expectedDrift:       true,
+			expectMetaUpdate:    true,
+		},
+		{
+			name:                "adopted object is kept",
+			annotations:         applied(changedSpec, desiredSpec),
+			presentSpec:         *changedSpec,
+			expectedAnnotations: applied(changedSpec, desiredSpec),
+		},
+		{
+			name:                "adopted object is updated, since spec is changed in CephDeployment",
+			annotations:         applied(changedSpec, oldSpec),
+			presentSpec:         *changedSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectUpdate:        true,
+		},
+		{
+			name:                "adopted object is in sync with CephDeployment",
+			annotations:         applied(changedSpec, oldSpec),
+			presentSpec:         desiredSpec,
+			expectedAnnotations: applied(desiredSpec, nil),
+			expectMetaUpdate:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+			cephDpl.Spec.DriftPolicy = test.driftPolicy
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl}, nil)
+			objMeta := metav1.ObjectMeta{Name: "pool1-hdd", Namespace: "rook-ceph", Annotations: test.annotations}
+
+			specUpdate, metaUpdate := c.checkObjectDrift("CephBlockPool", &objMeta, test.presentSpec, desiredSpec)
+			assert.Equal(t, test.expectUpdate, specUpdate)
+			assert.Equal(t, test.expectMetaUpdate, metaUpdate)
+			assert.Equal(t, test.expectedAnnotations, objMeta.Annotations)
+			assert.Equal(t, []string{"CephBlockPool"}, c.cdConfig.driftCheckedKinds)
+			if test.expectedDrift {
+				assert.Equal(t, []cephlcmv1alpha1.CephDeploymentObjectDrift{
+					{
+						Kind:      "CephBlockPool",
+						Namespace: "rook-ceph",
+						Name:      "pool1-hdd",
+						Fields:    []string{"spec.replicated.size"},
+						Policy:    c.getDriftPolicy("CephBlockPool", "pool1-hdd"),
+					},
+				}, c.cdConfig.drifts)
+			} else {
+				assert.Nil(t, c.cdConfig.drifts)
+			}
+		})
+	}
+}
+
+func TestRefreshAppliedSpecHash(t *testing.T) {
+	prevSpec := cephv1.ClusterSpec{CephVersion: cephv1.CephVersionSpec{Image: "ceph:v19"}}
+	newSpec := cephv1.ClusterSpec{CephVersion: cephv1.CephVersionSpec{Image: "ceph:v20"}}
+
+	objMeta := metav1.ObjectMeta{}
+	refreshAppliedSpecHash(&objMeta, prevSpec, newSpec)
+	assert.Nil(t, objMeta.Annotations)
+
+	objMeta.Annotations = map[string]string{appliedSpecHashAnnotation: "some-hash"}
+	refreshAppliedSpecHash(&objMeta, prevSpec, newSpec)
+	assert.Equal(t, map[string]string{appliedSpecHashAnnotation: "some-hash"}, objMeta.Annotations)
+
+	objMeta.Annotations = map[string]string{appliedSpecHashAnnotation: getSpecHash(prevSpec)}
+	refreshAppliedSpecHash(&objMeta, prevSpec, newSpec)
+	assert.Equal(t, map[string]string{appliedSpecHashAnnotation: getSpecHash(newSpec)}, objMeta.Annotations)
+}
+
+func TestSetDriftStatus(t *testing.T) {
+	oldTimeFunc := lcmcommon.GetCurrentTimeString
+	lcmcommon.GetCurrentTimeString = func() string {
+		return "2021-08-15T14:30:45+04:00"
+	}
+	prevTime := *getTestPauseTime("2021-08-15T14:00:00+04:00")
+	nowTime := *getTestPauseTime("2021-08-15T14:30:45+04:00")
+	poolDrift := cephlcmv1alpha1.CephDeploymentObjectDrift{
+		Kind:      "CephBlockPool",
+		Namespace: "rook-ceph",
+		Name:      "pool1-hdd",
+		Fields:    []string{"spec.replicated.size"},
+		Policy:    cephlcmv1alpha1.DriftPolicyReportOnly,
+	}
+	clusterDrift := cephlcmv1alpha1.CephDeploymentObjectDrift{
+		Kind:      "CephCluster",
+		Namespace: "rook-ceph",
+		Name:      "cephcluster",
+		Fields:    []string{"spec.mon.count"},
+		Policy:    cephlcmv1alpha1.DriftPolicyRevert,
+	}
+	rgwDrift := cephlcmv1alpha1.CephDeploymentObjectDrift{
+		Kind:      "CephObjectStore",
+		Namespace: "rook-ceph",
+		Name:      "rgw-store",
+		Fields:    []string{"spec.gateway.instances"},
+		Policy:    cephlcmv1alpha1.DriftPolicyAdopt,
+	}
+	withTime := func(drift cephlcmv1alpha1.CephDeploymentObjectDrift, detectedAt metav1.Time) cephlcmv1alpha1.CephDeploymentObjectDrift {
+		drift.DetectedAt = detectedAt
+		return drift
+	}
+	tests := []struct {
+		name           string
+		statusDrifts   []cephlcmv1alpha1.CephDeploymentObjectDrift
+		drifts         []cephlcmv1alpha1.CephDeploymentObjectDrift
+		checkedKinds   []string
+		expectedDrifts []cephlcmv1alpha1.CephDeploymentObjectDrift
+		expectedEvents []string
+	}{
+		{
+			name:           "no drifts",
+			checkedKinds:   []string{"CephCluster", "CephBlockPool"},
+			expectedEvents: []string{},
+		},
+		{
+			name:           "new drifts found",
+			drifts:         []cephlcmv1alpha1.CephDeploymentObjectDrift{poolDrift, clusterDrift},
+			checkedKinds:   []string{"CephCluster", "CephBlockPool"},
+			expectedDrifts: []cephlcmv1alpha1.CephDeploymentObjectDrift{withTime(poolDrift, nowTime), withTime(clusterDrift, nowTime)},
+			expectedEvents: []string{
+				"Warning DriftDetected CephBlockPool rook-ceph/pool1-hdd is changed outside of CephDeployment (fields: spec.replicated.size), drift policy 'report-only': object update is skipped",
+				"Warning DriftDetected CephCluster rook-ceph/cephcluster is changed outside of CephDeployment (fields: spec.mon.count), drift policy 'revert': changes are reverted",
+			},
+		},
+		{
+			name:           "drifts are still present, not checked kinds are kept",
+			statusDrifts:   []cephlcmv1alpha1.CephDeploymentObjectDrift{withTime(poolDrift, prevTime), withTime(rgwDrift, prevTime)},
+			drifts:         []cephlcmv1alpha1.CephDeploymentObjectDrift{poolDrift},
+			checkedKinds:   []string{"CephCluster", "CephBlockPool"},
+			expectedDrifts: []cephlcmv1alpha1.CephDeploymentObjectDrift{withTime(poolDrift, prevTime), withTime(rgwDrift, prevTime)},
+			expectedEvents: []string{},
+		},
+		{
+			name:           "drifts are resolved",
+			statusDrifts:   []cephlcmv1alpha1.CephDeploymentObjectDrift{withTime(poolDrift, prevTime), withTime(rgwDrift, prevTime)},
+			checkedKinds:   []string{"CephBlockPool", "CephObjectStore"},
+			expectedEvents: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+			cephDpl.Status.Drifts = test.statusDrifts
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl, drifts: test.drifts, driftCheckedKinds: test.checkedKinds}, nil)
+			recorder := events.NewFakeRecorder(10)
+			c.api.Recorder = recorder
+
+			c.setDriftStatus()
+			assert.Equal(t, test.expectedDrifts, cephDpl.Status.Drifts)
+			assert.Equal(t, test.expectedEvents, readFakeEvents(recorder))
+		})
+	}
+	lcmcommon.GetCurrentTimeString = oldTimeFunc
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+const (
+	eventReasonPhaseChanged     = "PhaseChanged"
+	eventReasonValidationFailed = "ValidationFailed"
+	eventReasonObjectCreated    = "ObjectCreated"
+	eventReasonObjectDeleted    = "ObjectDeleted"
+	eventReasonPlanGenerated    = "PlanGenerated"
+	eventReasonApplyPaused      = "ApplyPaused"
+	eventReasonApplyResumed     = "ApplyResumed"
+	eventReasonDriftDetected    = "DriftDetected"
+
+	eventActionReconcile = "Reconcile"
+	eventActionValidate  = "Validate"
+	eventActionCreate    = "Create"
+	eventActionDelete    = "Delete"
+	eventActionPlan      = "Plan"
+	eventActionPause     = "Pause"
+)
+
+func (r *ReconcileCephDeployment) recordEvent(regarding runtime.Object, eventType, reason, action, note string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(regarding, nil, eventType, reason, action, note, args...)
+}
+
+func (r *ReconcileCephDeployment) recordPhaseChangedEvent(cephDpl *cephlcmv1alpha1.CephDeployment, oldPhase cephlcmv1alpha1.CephDeploymentPhase, status cephlcmv1alpha1.CephDeploymentStatus) {
+	if status.Phase == "" || status.Phase == oldPhase {
+		return
+	}
+	eventType := v1.EventTypeNormal
+	if status.Phase == cephlcmv1alpha1.PhaseFailed {
+		eventType = v1.EventTypeWarning
+	}
+	if oldPhase == "" {
+		r.recordEvent(cephDpl, eventType, eventReasonPhaseChanged, eventActionReconcile, "phase set to %s: %s", status.Phase, status.Message)
+		return
+	}
+	r.recordEvent(cephDpl, eventType, eventReasonPhaseChanged, eventActionReconcile, "phase changed from %s to %s: %s", oldPhase, status.Phase, status.Message)
+}
+
+func (r *ReconcileCephDeployment) recordValidationFailedEvent(cephDpl *cephlcmv1alpha1.CephDeployment, validation cephlcmv1alpha1.CephDeploymentValidation) {
+	r.recordEvent(cephDpl, v1.EventTypeWarning, eventReasonValidationFailed, eventActionValidate, "spec validation failed: %s", strings.Join(validation.Messages, ", "))
+}
+
+// recordObjectEvent records event about created or deleted child object for current CephDeployment
+func (c *cephDeploymentConfig) recordObjectEvent(process objectProcess, kind, namespace, name string) {
+	switch process {
+	case objectCreate:
+		c.api.recordEvent(c.cdConfig.cephDpl, v1.EventTypeNormal, eventReasonObjectCreated, eventActionCreate, "%s %s/%s created", kind, namespace, name)
+	case objectDelete:
+		c.api.recordEvent(c.cdConfig.cephDpl, v1.EventTypeNormal, eventReasonObjectDeleted, eventActionDelete, "%s %s/%s deleted", kind, namespace, name)
+	}
+}
+
+func (c *cephDeploymentConfig) recordPlanGeneratedEvent(changesCount int, planConfigMap string) {
+	c.api.recordEvent(c.cdConfig.cephDpl, v1.EventTypeNormal, eventReasonPlanGenerated, eventActionPlan, "configuration plan is generated with %d change(s), see ConfigMap %s", changesCount, planConfigMap)
+}
+
+func (c *cephDeploymentConfig) recordPauseEvent(paused bool, reason string) {
+	if paused {
+		c.api.recordEvent(c.cdConfig.cephDpl, v1.EventTypeWarning, eventReasonApplyPaused, eventActionPause, "configuration apply is paused: %s", reason)
+		return
+	}
+	c.api.recordEvent(c.cdConfig.cephDpl, v1.EventTypeNormal, eventReasonApplyResumed, eventActionPause, "configuration apply is resumed")
+}
+
+func (c *cephDeploymentConfig) recordDriftDetectedEvent(drift cephlcmv1alpha1.CephDeploymentObjectDrift) {
+	action := "changes are reverted"
+	switch drift.Policy {
+	case cephlcmv1alpha1.DriftPolicyReportOnly:
+		action = "object update is skipped"
+	case cephlcmv1alpha1.DriftPolicyAdopt:
+		action = "changes are adopted"
+	}
+	c.api.recordEvent(c.cdConfig.cephDpl, v1.EventTypeWarning, eventReasonDriftDetected, eventActionReconcile, "%s %s/%s is changed outside of CephDeployment (fields: %s), drift policy '%s': %s",
+		drift.Kind, drift.Namespace, drift.Name, strings.Join(drift.Fields, ", "), drift.Policy, action)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/events"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+func readFakeEvents(recorder *events.FakeRecorder) []string {
+	result := []string{}
+	for {
+		select {
+		case e := <-recorder.Events:
+			result = append(result, e)
+		default:
+			return result
+		}
+	}
+}
+
+func TestRecordPhaseChangedEvent(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldPhase       cephlcmv1alpha1.CephDeploymentPhase
+		status         cephlcmv1alpha1.CephDeploymentStatus
+		expectedEvents []string
+	}{
+		{
+			name:           "no phase in status - no event",
+			oldPhase:       cephlcmv1alpha1.PhaseReady,
+			status:         cephlcmv1alpha1.CephDeploymentStatus{ClusterVersion: "v19.2.3"},
+			expectedEvents: []string{},
+		},
+		{
+			name:           "phase is not changed - no event",
+			oldPhase:       cephlcmv1alpha1.PhaseReady,
+			status:         cephlcmv1alpha1.CephDeploymentStatus{Phase: cephlcmv1alpha1.PhaseReady},
+			expectedEvents: []string{},
+		},
+		{
+			name:           "initial phase set",
+			status:         cephlcmv1alpha1.CephDeploymentStatus{Phase: cephlcmv1alpha1.PhaseDeploying, Message: "Ceph cluster is deploying"},
+			expectedEvents: []string{"Normal PhaseChanged phase set to Deploying: Ceph cluster is deploying"},
+		},
+		{
+			name:           "phase changed to ready",
+			oldPhase:       cephlcmv1alpha1.PhaseDeploying,
+			status:         cephlcmv1alpha1.CephDeploymentStatus{Phase: cephlcmv1alpha1.PhaseReady, Message: "Ceph cluster configuration successfully applied"},
+			expectedEvents: []string{"Normal PhaseChanged phase changed from Deploying to Ready: Ceph cluster configuration successfully applied"},
+		},
+		{
+			name:           "phase changed to failed",
+			oldPhase:       cephlcmv1alpha1.PhaseReady,
+			status:         cephlcmv1alpha1.CephDeploymentStatus{Phase: cephlcmv1alpha1.PhaseFailed, Message: "failed to verify Ceph version"},
+			expectedEvents: []string{"Warning PhaseChanged phase changed from Ready to Failed: failed to verify Ceph version"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(10)
+			r := FakeReconciler()
+			r.Recorder = recorder
+			c := fakeDeploymentConfig(nil, nil)
+			r.recordPhaseChangedEvent(c.cdConfig.cephDpl, test.oldPhase, test.status)
+			assert.Equal(t, test.expectedEvents, readFakeEvents(recorder))
+		})
+	}
+}
+
+func TestRecordValidationFailedEvent(t *testing.T) {
+	recorder := events.NewFakeRecorder(10)
+	r := FakeReconciler()
+	r.Recorder = recorder
+	c := fakeDeploymentConfig(nil, nil)
+	r.recordValidationFailedEvent(c.cdConfig.cephDpl, cephlcmv1alpha1.CephDeploymentValidation{
+		Result:   cephlcmv1alpha1.ValidationFailed,
+		Messages: []string{"node 'node-1' has no roles", "pool 'pool1' has no role"},
+	})
+	assert.Equal(t, []string{"Warning ValidationFailed spec validation failed: node 'node-1' has no roles, pool 'pool1' has no role"}, readFakeEvents(recorder))
+}
+
+func TestRecordObjectEvent(t *testing.T) {
+	recorder := events.NewFakeRecorder(10)
+	c := fakeDeploymentConfig(nil, nil)
+	c.api.Recorder = recorder
+	c.recordObjectEvent(objectCreate, "CephBlockPool", "rook-ceph", "pool1-hdd")
+	c.recordObjectEvent(objectUpdate, "CephBlockPool", "rook-ceph", "pool1-hdd")
+	c.recordObjectEvent(objectDelete, "CephClient", "rook-ceph", "cinder")
+	assert.Equal(t, []string{
+		"Normal ObjectCreated CephBlockPool rook-ceph/pool1-hdd created",
+		"Normal ObjectDeleted CephClient rook-ceph/cinder deleted",
+	}, readFakeEvents(recorder))
+
+	// no recorder set - no panic
+	c.api.Recorder = nil
+	c.recordObjectEvent(objectCreate, "CephBlockPool", "rook-ceph", "pool1-hdd")
+}
@@ -19,6 +19,7 @@ package deployment
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"reflect"
 	"strconv"
 	"strings"
@@ -48,6 +49,10 @@ func (c *cephDeploymentConfig) addExternalResources(ownerRefs []metav1.OwnerRefe
 		return false, errors.Errorf("required for connection to external cluster parameters ('connection' field) is not specified in secret '%s/%s'", c.cdConfig.cephDpl.Namespace, externalStringSecretName)
 	}
 
+	monEndpoints, monCount, err := parseExternalMonEndpoints(cephCon.MonEndpoints)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse external connection mon endpoints from secret '%s/%s'", c.cdConfig.cephDpl.Namespace, externalStringSecretName)
+	}
 	monEndpointsConfigMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            rookCephMonEndpointsMapName,
@@ -56,9 +61,9 @@ func (c *cephDeploymentConfig) addExternalResources(ownerRefs []metav1.OwnerRefe
 			Labels:          baseResourceLabels,
 		},
 		Data: map[string]string{
-			"data":     cephCon.MonEndpoints,
+			"data":     monEndpoints,
 			"mapping":  "{}",
-			"maxMonId": strconv.Itoa(len(strings.Split(cephCon.MonEndpoints, ","))),
+			"maxMonId": strconv.Itoa(monCount),
 		},
 	}
 	configMapUpdated, err := c.manageConfigMap(monEndpointsConfigMap)
@@ -150,6 +155,36 @@ func (c *cephDeploymentConfig) addExternalResources(ownerRefs []metav1.OwnerRefe
 	return configMapUpdated || secretsUpdated, nil
 }
 
+// parseExternalMonEndpoints verifies monitor endpoints in format 'name=address[:port]' and
+// returns endpoints with IPv6 addresses enclosed in brackets and monitors count
+func parseExternalMonEndpoints(endpoints string) (string, int, error) {
+	parsed := []string{}
+	for _, endpoint := range strings.Split(endpoints, ",") {
+		name, address, found := strings.Cut(strings.TrimSpace(endpoint), "=")
+		if !found || name == "" || address == "" {
+			return "", 0, errors.Errorf("invalid monitor endpoint '%s', expected format is 'name=address[:port]'", endpoint)
+		}
+		host := strings.TrimSuffix(strings.TrimPrefix(address, "["), "]")
+		if ip := net.ParseIP(host); ip != nil {
+			// address without port
+			if ip.To4() == nil {
+				address = fmt.Sprintf("[%s]", host)
+			}
+		} else if strings.Contains(address, ":") {
+			host, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return "", 0, errors.Wrapf(err, "invalid monitor endpoint '%s'", endpoint)
+			}
+			if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+				return "", 0, errors.Errorf("invalid monitor endpoint '%s', port '%s' is not valid", endpoint, port)
+			}
+			address = net.JoinHostPort(host, port)
+		}
+		parsed = append(parsed, fmt.Sprintf("%s=%s", name, address))
+	}
+	return strings.Join(parsed, ","), len(parsed), nil
+}
+
 func (c *cephDeploymentConfig) manageSecrets(secrets []*corev1.Secret) (bool, error) {
 	errs := []string{}
 	updated := false
@@ -297,6 +297,63 @@ func TestAddExternalResources(t *testing.T) {
 	}
 }
 
+func TestParseExternalMonEndpoints(t *testing.T) {
+	tests := []struct {
+		name              string
+		endpoints         string
+		expectedEndpoints string
+		expectedCount     int
+		expectedError     string
+	}{
+		{
+			name:              "ipv4 endpoints",
+			endpoints:         "cmn01=10.0.0.1:6969,cmn02=10.0.0.2:6969,cmn03=10.0.0.3",
+			expectedEndpoints: "cmn01=10.0.0.1:6969,cmn02=10.0.0.2:6969,cmn03=10.0.0.3",
+			expectedCount:     3,
+		},
+		{
+			name:              "ipv6 endpoints",
+			endpoints:         "a=[fd00:10::1]:6789,b=fd00:10::2,c=[fd00:10::3]",
+			expectedEndpoints: "a=[fd00:10::1]:6789,b=[fd00:10::2],c=[fd00:10::3]",
+			expectedCount:     3,
+		},
+		{
+			name:              "dual-stack endpoints",
+			endpoints:         "a=10.0.0.1:3300, b=[fd00:10::2]:3300",
+			expectedEndpoints: "a=10.0.0.1:3300,b=[fd00:10::2]:3300",
+			expectedCount:     2,
+		},
+		{
+			name:          "endpoint without name",
+			endpoints:     "a=10.0.0.1:3300,10.0.0.2:3300",
+			expectedError: "invalid monitor endpoint '10.0.0.2:3300', expected format is 'name=address[:port]'",
+		},
+		{
+			name:          "ipv6 endpoint with port without brackets",
+			endpoints:     "a=fd00:10::1:6789:",
+			expectedError: "invalid monitor endpoint 'a=fd00:10::1:6789:': address fd00:10::1:6789:: too many colons in address",
+		},
+		{
+			name:          "endpoint with invalid port",
+			endpoints:     "a=[fd00:10::1]:port",
+			expectedError: "invalid monitor endpoint 'a=[fd00:10::1]:port', port 'port' is not valid",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoints, count, err := parseExternalMonEndpoints(test.endpoints)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.expectedEndpoints, endpoints)
+			assert.Equal(t, test.expectedCount, count)
+		})
+	}
+}
+
 func TestManageSecrets(t *testing.T) {
 	tests := []struct {
 		name           string
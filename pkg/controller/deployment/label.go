@@ -259,6 +259,10 @@ func (c *cephDeploymentConfig) addTopology(nodeName string, crush map[string]str
 
 	isError := false
 	for crushroot, crushtopology := range crush {
+		// crush root is set through osd config, not node labels
+		if crushroot == crushRootKey {
+			continue
+		}
 		if key = crushTopologyAllowedKeys[crushroot]; key == "" {
 			c.log.Error().Msgf("crush topology label not specified for '%s' node: crushroot '%s' is invalid", nodeName, crushroot)
 			isError = true
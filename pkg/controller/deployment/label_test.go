@@ -135,6 +135,12 @@ func TestAddTopology(t *testing.T) {
 			nodes:         &v1.NodeList{Items: []v1.Node{*unitinputs.EmptyLabelsNode.DeepCopy()}},
 			expectedNodes: &v1.NodeList{Items: []v1.Node{*unitinputs.TopologyLabelsNode.DeepCopy()}},
 		},
+		{
+			name:          "add topology to nodes - crush root is not added as label, update success",
+			topology:      map[string]string{"region": "region1", "zone": "zone1", "rack": "rack1", "root": "nvme"},
+			nodes:         &v1.NodeList{Items: []v1.Node{*unitinputs.EmptyLabelsNode.DeepCopy()}},
+			expectedNodes: &v1.NodeList{Items: []v1.Node{*unitinputs.TopologyLabelsNode.DeepCopy()}},
+		},
 		{
 			name:          "add topology to nodes - remove all topology, update success",
 			topology:      map[string]string{},
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+const (
+	metricsNamespace = "pelagia"
+	metricsSubsystem = "cephdeployment"
+)
+
+var (
+	metricsObjectLabels = []string{"namespace", "name"}
+	metricsStepLabels   = []string{"namespace", "name", "step"}
+
+	cephDeploymentPhases = []cephlcmv1alpha1.CephDeploymentPhase{
+		cephlcmv1alpha1.PhaseCreating,
+		cephlcmv1alpha1.PhaseDeploying,
+		cephlcmv1alpha1.PhaseValidation,
+		cephlcmv1alpha1.PhaseReady,
+		cephlcmv1alpha1.PhaseOnHold,
+		cephlcmv1alpha1.PhaseMaintenance,
+		cephlcmv1alpha1.PhasePaused,
+		cephlcmv1alpha1.PhasePlanning,
+		cephlcmv1alpha1.PhaseDeleting,
+		cephlcmv1alpha1.PhaseFailed,
+	}
+	cephDeploymentValidationResults = []cephlcmv1alpha1.ValidationResult{
+		cephlcmv1alpha1.ValidationSucceed,
+		cephlcmv1alpha1.ValidationFailed,
+	}
+
+	ensureDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "ensure_duration_seconds",
+		Help:      "Duration of CephDeployment configuration apply steps in seconds.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, metricsStepLabels)
+	ensureTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "ensure_total",
+		Help:      "Total number of CephDeployment configuration apply step runs.",
+	}, metricsStepLabels)
+	ensureChangedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "ensure_changed_total",
+		Help:      "Total number of CephDeployment configuration apply step runs which changed resources.",
+	}, metricsStepLabels)
+	ensureFailedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "ensure_failed_total",
+		Help:      "Total number of failed CephDeployment configuration apply step runs.",
+	}, metricsStepLabels)
+	ensureLastFailed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "ensure_last_failed",
+		Help:      "Whether the last run of CephDeployment configuration apply step is failed (1) or not (0).",
+	}, metricsStepLabels)
+	phaseGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "phase",
+		Help:      "Current CephDeployment phase, set to 1 for the current phase and 0 for others.",
+	}, append(metricsObjectLabels, "phase"))
+	validationGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "validation_result",
+		Help:      "Current CephDeployment spec validation result, set to 1 for the current result and 0 for others.",
+	}, append(metricsObjectLabels, "result"))
+	clusterVersionGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "cluster_version_info",
+		Help:      "Current Ceph cluster version of CephDeployment, always set to 1.",
+	}, append(metricsObjectLabels, "version"))
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ensureDurationSeconds,
+		ensureTotal,
+		ensureChangedTotal,
+		ensureFailedTotal,
+		ensureLastFailed,
+		phaseGauge,
+		validationGauge,
+		clusterVersionGauge,
+	)
+}
+
+func recordEnsureMetrics(namespace, name, step string, duration time.Duration, changed bool, err error) {
+	ensureDurationSeconds.WithLabelValues(namespace, name, step).Observe(duration.Seconds())
+	ensureTotal.WithLabelValues(namespace, name, step).Inc()
+	if changed {
+		ensureChangedTotal.WithLabelValues(namespace, name, step).Inc()
+	}
+	if err != nil {
+		ensureFailedTotal.WithLabelValues(namespace, name, step).Inc()
+		ensureLastFailed.WithLabelValues(namespace, name, step).Set(1)
+	} else {
+		ensureLastFailed.WithLabelValues(namespace, name, step).Set(0)
+	}
+}
+
+func recordStatusMetrics(namespace, name string, status cephlcmv1alpha1.CephDeploymentStatus) {
+	if status.Phase != "" {
+		for _, phase := range cephDeploymentPhases {
+			value := 0.0
+			if phase == status.Phase {
+				value = 1
+			}
+			phaseGauge.WithLabelValues(namespace, name, string(phase)).Set(value)
+		}
+	}
+	if status.Validation.Result != "" {
+		for _, result := range cephDeploymentValidationResults {
+			value := 0.0
+			if result == status.Validation.Result {
+				value = 1
+			}
+			validationGauge.WithLabelValues(namespace, name, string(result)).Set(value)
+		}
+	}
+	if status.ClusterVersion != "" {
+		clusterVersionGauge.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "name": name})
+		clusterVersionGauge.WithLabelValues(namespace, name, status.ClusterVersion).Set(1)
+	}
+}
+
+func cleanupMetrics(namespace, name string) {
+	labels := prometheus.Labels{"namespace": namespace, "name": name}
+	ensureDurationSeconds.DeletePartialMatch(labels)
+	ensureTotal.DeletePartialMatch(labels)
+	ensureChangedTotal.DeletePartialMatch(labels)
+	ensureFailedTotal.DeletePartialMatch(labels)
+	ensureLastFailed.DeletePartialMatch(labels)
+	phaseGauge.DeletePartialMatch(labels)
+	validationGauge.DeletePartialMatch(labels)
+	clusterVersionGauge.DeletePartialMatch(labels)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+func readMetric(t *testing.T, metric prometheus.Metric) *dto.Metric {
+	out := &dto.Metric{}
+	err := metric.Write(out)
+	assert.Nil(t, err)
+	return out
+}
+
+func countMetricSeries(collector prometheus.Collector) int {
+	ch := make(chan prometheus.Metric, 100)
+	collector.Collect(ch)
+	close(ch)
+	return len(ch)
+}
+
+func resetMetrics() {
+	for _, vec := range []interface{ Reset() }{ensureDurationSeconds, ensureTotal, ensureChangedTotal, ensureFailedTotal, ensureLastFailed, phaseGauge, validationGauge, clusterVersionGauge} {
+		vec.Reset()
+	}
+}
+
+func TestRecordEnsureMetrics(t *testing.T) {
+	resetMetrics()
+	recordEnsureMetrics("lcm-namespace", "cephcluster", "cephblockpools", 2*time.Second, true, nil)
+	recordEnsureMetrics("lcm-namespace", "cephcluster", "cephblockpools", time.Second, false, errors.New("failed"))
+	recordEnsureMetrics("lcm-namespace", "cephcluster", "cephclients", time.Second, false, nil)
+
+	assert.Equal(t, 2.0, readMetric(t, ensureTotal.WithLabelValues("lcm-namespace", "cephcluster", "cephblockpools")).GetCounter().GetValue())
+	assert.Equal(t, 1.0, readMetric(t, ensureChangedTotal.WithLabelValues("lcm-namespace", "cephcluster", "cephblockpools")).GetCounter().GetValue())
+	assert.Equal(t, 1.0, readMetric(t, ensureFailedTotal.WithLabelValues("lcm-namespace", "cephcluster", "cephblockpools")).GetCounter().GetValue())
+	assert.Equal(t, 1.0, readMetric(t, ensureLastFailed.WithLabelValues("lcm-namespace", "cephcluster", "cephblockpools")).GetGauge().GetValue())
+	histogram := readMetric(t, ensureDurationSeconds.WithLabelValues("lcm-namespace", "cephcluster", "cephblockpools").(prometheus.Metric)).GetHistogram()
+	assert.Equal(t, uint64(2), histogram.GetSampleCount())
+	assert.Equal(t, 3.0, histogram.GetSampleSum())
+
+	assert.Equal(t, 1.0, readMetric(t, ensureTotal.WithLabelValues("lcm-namespace", "cephcluster", "cephclients")).GetCounter().GetValue())
+	assert.Equal(t, 0.0, readMetric(t, ensureLastFailed.WithLabelValues("lcm-namespace", "cephcluster", "cephclients")).GetGauge().GetValue())
+
+	cleanupMetrics("lcm-namespace", "cephcluster")
+	assert.Equal(t, 0, countMetricSeries(ensureTotal))
+	assert.Equal(t, 0, countMetricSeries(ensureDurationSeconds))
+}
+
+func TestRecordStatusMetrics(t *testing.T) {
+	resetMetrics()
+	recordStatusMetrics("lcm-namespace", "cephcluster", cephlcmv1alpha1.CephDeploymentStatus{
+		Phase:          cephlcmv1alpha1.PhaseDeploying,
+		Validation:     cephlcmv1alpha1.CephDeploymentValidation{Result: cephlcmv1alpha1.ValidationSucceed},
+		ClusterVersion: "v19.2.3",
+	})
+	assert.Equal(t, len(cephDeploymentPhases), countMetricSeries(phaseGauge))
+	assert.Equal(t, 1.0, readMetric(t, phaseGauge.WithLabelValues("lcm-namespace", "cephcluster", "Deploying")).GetGauge().GetValue())
+	assert.Equal(t, 0.0, readMetric(t, phaseGauge.WithLabelValues("lcm-namespace", "cephcluster", "Ready")).GetGauge().GetValue())
+	assert.Equal(t, 1.0, readMetric(t, validationGauge.WithLabelValues("lcm-namespace", "cephcluster", "Succeed")).GetGauge().GetValue())
+	assert.Equal(t, 0.0, readMetric(t, validationGauge.WithLabelValues("lcm-namespace", "cephcluster", "Failed")).GetGauge().GetValue())
+	assert.Equal(t, 1, countMetricSeries(clusterVersionGauge))
+
+	// status without version and validation does not reset previous values
+	recordStatusMetrics("lcm-namespace", "cephcluster", cephlcmv1alpha1.CephDeploymentStatus{Phase: cephlcmv1alpha1.PhaseReady})
+	assert.Equal(t, 0.0, readMetric(t, phaseGauge.WithLabelValues("lcm-namespace", "cephcluster", "Deploying")).GetGauge().GetValue())
+	assert.Equal(t, 1.0, readMetric(t, phaseGauge.WithLabelValues("lcm-namespace", "cephcluster", "Ready")).GetGauge().GetValue())
+	assert.Equal(t, 1.0, readMetric(t, validationGauge.WithLabelValues("lcm-namespace", "cephcluster", "Succeed")).GetGauge().GetValue())
+	assert.Equal(t, 1, countMetricSeries(clusterVersionGauge))
+
+	// version change replaces previous version series
+	recordStatusMetrics("lcm-namespace", "cephcluster", cephlcmv1alpha1.CephDeploymentStatus{ClusterVersion: "v20.2.0"})
+	assert.Equal(t, 1, countMetricSeries(clusterVersionGauge))
+	assert.Equal(t, 1.0, readMetric(t, clusterVersionGauge.WithLabelValues("lcm-namespace", "cephcluster", "v20.2.0")).GetGauge().GetValue())
+
+	cleanupMetrics("lcm-namespace", "cephcluster")
+	assert.Equal(t, 0, countMetricSeries(phaseGauge))
+	assert.Equal(t, 0, countMetricSeries(validationGauge))
+	assert.Equal(t, 0, countMetricSeries(clusterVersionGauge))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// getStaleManagers returns running Ceph Manager pods placed on nodes without mgr role,
+// except pods of managers which are going to be removed
+func getStaleManagers(pods []corev1.Pod, mgrNodes, removedMgrs []string) []corev1.Pod {
+	stale := []corev1.Pod{}
+	for _, pod := range pods {
+		if pod.Labels["mgr"] == "" || lcmcommon.Contains(removedMgrs, pod.Labels["mgr"]) {
+			continue
+		}
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if !lcmcommon.Contains(mgrNodes, pod.Spec.NodeName) {
+			stale = append(stale, pod)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].Name < stale[j].Name })
+	return stale
+}
+
+// ensureManagersPlacement moves Ceph Managers from nodes without mgr role and removes
+// extra Ceph Manager deployments. Active manager is failed over to a standby before it
+// is removed, if standby is available. Returns managers count for CephCluster spec, which
+// keeps deployed managers counted while stale ones are moved, to not let Rook remove
+// a standby manager before active one is failed over.
+func (c *cephDeploymentConfig) ensureManagersPlacement(desiredCount int) (int, bool, error) {
+	pods, err := c.api.Kubeclientset.CoreV1().Pods(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{LabelSelector: rookCephMgrLabelSelector})
+	if err != nil {
+		return desiredCount, false, errors.Wrapf(err, "failed to list Ceph Manager pods in %s namespace", c.lcmConfig.RookNamespace)
+	}
+	deployments, err := c.api.Kubeclientset.AppsV1().Deployments(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{LabelSelector: rookCephMgrLabelSelector})
+	if err != nil {
+		return desiredCount, false, errors.Wrapf(err, "failed to list Ceph Manager deployments in %s namespace", c.lcmConfig.RookNamespace)
+	}
+	mgrNodes := []string{}
+	for _, node := range c.cdConfig.nodesListExpanded {
+		if lcmcommon.Contains(node.Roles, "mgr") {
+			mgrNodes = append(mgrNodes, node.Name)
+		}
+	}
+	mgrDeployments := []appsv1.Deployment{}
+	for _, deploy := range deployments.Items {
+		if deploy.Labels["mgr"] != "" {
+			mgrDeployments = append(mgrDeployments, deploy)
+		}
+	}
+	// rook names managers by letters in order, so extra managers are the last ones
+	sort.Slice(mgrDeployments, func(i, j int) bool { return mgrDeployments[i].Name < mgrDeployments[j].Name })
+	extraDeployments := []appsv1.Deployment{}
+	extraMgrs := []string{}
+	if len(mgrDeployments) > desiredCount {
+		extraDeployments = mgrDeployments[desiredCount:]
+		for _, deploy := range extraDeployments {
+			extraMgrs = append(extraMgrs, deploy.Labels["mgr"])
+		}
+	}
+	stale := getStaleManagers(pods.Items, mgrNodes, extraMgrs)
+	if len(stale) == 0 && len(extraDeployments) == 0 {
+		return desiredCount, false, nil
+	}
+
+	var mgrStat lcmcommon.MgrStat
+	err = lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, "ceph mgr stat -f json", &mgrStat)
+	if err != nil {
+		return desiredCount, false, errors.Wrap(err, "failed to check Ceph Managers state")
+	}
+
+	if len(stale) > 0 {
+		mgrCount := desiredCount
+		if len(mgrDeployments) > desiredCount {
+			mgrCount = len(mgrDeployments)
+		}
+		pod := stale[0]
+		mgrName := pod.Labels["mgr"]
+		if mgrName == mgrStat.ActiveName {
+			if mgrStat.NumStandby > 0 {
+				changed, err := c.failoverManager(mgrName)
+				return mgrCount, changed, err
+			}
+			// standby is expected to be started by Rook, otherwise
+			// active manager can be only restarted on another node
+			if mgrCount > 1 {
+				c.log.Info().Msgf("waiting for standby Ceph Manager to fail over active manager '%s' on node '%s'", mgrName, pod.Spec.NodeName)
+				return mgrCount, false, nil
+			}
+			c.log.Warn().Msgf("no standby Ceph Manager available, active manager '%s' on node '%s' is restarted without fail over", mgrName, pod.Spec.NodeName)
+		}
+		c.log.Info().Msgf("removing Ceph Manager '%s' pod %s/%s from node '%s' without mgr role", mgrName, pod.Namespace, pod.Name, pod.Spec.NodeName)
+		err = c.api.Kubeclientset.CoreV1().Pods(c.lcmConfig.RookNamespace).Delete(c.context, pod.Name, metav1.DeleteOptions{})
+		if err != nil {
+			return mgrCount, false, errors.Wrapf(err, "failed to delete Ceph Manager pod %s/%s", pod.Namespace, pod.Name)
+		}
+		return mgrCount, true, nil
+	}
+
+	changed := false
+	for _, deploy := range extraDeployments {
+		mgrName := deploy.Labels["mgr"]
+		if mgrName == mgrStat.ActiveName && mgrStat.NumStandby > 0 {
+			failedOver, err := c.failoverManager(mgrName)
+			return desiredCount, changed || failedOver, err
+		}
+		c.log.Info().Msgf("removing extra Ceph Manager deployment %s/%s", deploy.Namespace, deploy.Name)
+		err = c.api.Kubeclientset.AppsV1().Deployments(c.lcmConfig.RookNamespace).Delete(c.context, deploy.Name, metav1.DeleteOptions{})
+		if err != nil {
+			return desiredCount, changed, errors.Wrapf(err, "failed to delete Ceph Manager deployment %s/%s", deploy.Namespace, deploy.Name)
+		}
+		c.recordObjectEvent(objectDelete, "Deployment", deploy.Namespace, deploy.Name)
+		changed = true
+	}
+	return desiredCount, changed, nil
+}
+
+func (c *cephDeploymentConfig) failoverManager(mgrName string) (bool, error) {
+	c.log.Info().Msgf("failing over active Ceph Manager '%s' to standby", mgrName)
+	_, err := lcmcommon.RunCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, fmt.Sprintf("ceph mgr fail %s", mgrName))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to fail over active Ceph Manager '%s'", mgrName)
+	}
+	return true, nil
+}
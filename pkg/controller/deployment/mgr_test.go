@@ -0,0 +1,269 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func mgrPod(name, node string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rook-ceph-mgr-" + name + "-123",
+			Namespace: "rook-ceph",
+			Labels:    map[string]string{"app": "rook-ceph-mgr", "mgr": name},
+		},
+		Spec: corev1.PodSpec{NodeName: node},
+	}
+}
+
+func mgrDeployment(name string) appsv1.Deployment {
+	return appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rook-ceph-mgr-" + name,
+			Namespace: "rook-ceph",
+			Labels:    map[string]string{"app": "rook-ceph-mgr", "mgr": name},
+		},
+	}
+}
+
+func TestGetStaleManagers(t *testing.T) {
+	pendingPod := mgrPod("c", "")
+	toolboxPod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pelagia-ceph-toolbox"}, Spec: corev1.PodSpec{NodeName: "node-3"}}
+	pods := []corev1.Pod{mgrPod("b", "node-3"), mgrPod("a", "node-1"), pendingPod, toolboxPod}
+
+	assert.Equal(t, []corev1.Pod{}, getStaleManagers(pods, []string{"node-1", "node-3"}, nil))
+	assert.Equal(t, []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-3")}, getStaleManagers(pods, []string{"node-2"}, nil))
+	assert.Equal(t, []corev1.Pod{mgrPod("a", "node-1")}, getStaleManagers(pods, []string{"node-2"}, []string{"b"}))
+}
+
+func TestEnsureManagersPlacement(t *testing.T) {
+	mgrNodes := []cephlcmv1alpha1.CephDeploymentNode{
+		{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mgr"}},
+		{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mgr"}},
+	}
+	movedMgrNodes := []cephlcmv1alpha1.CephDeploymentNode{
+		{Node: cephv1.Node{Name: "node-1"}},
+		{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mgr"}},
+		{Node: cephv1.Node{Name: "node-3"}, Roles: []string{"mgr"}},
+	}
+	tests := []struct {
+		name                string
+		nodes               []cephlcmv1alpha1.CephDeploymentNode
+		desiredCount        int
+		pods                []corev1.Pod
+		deployments         []appsv1.Deployment
+		mgrStat             string
+		apiErrors           map[string]error
+		expectedCount       int
+		expectedChanged     bool
+		expectedCmds        []string
+		expectedPods        []string
+		expectedDeployments []string
+		expectedError       string
+	}{
+		{
+			name:                "managers are placed correctly",
+			nodes:               mgrNodes,
+			desiredCount:        2,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-2")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			expectedCount:       2,
+			expectedCmds:        []string{},
+			expectedPods:        []string{"rook-ceph-mgr-a-123", "rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a", "rook-ceph-mgr-b"},
+		},
+		{
+			name:                "mgr role is moved, active manager is failed over",
+			nodes:               movedMgrNodes,
+			desiredCount:        2,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-2")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			mgrStat:             `{"available":true,"active_name":"a","num_standby":1}`,
+			expectedCount:       2,
+			expectedChanged:     true,
+			expectedCmds:        []string{"ceph mgr stat -f json", "ceph mgr fail a"},
+			expectedPods:        []string{"rook-ceph-mgr-a-123", "rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a", "rook-ceph-mgr-b"},
+		},
+		{
+			name:                "mgr role is moved, standby manager pod is removed",
+			nodes:               movedMgrNodes,
+			desiredCount:        2,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-2")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			mgrStat:             `{"available":true,"active_name":"b","num_standby":1}`,
+			expectedCount:       2,
+			expectedChanged:     true,
+			expectedCmds:        []string{"ceph mgr stat -f json"},
+			expectedPods:        []string{"rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a", "rook-ceph-mgr-b"},
+		},
+		{
+			name: "mgr role is removed, waiting for standby manager",
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}},
+				{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mgr"}},
+			},
+			desiredCount:        1,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			mgrStat:             `{"available":true,"active_name":"a","num_standby":0}`,
+			expectedCount:       2,
+			expectedCmds:        []string{"ceph mgr stat -f json"},
+			expectedPods:        []string{"rook-ceph-mgr-a-123", "rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a", "rook-ceph-mgr-b"},
+		},
+		{
+			name: "single manager is moved without fail over",
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}},
+				{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mgr"}},
+			},
+			desiredCount:        1,
+			pods:                []corev1.Pod{mgrPod("a", "node-1")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a")},
+			mgrStat:             `{"available":true,"active_name":"a","num_standby":0}`,
+			expectedCount:       1,
+			expectedChanged:     true,
+			expectedCmds:        []string{"ceph mgr stat -f json"},
+			expectedPods:        []string{},
+			expectedDeployments: []string{"rook-ceph-mgr-a"},
+		},
+		{
+			name: "mgr role is removed, extra active manager is failed over",
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mgr"}},
+				{Node: cephv1.Node{Name: "node-2"}},
+			},
+			desiredCount:        1,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-2")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			mgrStat:             `{"available":true,"active_name":"b","num_standby":1}`,
+			expectedCount:       1,
+			expectedChanged:     true,
+			expectedCmds:        []string{"ceph mgr stat -f json", "ceph mgr fail b"},
+			expectedPods:        []string{"rook-ceph-mgr-a-123", "rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a", "rook-ceph-mgr-b"},
+		},
+		{
+			name: "mgr role is removed, extra manager deployment is removed",
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mgr"}},
+				{Node: cephv1.Node{Name: "node-2"}},
+			},
+			desiredCount:        1,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-2")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			mgrStat:             `{"available":true,"active_name":"a","num_standby":1}`,
+			expectedCount:       1,
+			expectedChanged:     true,
+			expectedCmds:        []string{"ceph mgr stat -f json"},
+			expectedPods:        []string{"rook-ceph-mgr-a-123", "rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a"},
+		},
+		{
+			name:                "failed to check managers state",
+			nodes:               movedMgrNodes,
+			desiredCount:        2,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-2")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			expectedCount:       2,
+			expectedCmds:        []string{"ceph mgr stat -f json"},
+			expectedPods:        []string{"rook-ceph-mgr-a-123", "rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a", "rook-ceph-mgr-b"},
+			expectedError:       "failed to check Ceph Managers state: failed to run command 'ceph mgr stat -f json': unexpected command",
+		},
+		{
+			name:                "failed to remove stale manager pod",
+			nodes:               movedMgrNodes,
+			desiredCount:        2,
+			pods:                []corev1.Pod{mgrPod("a", "node-1"), mgrPod("b", "node-2")},
+			deployments:         []appsv1.Deployment{mgrDeployment("a"), mgrDeployment("b")},
+			mgrStat:             `{"available":true,"active_name":"b","num_standby":1}`,
+			apiErrors:           map[string]error{"delete-pods": errors.New("delete failed")},
+			expectedCount:       2,
+			expectedCmds:        []string{"ceph mgr stat -f json"},
+			expectedPods:        []string{"rook-ceph-mgr-a-123", "rook-ceph-mgr-b-123"},
+			expectedDeployments: []string{"rook-ceph-mgr-a", "rook-ceph-mgr-b"},
+			expectedError:       "failed to delete Ceph Manager pod rook-ceph/rook-ceph-mgr-a-123: delete failed",
+		},
+	}
+	oldCmdFunc := lcmcommon.RunPodCommandWithValidation
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: unitinputs.CephDeployNonMosk.DeepCopy(), nodesListExpanded: test.nodes}, nil)
+			inputResources := map[string]runtime.Object{
+				"pods":        &corev1.PodList{Items: test.pods},
+				"deployments": &appsv1.DeploymentList{Items: test.deployments},
+			}
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "list", []string{"pods"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "delete", []string{"pods"}, inputResources, test.apiErrors)
+			faketestclients.FakeReaction(c.api.Kubeclientset.AppsV1(), "list", []string{"deployments"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.AppsV1(), "delete", []string{"deployments"}, inputResources, test.apiErrors)
+
+			cmds := []string{}
+			lcmcommon.RunPodCommandWithValidation = func(e lcmcommon.ExecConfig) (string, string, error) {
+				cmds = append(cmds, e.Command)
+				if e.Command == "ceph mgr stat -f json" && test.mgrStat != "" {
+					return test.mgrStat, "", nil
+				}
+				if e.Command == "ceph mgr fail a" || e.Command == "ceph mgr fail b" {
+					return "", "", nil
+				}
+				return "", "", errors.New("unexpected command")
+			}
+
+			count, changed, err := c.ensureManagersPlacement(test.desiredCount)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.expectedCount, count)
+			assert.Equal(t, test.expectedChanged, changed)
+			assert.Equal(t, test.expectedCmds, cmds)
+			podNames := []string{}
+			for _, pod := range inputResources["pods"].(*corev1.PodList).Items {
+				podNames = append(podNames, pod.Name)
+			}
+			assert.Equal(t, test.expectedPods, podNames)
+			deployNames := []string{}
+			for _, deploy := range inputResources["deployments"].(*appsv1.DeploymentList).Items {
+				deployNames = append(deployNames, deploy.Name)
+			}
+			assert.Equal(t, test.expectedDeployments, deployNames)
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.AppsV1())
+		})
+	}
+	lcmcommon.RunPodCommandWithValidation = oldCmdFunc
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// monitorInfo describes Ceph Monitor placement, scheduled by Rook
+type monitorInfo struct {
+	name    string
+	node    string
+	address string
+}
+
+// getMonitorsPlacement returns current Ceph Monitors placement from Rook mon endpoints ConfigMap
+func (c *cephDeploymentConfig) getMonitorsPlacement() ([]monitorInfo, error) {
+	cm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Get(c.context, rookCephMonEndpointsMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s/%s configmap", c.lcmConfig.RookNamespace, rookCephMonEndpointsMapName)
+	}
+	mons := []monitorInfo{}
+	if cm.Data["mapping"] == "" {
+		return mons, nil
+	}
+	// rook keeps monitors placement as map of monitor name to scheduled node info
+	mapping := struct {
+		Node map[string]struct {
+			Name    string `json:"name"`
+			Address string `json:"address"`
+		} `json:"node"`
+	}{}
+	if err := json.Unmarshal([]byte(cm.Data["mapping"]), &mapping); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse monitors mapping from %s/%s configmap", c.lcmConfig.RookNamespace, rookCephMonEndpointsMapName)
+	}
+	for name, scheduled := range mapping.Node {
+		mons = append(mons, monitorInfo{name: name, node: scheduled.Name, address: scheduled.Address})
+	}
+	sort.Slice(mons, func(i, j int) bool { return mons[i].name < mons[j].name })
+	return mons, nil
+}
+
+// getObsoleteMonitors returns Ceph Monitors placed on nodes without mon role or
+// with changed monitor IP, which should be removed one by one
+func getObsoleteMonitors(mons []monitorInfo, nodesListExpanded []cephlcmv1alpha1.CephDeploymentNode) []cephlcmv1alpha1.CephDeploymentMonitorChange {
+	monNodes := map[string]cephlcmv1alpha1.CephDeploymentNode{}
+	for _, node := range nodesListExpanded {
+		if lcmcommon.Contains(node.Roles, "mon") {
+			monNodes[node.Name] = node
+		}
+	}
+	obsolete := []cephlcmv1alpha1.CephDeploymentMonitorChange{}
+	for _, mon := range mons {
+		reason := ""
+		if node, present := monNodes[mon.node]; !present {
+			reason = "mon role is removed from node"
+		} else if node.MonitorIP != "" && mon.address != "" && !isSameIP(node.MonitorIP, mon.address) {
+			reason = fmt.Sprintf("monitor IP is changed from '%s' to '%s'", mon.address, node.MonitorIP)
+		}
+		if reason != "" {
+			obsolete = append(obsolete, cephlcmv1alpha1.CephDeploymentMonitorChange{Name: mon.name, Node: mon.node, Reason: reason})
+		}
+	}
+	return obsolete
+}
+
+// ensureMonitorsPlacement removes Ceph Monitors placed on nodes without mon role or with
+// changed monitor IP. Monitors are removed one by one: the next one is removed from monmap
+// only if all monitors are in quorum, then, once monitor left monmap, it is removed from
+// Rook mon endpoints and its deployment is deleted, so Rook deploys missing monitor on a
+// node with mon role, if needed. Returns monitors count for CephCluster spec, which keeps
+// obsolete monitors counted until they left monmap to not let Rook remove random monitors.
+func (c *cephDeploymentConfig) ensureMonitorsPlacement(desiredCount int) (int, bool, error) {
+	mons, err := c.getMonitorsPlacement()
+	if err != nil {
+		return desiredCount, false, err
+	}
+	obsolete := getObsoleteMonitors(mons, c.cdConfig.nodesListExpanded)
+	if len(obsolete) == 0 {
+		c.cdConfig.cephDpl.Status.Monitors = nil
+		return desiredCount, false, nil
+	}
+	status := &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: obsolete}
+	c.cdConfig.cephDpl.Status.Monitors = status
+	monCount := desiredCount
+	if len(mons) > desiredCount {
+		monCount = len(mons)
+	}
+
+	var quorumStatus lcmcommon.CephQuorumStatus
+	err = lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, "ceph quorum_status -f json", &quorumStatus)
+	if err != nil {
+		return monCount, false, errors.Wrap(err, "failed to check monitors quorum")
+	}
+	monmap := map[string]bool{}
+	for _, mon := range quorumStatus.MonMap.Mons {
+		monmap[mon.Name] = true
+	}
+	for _, mon := range obsolete {
+		if monmap[mon.Name] {
+			continue
+		}
+		// monitor already left monmap, so it is safe to drop it from Rook
+		if err := c.removeMonitorFromRook(mon.Name); err != nil {
+			return monCount, false, err
+		}
+		if len(mons)-1 > desiredCount {
+			monCount = len(mons) - 1
+		} else {
+			monCount = desiredCount
+		}
+		status.Message = fmt.Sprintf("monitor '%s' on node '%s' is removed from monmap and Rook mon endpoints", mon.Name, mon.Node)
+		c.log.Info().Msg(status.Message)
+		return monCount, true, nil
+	}
+	if len(mons) < desiredCount {
+		status.Message = fmt.Sprintf("waiting for Rook to deploy %d monitors, currently deployed %d", desiredCount, len(mons))
+		c.log.Info().Msg(status.Message)
+		return monCount, false, nil
+	}
+	if len(monmap) != len(mons) || len(quorumStatus.QuorumNames) != len(monmap) {
+		status.Message = fmt.Sprintf("waiting for all monitors to join quorum, current quorum %v", quorumStatus.QuorumNames)
+		c.log.Info().Msg(status.Message)
+		return monCount, false, nil
+	}
+	next := obsolete[0]
+	if len(quorumStatus.QuorumNames)-1 <= len(monmap)/2 {
+		status.Message = fmt.Sprintf("monitor '%s' on node '%s' can't be removed, remaining monitors can't keep quorum", next.Name, next.Node)
+		c.log.Error().Msg(status.Message)
+		return monCount, false, nil
+	}
+	c.log.Info().Msgf("removing monitor '%s' on node '%s' from monmap: %s", next.Name, next.Node, next.Reason)
+	_, err = lcmcommon.RunCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, fmt.Sprintf("ceph mon remove %s", next.Name))
+	if err != nil {
+		return monCount, false, errors.Wrapf(err, "failed to remove monitor '%s' from monmap", next.Name)
+	}
+	status.Message = fmt.Sprintf("monitor '%s' on node '%s' is removing from monmap", next.Name, next.Node)
+	return monCount, true, nil
+}
+
+// removeMonitorFromRook removes monitor, which already left monmap, from Rook mon endpoints
+// the same way Rook does on monitor removal and deletes monitor deployment
+func (c *cephDeploymentConfig) removeMonitorFromRook(name string) error {
+	cm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Get(c.context, rookCephMonEndpointsMapName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s/%s configmap", c.lcmConfig.RookNamespace, rookCephMonEndpointsMapName)
+	}
+	// keep the rest of mapping fields as is, since they are managed by Rook
+	mapping := map[string]map[string]any{}
+	if cm.Data["mapping"] != "" {
+		if err := json.Unmarshal([]byte(cm.Data["mapping"]), &mapping); err != nil {
+			return errors.Wrapf(err, "failed to parse monitors mapping from %s/%s configmap", c.lcmConfig.RookNamespace, rookCephMonEndpointsMapName)
+		}
+	}
+	_, present := mapping["node"][name]
+	endpoints := []string{}
+	for _, endpoint := range strings.Split(cm.Data["data"], ",") {
+		if strings.HasPrefix(endpoint, name+"=") {
+			present = true
+		} else if endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if present {
+		delete(mapping["node"], name)
+		data, err := json.Marshal(mapping)
+		if err != nil {
+			return errors.Wrap(err, "failed to prepare monitors mapping")
+		}
+		cm.Data["mapping"] = string(data)
+		cm.Data["data"] = strings.Join(endpoints, ",")
+		c.log.Info().Msgf("removing monitor '%s' from %s/%s configmap", name, c.lcmConfig.RookNamespace, rookCephMonEndpointsMapName)
+		_, err = c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Update(c.context, cm, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to update %s/%s configmap", c.lcmConfig.RookNamespace, rookCephMonEndpointsMapName)
+		}
+	}
+	deployName := fmt.Sprintf(rookCephMonDeploymentTemplate, name)
+	err = c.api.Kubeclientset.AppsV1().Deployments(c.lcmConfig.RookNamespace).Delete(c.context, deployName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete %s/%s deployment", c.lcmConfig.RookNamespace, deployName)
+	}
+	return nil
+}
+
+// isSameIP compares addresses as IPs, since IPv6 address may have few notations
+func isSameIP(first, second string) bool {
+	firstIP, secondIP := net.ParseIP(first), net.ParseIP(second)
+	if firstIP == nil || secondIP == nil {
+		return first == second
+	}
+	return firstIP.Equal(secondIP)
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func monEndpointsWithMapping(mapping string) *corev1.ConfigMap {
+	cm := unitinputs.RookCephMonEndpoints.DeepCopy()
+	cm.Data["mapping"] = mapping
+	return cm
+}
+
+func monDeployment(name string, replicas int32) appsv1.Deployment {
+	return appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "rook-ceph-mon-" + name, Namespace: "rook-ceph"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{Replicas: replicas},
+	}
+}
+
+func TestGetObsoleteMonitors(t *testing.T) {
+	mons := []monitorInfo{
+		{name: "a", node: "node-1", address: "127.0.0.1"},
+		{name: "b", node: "node-2", address: "127.0.0.2"},
+		{name: "c", node: "node-3", address: "127.0.0.3"},
+	}
+	tests := []struct {
+		name     string
+		mons     []monitorInfo
+		nodes    []cephlcmv1alpha1.CephDeploymentNode
+		expected []cephlcmv1alpha1.CephDeploymentMonitorChange
+	}{
+		{
+			name: "all monitors are placed correctly",
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mon", "mgr"}},
+				{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mon"}},
+				{Node: cephv1.Node{Name: "node-3"}, Roles: []string{"mon"}, MonitorIP: "127.0.0.3"},
+			},
+			expected: []cephlcmv1alpha1.CephDeploymentMonitorChange{},
+		},
+		{
+			name: "ipv6 monitor ip in another notation",
+			mons: []monitorInfo{{name: "a", node: "node-1", address: "fd00:10:0:0::10"}},
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mon"}, MonitorIP: "fd00:10::10"},
+			},
+			expected: []cephlcmv1alpha1.CephDeploymentMonitorChange{},
+		},
+		{
+			name: "mon role removed and monitor ip changed",
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mgr"}},
+				{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mon"}},
+				{Node: cephv1.Node{Name: "node-3"}, Roles: []string{"mon"}, MonitorIP: "127.0.0.33"},
+				{Node: cephv1.Node{Name: "node-4"}, Roles: []string{"mon"}},
+			},
+			expected: []cephlcmv1alpha1.CephDeploymentMonitorChange{
+				{Name: "a", Node: "node-1", Reason: "mon role is removed from node"},
+				{Name: "c", Node: "node-3", Reason: "monitor IP is changed from '127.0.0.3' to '127.0.0.33'"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.mons == nil {
+				test.mons = mons
+			}
+			assert.Equal(t, test.expected, getObsoleteMonitors(test.mons, test.nodes))
+		})
+	}
+}
+
+func TestEnsureMonitorsPlacement(t *testing.T) {
+	mapping := `{"node":{"a":{"Name":"node-1","Hostname":"node-1","Address":"127.0.0.1"},"b":{"Name":"node-2","Hostname":"node-2","Address":"127.0.0.2"},"c":{"Name":"node-3","Hostname":"node-3","Address":"127.0.0.3"}}}`
+	monNodes := []cephlcmv1alpha1.CephDeploymentNode{
+		{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mon"}},
+		{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mon"}},
+		{Node: cephv1.Node{Name: "node-3"}, Roles: []string{"mon"}},
+	}
+	scaleDownNodes := []cephlcmv1alpha1.CephDeploymentNode{
+		{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mon"}},
+		{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mon"}},
+		{Node: cephv1.Node{Name: "node-3"}},
+	}
+	quorumFull := `{"quorum_names":["a","b","c"],"monmap":{"mons":[{"name":"a"},{"name":"b"},{"name":"c"}]}}`
+	quorumPartial := `{"quorum_names":["a","b"],"monmap":{"mons":[{"name":"a"},{"name":"b"},{"name":"c"}]}}`
+	quorumWithoutC := `{"quorum_names":["a","b"],"monmap":{"mons":[{"name":"a"},{"name":"b"}]}}`
+	pendingC := []cephlcmv1alpha1.CephDeploymentMonitorChange{{Name: "c", Node: "node-3", Reason: "mon role is removed from node"}}
+	tests := []struct {
+		name                 string
+		nodes                []cephlcmv1alpha1.CephDeploymentNode
+		desiredCount         int
+		monEndpoints         *corev1.ConfigMap
+		deployments          []appsv1.Deployment
+		quorumStatus         string
+		cmdErrors            map[string]error
+		apiErrors            map[string]error
+		expectedCount        int
+		expectedChanged      bool
+		expectedStatus       *cephlcmv1alpha1.CephDeploymentMonitorsStatus
+		expectedCommands     []string
+		expectedMonEndpoints map[string]string
+		expectedDeployments  []string
+		expectedError        string
+	}{
+		{
+			name:          "failed to get mon endpoints",
+			nodes:         monNodes,
+			desiredCount:  3,
+			apiErrors:     map[string]error{"get-configmaps": errors.New("get failed")},
+			expectedCount: 3,
+			expectedError: "failed to get rook-ceph/rook-ceph-mon-endpoints configmap: get failed",
+		},
+		{
+			name:          "no monitors mapping yet",
+			nodes:         monNodes,
+			desiredCount:  3,
+			monEndpoints:  unitinputs.RookCephMonEndpoints.DeepCopy(),
+			expectedCount: 3,
+		},
+		{
+			name:          "monitors are placed correctly",
+			nodes:         monNodes,
+			desiredCount:  3,
+			monEndpoints:  monEndpointsWithMapping(mapping),
+			expectedCount: 3,
+		},
+		{
+			name:           "monitor is removed, failed to check quorum",
+			nodes:          scaleDownNodes,
+			desiredCount:   2,
+			monEndpoints:   monEndpointsWithMapping(mapping),
+			cmdErrors:      map[string]error{"ceph quorum_status -f json": errors.New("command failed")},
+			expectedCount:  3,
+			expectedStatus: &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: pendingC},
+			expectedError:  "failed to check monitors quorum: failed to run command 'ceph quorum_status -f json': command failed",
+		},
+		{
+			name:                "monitor is removed, remove obsolete monitor from monmap",
+			nodes:               scaleDownNodes,
+			desiredCount:        2,
+			monEndpoints:        monEndpointsWithMapping(mapping),
+			deployments:         []appsv1.Deployment{monDeployment("a", 1), monDeployment("b", 1), monDeployment("c", 1)},
+			quorumStatus:        quorumFull,
+			expectedCount:       3,
+			expectedChanged:     true,
+			expectedStatus:      &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: pendingC, Message: "monitor 'c' on node 'node-3' is removing from monmap"},
+			expectedCommands:    []string{"ceph quorum_status -f json", "ceph mon remove c"},
+			expectedDeployments: []string{"rook-ceph-mon-a", "rook-ceph-mon-b", "rook-ceph-mon-c"},
+		},
+		{
+			name:                "monitor is removed, waiting for quorum",
+			nodes:               scaleDownNodes,
+			desiredCount:        2,
+			monEndpoints:        monEndpointsWithMapping(mapping),
+			deployments:         []appsv1.Deployment{monDeployment("a", 1), monDeployment("b", 1), monDeployment("c", 1)},
+			quorumStatus:        quorumPartial,
+			expectedCount:       3,
+			expectedStatus:      &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: pendingC, Message: "waiting for all monitors to join quorum, current quorum [a b]"},
+			expectedCommands:    []string{"ceph quorum_status -f json"},
+			expectedDeployments: []string{"rook-ceph-mon-a", "rook-ceph-mon-b", "rook-ceph-mon-c"},
+		},
+		{
+			name:             "monitor is removed, failed to remove monitor from monmap",
+			nodes:            scaleDownNodes,
+			desiredCount:     2,
+			monEndpoints:     monEndpointsWithMapping(mapping),
+			quorumStatus:     quorumFull,
+			cmdErrors:        map[string]error{"ceph mon remove c": errors.New("command failed")},
+			expectedCount:    3,
+			expectedStatus:   &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: pendingC},
+			expectedCommands: []string{"ceph quorum_status -f json", "ceph mon remove c"},
+			expectedError:    "failed to remove monitor 'c' from monmap: failed to run command 'ceph mon remove c': command failed",
+		},
+		{
+			name:             "monitor is removed, obsolete monitor left monmap, remove it from rook",
+			nodes:            scaleDownNodes,
+			desiredCount:     2,
+			monEndpoints:     monEndpointsWithMapping(mapping),
+			deployments:      []appsv1.Deployment{monDeployment("a", 1), monDeployment("b", 1), monDeployment("c", 1)},
+			quorumStatus:     quorumWithoutC,
+			expectedCount:    2,
+			expectedChanged:  true,
+			expectedStatus:   &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: pendingC, Message: "monitor 'c' on node 'node-3' is removed from monmap and Rook mon endpoints"},
+			expectedCommands: []string{"ceph quorum_status -f json"},
+			expectedMonEndpoints: map[string]string{
+				"data":    "a=127.0.0.1,b=127.0.0.2",
+				"mapping": `{"node":{"a":{"Address":"127.0.0.1","Hostname":"node-1","Name":"node-1"},"b":{"Address":"127.0.0.2","Hostname":"node-2","Name":"node-2"}}}`,
+			},
+			expectedDeployments: []string{"rook-ceph-mon-a", "rook-ceph-mon-b"},
+		},
+		{
+			name:                "monitor is removed, obsolete monitor left monmap, failed to update mon endpoints",
+			nodes:               scaleDownNodes,
+			desiredCount:        2,
+			monEndpoints:        monEndpointsWithMapping(mapping),
+			deployments:         []appsv1.Deployment{monDeployment("a", 1), monDeployment("b", 1), monDeployment("c", 1)},
+			quorumStatus:        quorumWithoutC,
+			apiErrors:           map[string]error{"update-configmaps": errors.New("update failed")},
+			expectedCount:       3,
+			expectedStatus:      &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: pendingC},
+			expectedCommands:    []string{"ceph quorum_status -f json"},
+			expectedDeployments: []string{"rook-ceph-mon-a", "rook-ceph-mon-b", "rook-ceph-mon-c"},
+			expectedError:       "failed to update rook-ceph/rook-ceph-mon-endpoints configmap: update failed",
+		},
+		{
+			name: "monitor is moved, waiting for new monitor",
+			nodes: []cephlcmv1alpha1.CephDeploymentNode{
+				{Node: cephv1.Node{Name: "node-1"}, Roles: []string{"mon"}},
+				{Node: cephv1.Node{Name: "node-2"}, Roles: []string{"mon"}},
+				{Node: cephv1.Node{Name: "node-4"}, Roles: []string{"mon"}},
+			},
+			desiredCount:        3,
+			monEndpoints:        monEndpointsWithMapping(`{"node":{"a":{"Name":"node-1","Address":"127.0.0.1"},"c":{"Name":"node-3","Address":"127.0.0.3"}}}`),
+			deployments:         []appsv1.Deployment{monDeployment("a", 1), monDeployment("c", 1)},
+			quorumStatus:        `{"quorum_names":["a","c"],"monmap":{"mons":[{"name":"a"},{"name":"c"}]}}`,
+			expectedCount:       3,
+			expectedStatus:      &cephlcmv1alpha1.CephDeploymentMonitorsStatus{Pending: pendingC, Message: "waiting for Rook to deploy 3 monitors, currently deployed 2"},
+			expectedCommands:    []string{"ceph quorum_status -f json"},
+			expectedDeployments: []string{"rook-ceph-mon-a", "rook-ceph-mon-c"},
+		},
+	}
+	oldCmdFunc := lcmcommon.RunPodCommandWithValidation
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: unitinputs.CephDeployNonMosk.DeepCopy(), nodesListExpanded: test.nodes}, nil)
+			configMaps := &corev1.ConfigMapList{}
+			if test.monEndpoints != nil {
+				configMaps.Items = []corev1.ConfigMap{*test.monEndpoints}
+			}
+			inputResources := map[string]runtime.Object{
+				"configmaps":  configMaps,
+				"deployments": &appsv1.DeploymentList{Items: test.deployments},
+			}
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "get", []string{"configmaps"}, inputResources, test.apiErrors)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "update", []string{"configmaps"}, inputResources, test.apiErrors)
+			faketestclients.FakeReaction(c.api.Kubeclientset.AppsV1(), "delete", []string{"deployments"}, inputResources, test.apiErrors)
+
+			commands := []string{}
+			lcmcommon.RunPodCommandWithValidation = func(e lcmcommon.ExecConfig) (string, string, error) {
+				commands = append(commands, e.Command)
+				if err := test.cmdErrors[e.Command]; err != nil {
+					return "", "", err
+				}
+				if e.Command == "ceph quorum_status -f json" && test.quorumStatus != "" {
+					return test.quorumStatus, "", nil
+				}
+				if e.Command == "ceph mon remove c" {
+					return "", "", nil
+				}
+				return "", "", errors.New("unexpected command: " + e.Command)
+			}
+
+			count, changed, err := c.ensureMonitorsPlacement(test.desiredCount)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.expectedCount, count)
+			assert.Equal(t, test.expectedChanged, changed)
+			assert.Equal(t, test.expectedStatus, c.cdConfig.cephDpl.Status.Monitors)
+			if test.expectedCommands != nil {
+				assert.Equal(t, test.expectedCommands, commands)
+			}
+			if test.expectedMonEndpoints != nil {
+				assert.Equal(t, test.expectedMonEndpoints, inputResources["configmaps"].(*corev1.ConfigMapList).Items[0].Data)
+			}
+			if test.expectedDeployments != nil {
+				deployments := []string{}
+				for _, deploy := range inputResources["deployments"].(*appsv1.DeploymentList).Items {
+					deployments = append(deployments, deploy.Name)
+				}
+				assert.Equal(t, test.expectedDeployments, deployments)
+			}
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.AppsV1())
+		})
+	}
+	lcmcommon.RunPodCommandWithValidation = oldCmdFunc
+}
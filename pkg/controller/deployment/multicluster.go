@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+// getCephDeploymentRookNamespace returns rook namespace used by CephDeployment,
+// if namespace is not specified in spec - default rook namespace is used
+func getCephDeploymentRookNamespace(cephDpl *cephlcmv1alpha1.CephDeployment, defaultRookNamespace string) string {
+	if cephDpl.Spec.RookNamespace != "" {
+		return cephDpl.Spec.RookNamespace
+	}
+	return defaultRookNamespace
+}
+
+// isOlderCephDeployment checks whether CephDeployment a is created before b,
+// for equal creation timestamps name order is used
+func isOlderCephDeployment(a, b *cephlcmv1alpha1.CephDeployment) bool {
+	if a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.Name < b.Name
+	}
+	return a.CreationTimestamp.Before(&b.CreationTimestamp)
+}
+
+// getRookNamespaceOwner returns CephDeployment from the list which owns the same rook namespace
+// as passed CephDeployment, the oldest CephDeployment keeps rook namespace. Returns nil if
+// passed CephDeployment is the owner itself
+func getRookNamespaceOwner(cephDpls []cephlcmv1alpha1.CephDeployment, cephDpl *cephlcmv1alpha1.CephDeployment, defaultRookNamespace string) *cephlcmv1alpha1.CephDeployment {
+	rookNamespace := getCephDeploymentRookNamespace(cephDpl, defaultRookNamespace)
+	var owner *cephlcmv1alpha1.CephDeployment
+	for idx := range cephDpls {
+		item := &cephDpls[idx]
+		if item.Name == cephDpl.Name || getCephDeploymentRookNamespace(item, defaultRookNamespace) != rookNamespace {
+			continue
+		}
+		if isOlderCephDeployment(item, cephDpl) && (owner == nil || isOlderCephDeployment(item, owner)) {
+			owner = item
+		}
+	}
+	return owner
+}
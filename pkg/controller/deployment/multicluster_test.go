@@ -0,0 +1,85 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+func TestGetRookNamespaceOwner(t *testing.T) {
+	newCephDpl := func(name, rookNamespace string, created int) cephlcmv1alpha1.CephDeployment {
+		return cephlcmv1alpha1.CephDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "lcm-namespace",
+				CreationTimestamp: metav1.NewTime(time.Date(2026, 1, created, 0, 0, 0, 0, time.UTC)),
+			},
+			Spec: cephlcmv1alpha1.CephDeploymentSpec{RookNamespace: rookNamespace},
+		}
+	}
+	tests := []struct {
+		name          string
+		cephDpls      []cephlcmv1alpha1.CephDeployment
+		cephDpl       cephlcmv1alpha1.CephDeployment
+		expectedOwner string
+	}{
+		{
+			name:     "single cephdeployment",
+			cephDpls: []cephlcmv1alpha1.CephDeployment{newCephDpl("cluster-a", "", 1)},
+			cephDpl:  newCephDpl("cluster-a", "", 1),
+		},
+		{
+			name:     "few cephdeployments with own rook namespaces",
+			cephDpls: []cephlcmv1alpha1.CephDeployment{newCephDpl("cluster-a", "", 1), newCephDpl("cluster-b", "rook-ceph-b", 2)},
+			cephDpl:  newCephDpl("cluster-b", "rook-ceph-b", 2),
+		},
+		{
+			name:     "older cephdeployment keeps default rook namespace",
+			cephDpls: []cephlcmv1alpha1.CephDeployment{newCephDpl("cluster-a", "", 1), newCephDpl("cluster-b", "rook-ceph", 2)},
+			cephDpl:  newCephDpl("cluster-a", "", 1),
+		},
+		{
+			name:          "newer cephdeployment uses already owned rook namespace",
+			cephDpls:      []cephlcmv1alpha1.CephDeployment{newCephDpl("cluster-b", "rook-ceph", 2), newCephDpl("cluster-c", "", 3), newCephDpl("cluster-a", "", 1)},
+			cephDpl:       newCephDpl("cluster-c", "", 3),
+			expectedOwner: "cluster-a",
+		},
+		{
+			name:          "cephdeployments created at the same time",
+			cephDpls:      []cephlcmv1alpha1.CephDeployment{newCephDpl("cluster-a", "", 1), newCephDpl("cluster-b", "", 1)},
+			cephDpl:       newCephDpl("cluster-b", "", 1),
+			expectedOwner: "cluster-a",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			owner := getRookNamespaceOwner(test.cephDpls, &test.cephDpl, "rook-ceph")
+			if test.expectedOwner == "" {
+				assert.Nil(t, owner)
+			} else {
+				assert.NotNil(t, owner)
+				assert.Equal(t, test.expectedOwner, owner.Name)
+			}
+		})
+	}
+}
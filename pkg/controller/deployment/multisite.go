@@ -152,6 +152,8 @@ func (c *cephDeploymentConfig) ensureRealms() (bool, error) {
 			msg := fmt.Sprintf("failed to create CephObjectRealm '%s/%s': %s", c.lcmConfig.RookNamespace, realm.Name, err.Error())
 			c.log.Error().Err(err).Msg(msg)
 			errCollector = append(errCollector, msg)
+		} else {
+			c.recordObjectEvent(objectCreate, "CephObjectRealm", c.lcmConfig.RookNamespace, realm.Name)
 		}
 	}
 
@@ -243,6 +245,8 @@ func (c *cephDeploymentConfig) ensureZoneGroups() (bool, error) {
 			msg := fmt.Sprintf("failed to create CephObjectZoneGroup '%s/%s': %s", c.lcmConfig.RookNamespace, zoneGroup.Name, err.Error())
 			c.log.Error().Err(err).Msg(msg)
 			errCollector = append(errCollector, msg)
+		} else {
+			c.recordObjectEvent(objectCreate, "CephObjectZoneGroup", c.lcmConfig.RookNamespace, zoneGroup.Name)
 		}
 	}
 
@@ -337,6 +341,8 @@ func (c *cephDeploymentConfig) ensureZones() (bool, error) {
 			msg := fmt.Sprintf("failed to create CephObjectZone '%s/%s': %s", c.lcmConfig.RookNamespace, zone.Name, err.Error())
 			c.log.Error().Err(err).Msg(msg)
 			errCollector = append(errCollector, msg)
+		} else {
+			c.recordObjectEvent(objectCreate, "CephObjectZone", c.lcmConfig.RookNamespace, zone.Name)
 		}
 	}
 
@@ -439,7 +445,11 @@ func (c *cephDeploymentConfig) deleteMultiSite() (bool, error) {
 func (c *cephDeploymentConfig) deleteZone(zone string) error {
 	c.log.Info().Msgf("removing CephObjectZone '%s/%s'", c.lcmConfig.RookNamespace, zone)
 	err := c.api.Rookclientset.CephV1().CephObjectZones(c.lcmConfig.RookNamespace).Delete(c.context, zone, metav1.DeleteOptions{})
-	if err == nil || apierrors.IsNotFound(err) {
+	if err == nil {
+		c.recordObjectEvent(objectDelete, "CephObjectZone", c.lcmConfig.RookNamespace, zone)
+		return nil
+	}
+	if apierrors.IsNotFound(err) {
 		return nil
 	}
 	return errors.Wrapf(err, "failed to delete CephObjectZone '%s/%s'", c.lcmConfig.RookNamespace, zone)
@@ -466,7 +476,11 @@ func (c *cephDeploymentConfig) deleteZoneGroup(zoneGroup string) error {
 		}
 	}
 	err = c.api.Rookclientset.CephV1().CephObjectZoneGroups(c.lcmConfig.RookNamespace).Delete(c.context, zoneGroup, metav1.DeleteOptions{})
-	if err == nil || apierrors.IsNotFound(err) {
+	if err == nil {
+		c.recordObjectEvent(objectDelete, "CephObjectZoneGroup", c.lcmConfig.RookNamespace, zoneGroup)
+		return nil
+	}
+	if apierrors.IsNotFound(err) {
 		return nil
 	}
 	return errors.Wrapf(err, "failed to delete CephObjectZoneGroup '%s/%s'", c.lcmConfig.RookNamespace, zoneGroup)
@@ -497,7 +511,11 @@ func (c *cephDeploymentConfig) deleteRealm(realm string) error {
 			}
 		}
 		err = c.api.Rookclientset.CephV1().CephObjectRealms(c.lcmConfig.RookNamespace).Delete(c.context, realm, metav1.DeleteOptions{})
-		if err == nil || apierrors.IsNotFound(err) {
+		if err == nil {
+			c.recordObjectEvent(objectDelete, "CephObjectRealm", c.lcmConfig.RookNamespace, realm)
+			return nil
+		}
+		if apierrors.IsNotFound(err) {
 			return nil
 		}
 		return errors.Wrapf(err, "failed to delete CephObjectRealm '%s/%s'", c.lcmConfig.RookNamespace, realm)
@@ -72,6 +72,8 @@ func (c *cephDeploymentConfig) ensureNetworkPolicy() (bool, error) {
 	return !removed, nil
 }
 
+// getPortsForPolicies returns ingress ports for Ceph daemons. Policies have no
+// address blocks, so they are applied to IPv4 and IPv6 traffic in the same way.
 func (c *cephDeploymentConfig) getPortsForPolicies() map[string][]networkingv1.NetworkPolicyPort {
 	protocol := corev1.ProtocolTCP
 	getPort := func(port int32) *intstr.IntOrString {
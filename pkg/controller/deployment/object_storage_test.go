@@ -115,7 +115,7 @@ func TestEnsureObjectStorage(t *testing.T) {
 					Items: []storagev1.StorageClass{*unitinputs.RgwStorageClass.DeepCopy()},
 				},
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreExternal.DeepCopy()},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreExternal)},
 				},
 			},
 		},
@@ -208,7 +208,7 @@ func TestEnsureObjectStorage(t *testing.T) {
 								"cephdeployment.lcm.mirantis.com/ssl-cert-generated":                    "",
 								"cephdeployment.lcm.mirantis.com/config-client.rgw.rgw.store.a-updated": "",
 							}
-							return *store
+							return getAppliedRgw(*store)
 						}(),
 					},
 				},
@@ -38,6 +38,9 @@ var (
 		cephConfigMap: map[string]string{},
 		rgwSSLCert:    map[string]string{},
 	}
+	// runtime vars saved per CephDeployment, since few Ceph clusters may be
+	// managed by controller, each one in own rook namespace
+	clustersRuntimeVars = map[string]clusterRuntimeVars{}
 
 	// default labels for resources created by controller
 	baseResourceLabels = lcmcommon.PelagiaResourceLabels(ControllerName)
@@ -67,6 +70,12 @@ type deployConfig struct {
 	currentCephVersion *lcmcommon.CephVersion
 	// parsed ceph image for current cephDpl
 	currentCephImage string
+	// configuration sections with paused apply
+	pausedSections []cephlcmv1alpha1.CephDeploymentSection
+	// managed objects changed outside of CephDeployment, found during apply
+	drifts []cephlcmv1alpha1.CephDeploymentObjectDrift
+	// managed objects kinds verified for drifts during apply
+	driftCheckedKinds []string
 }
 
 type updateTimestamps struct {
@@ -82,3 +91,27 @@ func unsetTimestampsVar() {
 		rgwSSLCert:    map[string]string{},
 	}
 }
+
+type clusterRuntimeVars struct {
+	updateTimestamps updateTimestamps
+	failTry          int
+}
+
+// loadClusterRuntimeVars sets runtime vars to ones saved for particular CephDeployment
+func loadClusterRuntimeVars(key string) {
+	if vars, present := clustersRuntimeVars[key]; present {
+		resourceUpdateTimestamps = vars.updateTimestamps
+		currentFailTry = vars.failTry
+		return
+	}
+	unsetTimestampsVar()
+	currentFailTry = 0
+}
+
+// storeClusterRuntimeVars saves current runtime vars for particular CephDeployment
+func storeClusterRuntimeVars(key string) {
+	clustersRuntimeVars[key] = clusterRuntimeVars{
+		updateTimestamps: resourceUpdateTimestamps,
+		failTry:          currentFailTry,
+	}
+}
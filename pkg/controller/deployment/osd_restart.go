@@ -0,0 +1,246 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+const (
+	// rook label selector for Ceph OSD pods
+	rookCephOsdLabelSelector = "app=rook-ceph-osd"
+	// rook labels for Ceph OSD id and crush location
+	rookCephOsdIDLabel             = "ceph-osd-id"
+	rookCephOsdTopologyLabelTmpl   = "topology-location-%s"
+	defaultOsdRestartFailureDomain = "host"
+)
+
+// getOsdRestartDomains groups running Ceph OSDs by crush failure domain from OSD pods labels
+func getOsdRestartDomains(pods []corev1.Pod, failureDomain string) ([]cephlcmv1alpha1.CephDeploymentOsdRestartDomain, error) {
+	osdsByDomain := map[string][]string{}
+	for _, pod := range pods {
+		osdID := pod.Labels[rookCephOsdIDLabel]
+		if osdID == "" {
+			continue
+		}
+		domain := pod.Labels[fmt.Sprintf(rookCephOsdTopologyLabelTmpl, failureDomain)]
+		if domain == "" {
+			if failureDomain != defaultOsdRestartFailureDomain || pod.Spec.NodeName == "" {
+				return nil, errors.Errorf("failed to find '%s' failure domain for osd '%s' pod %s/%s", failureDomain, osdID, pod.Namespace, pod.Name)
+			}
+			domain = pod.Spec.NodeName
+		}
+		if !lcmcommon.Contains(osdsByDomain[domain], osdID) {
+			osdsByDomain[domain] = append(osdsByDomain[domain], osdID)
+		}
+	}
+	domains := []cephlcmv1alpha1.CephDeploymentOsdRestartDomain{}
+	for name, osds := range osdsByDomain {
+		sort.Strings(osds)
+		domains = append(domains, cephlcmv1alpha1.CephDeploymentOsdRestartDomain{
+			Name:  name,
+			Osds:  osds,
+			State: cephlcmv1alpha1.OsdRestartDomainPending,
+		})
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Name < domains[j].Name })
+	return domains, nil
+}
+
+// isOsdDomainRestarted checks that all failure domain osds are running in pods
+// created after restart and ready
+func isOsdDomainRestarted(pods []corev1.Pod, domain cephlcmv1alpha1.CephDeploymentOsdRestartDomain) bool {
+	for _, osdID := range domain.Osds {
+		restarted := false
+		for _, pod := range pods {
+			if pod.Labels[rookCephOsdIDLabel] != osdID || pod.DeletionTimestamp != nil {
+				continue
+			}
+			if domain.RestartedAt != nil && pod.CreationTimestamp.Before(domain.RestartedAt) {
+				continue
+			}
+			for _, cond := range pod.Status.Conditions {
+				if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+					restarted = true
+					break
+				}
+			}
+		}
+		if !restarted {
+			return false
+		}
+	}
+	return true
+}
+
+// isPgsActiveClean checks that all placement groups are active+clean
+func isPgsActiveClean(cephStatus lcmcommon.CephStatus) bool {
+	activeClean := 0
+	for _, state := range cephStatus.PgMap.PgsByState {
+		if state.StateName == "active+clean" {
+			activeClean += state.Count
+		}
+	}
+	return activeClean == cephStatus.PgMap.NumPgs
+}
+
+// ensureOsdRestart restarts Ceph OSDs one failure domain at a time, when osd restart reason
+// is specified or changed. Next failure domain is restarted only when previous one osds are
+// up and ready, all placement groups are active+clean and Ceph reports osds ok-to-stop.
+// Restart progress is kept in status, restart may be paused, resumed or aborted. Restart
+// in progress is reported as changed until all osds are restarted and placement groups
+// are active+clean, so CephDeployment stays in Deploying phase, paused restart is not.
+func (c *cephDeploymentConfig) ensureOsdRestart() (bool, error) {
+	extraOpts := c.cdConfig.cephDpl.Spec.ExtraOpts
+	if extraOpts == nil || extraOpts.OsdRestartReason == "" {
+		c.cdConfig.cephDpl.Status.OsdRestart = nil
+		return false, nil
+	}
+	failureDomain := extraOpts.OsdRestartFailureDomain
+	if failureDomain == "" {
+		failureDomain = defaultOsdRestartFailureDomain
+	}
+	status := c.cdConfig.cephDpl.Status.OsdRestart
+	if status == nil || status.Reason != extraOpts.OsdRestartReason {
+		if status == nil {
+			// osds restart with the same reason could be already done by previous
+			// controller version through CephCluster osd annotations
+			cephCluster, err := c.api.Rookclientset.CephV1().CephClusters(c.lcmConfig.RookNamespace).Get(c.context, c.cdConfig.cephDpl.Name, metav1.GetOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return false, errors.Wrapf(err, "failed to get %s/%s cephcluster", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name)
+			}
+			if err == nil && cephCluster.Annotations[cephRestartOsdLabel] == extraOpts.OsdRestartReason {
+				c.cdConfig.cephDpl.Status.OsdRestart = &cephlcmv1alpha1.CephDeploymentOsdRestartStatus{
+					Reason:        extraOpts.OsdRestartReason,
+					FailureDomain: failureDomain,
+					Phase:         cephlcmv1alpha1.OsdRestartCompleted,
+					Message:       "osds are already restarted with specified reason",
+					StartedAt:     getConditionTransitionTime(),
+				}
+				return false, nil
+			}
+		}
+		pods, err := c.api.Kubeclientset.CoreV1().Pods(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{LabelSelector: rookCephOsdLabelSelector})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to list Ceph OSD pods in %s namespace", c.lcmConfig.RookNamespace)
+		}
+		domains, err := getOsdRestartDomains(pods.Items, failureDomain)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to prepare osds restart")
+		}
+		c.log.Info().Msgf("starting osds restart by '%s' failure domain with reason '%s'", failureDomain, extraOpts.OsdRestartReason)
+		status = &cephlcmv1alpha1.CephDeploymentOsdRestartStatus{
+			Reason:        extraOpts.OsdRestartReason,
+			FailureDomain: failureDomain,
+			Phase:         cephlcmv1alpha1.OsdRestartInProgress,
+			Domains:       domains,
+			StartedAt:     getConditionTransitionTime(),
+		}
+		c.cdConfig.cephDpl.Status.OsdRestart = status
+	}
+	if status.Phase == cephlcmv1alpha1.OsdRestartCompleted || status.Phase == cephlcmv1alpha1.OsdRestartAborted {
+		return false, nil
+	}
+	if extraOpts.OsdRestartAction == cephlcmv1alpha1.OsdRestartActionAbort {
+		status.Phase = cephlcmv1alpha1.OsdRestartAborted
+		status.Message = "osds restart is aborted"
+		c.log.Warn().Msgf("osds restart with reason '%s' is aborted", status.Reason)
+		return false, nil
+	}
+
+	inProgress := extraOpts.OsdRestartAction != cephlcmv1alpha1.OsdRestartActionPause
+	pods, err := c.api.Kubeclientset.CoreV1().Pods(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{LabelSelector: rookCephOsdLabelSelector})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list Ceph OSD pods in %s namespace", c.lcmConfig.RookNamespace)
+	}
+	next := -1
+	for idx := range status.Domains {
+		domain := &status.Domains[idx]
+		if domain.State == cephlcmv1alpha1.OsdRestartDomainRestarting && !isOsdDomainRestarted(pods.Items, *domain) {
+			status.Message = fmt.Sprintf("waiting for osds of '%s' %s to be restarted", domain.Name, status.FailureDomain)
+			c.log.Info().Msg(status.Message)
+			return inProgress, nil
+		}
+		if domain.State == cephlcmv1alpha1.OsdRestartDomainPending && next < 0 {
+			next = idx
+		}
+	}
+
+	var cephStatus lcmcommon.CephStatus
+	err = lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, "ceph status -f json", &cephStatus)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check placement groups state")
+	}
+	if !isPgsActiveClean(cephStatus) {
+		status.Message = "waiting for all placement groups to be active+clean"
+		c.log.Info().Msg(status.Message)
+		return inProgress, nil
+	}
+	changed := false
+	for idx := range status.Domains {
+		if status.Domains[idx].State == cephlcmv1alpha1.OsdRestartDomainRestarting {
+			c.log.Info().Msgf("osds of '%s' %s are restarted", status.Domains[idx].Name, status.FailureDomain)
+			status.Domains[idx].State = cephlcmv1alpha1.OsdRestartDomainCompleted
+			changed = true
+		}
+	}
+	if next < 0 {
+		status.Phase = cephlcmv1alpha1.OsdRestartCompleted
+		status.Message = "all osds are restarted"
+		c.log.Info().Msgf("osds restart with reason '%s' is completed", status.Reason)
+		return changed, nil
+	}
+	if !inProgress {
+		status.Phase = cephlcmv1alpha1.OsdRestartPaused
+		status.Message = fmt.Sprintf("osds restart is paused before '%s' %s", status.Domains[next].Name, status.FailureDomain)
+		c.log.Info().Msg(status.Message)
+		return changed, nil
+	}
+	status.Phase = cephlcmv1alpha1.OsdRestartInProgress
+
+	domain := &status.Domains[next]
+	_, err = lcmcommon.RunCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, fmt.Sprintf("ceph osd ok-to-stop %s", strings.Join(domain.Osds, " ")))
+	if err != nil {
+		c.log.Warn().Err(err).Msgf("ok-to-stop failed for osds %v of '%s' %s", domain.Osds, domain.Name, status.FailureDomain)
+		status.Message = fmt.Sprintf("waiting for osds of '%s' %s to be ok-to-stop", domain.Name, status.FailureDomain)
+		return true, nil
+	}
+	c.log.Info().Msgf("restarting osds %v of '%s' %s", domain.Osds, domain.Name, status.FailureDomain)
+	for _, pod := range pods.Items {
+		if lcmcommon.Contains(domain.Osds, pod.Labels[rookCephOsdIDLabel]) && pod.DeletionTimestamp == nil {
+			err = c.api.Kubeclientset.CoreV1().Pods(c.lcmConfig.RookNamespace).Delete(c.context, pod.Name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return changed, errors.Wrapf(err, "failed to delete Ceph OSD pod %s/%s", pod.Namespace, pod.Name)
+			}
+		}
+	}
+	restartedAt := getConditionTransitionTime()
+	domain.State = cephlcmv1alpha1.OsdRestartDomainRestarting
+	domain.RestartedAt = &restartedAt
+	status.Message = fmt.Sprintf("osds of '%s' %s are restarting", domain.Name, status.FailureDomain)
+	return true, nil
+}
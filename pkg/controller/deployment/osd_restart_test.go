@@ -0,0 +1,306 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func osdPod(id, host string, created time.Time, ready bool) corev1.Pod {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "rook-ceph-osd-" + id + "-123",
+			Namespace:         "rook-ceph",
+			Labels:            map[string]string{"app": "rook-ceph-osd", "ceph-osd-id": id, "topology-location-host": host},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: corev1.PodSpec{NodeName: host},
+	}
+	if ready {
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	}
+	return pod
+}
+
+func TestGetOsdRestartDomains(t *testing.T) {
+	created := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	noHostLabelPod := osdPod("3", "node-3", created, true)
+	delete(noHostLabelPod.Labels, "topology-location-host")
+	pods := []corev1.Pod{osdPod("2", "node-2", created, true), osdPod("1", "node-1", created, true), osdPod("0", "node-1", created, true), noHostLabelPod}
+
+	domains, err := getOsdRestartDomains(pods, "host")
+	assert.Nil(t, err)
+	assert.Equal(t, []cephlcmv1alpha1.CephDeploymentOsdRestartDomain{
+		{Name: "node-1", Osds: []string{"0", "1"}, State: cephlcmv1alpha1.OsdRestartDomainPending},
+		{Name: "node-2", Osds: []string{"2"}, State: cephlcmv1alpha1.OsdRestartDomainPending},
+		{Name: "node-3", Osds: []string{"3"}, State: cephlcmv1alpha1.OsdRestartDomainPending},
+	}, domains)
+
+	for idx := range pods[:3] {
+		pods[idx].Labels["topology-location-rack"] = "rack-1"
+	}
+	domains, err = getOsdRestartDomains(pods, "rack")
+	assert.Nil(t, domains)
+	assert.Equal(t, "failed to find 'rack' failure domain for osd '3' pod rook-ceph/rook-ceph-osd-3-123", err.Error())
+
+	domains, err = getOsdRestartDomains(pods[:3], "rack")
+	assert.Nil(t, err)
+	assert.Equal(t, []cephlcmv1alpha1.CephDeploymentOsdRestartDomain{
+		{Name: "rack-1", Osds: []string{"0", "1", "2"}, State: cephlcmv1alpha1.OsdRestartDomainPending},
+	}, domains)
+}
+
+func TestEnsureOsdRestart(t *testing.T) {
+	oldTimeFunc := lcmcommon.GetCurrentTimeString
+	lcmcommon.GetCurrentTimeString = func() string {
+		return "2026-10-17T10:00:00Z"
+	}
+	restartTime := getConditionTransitionTime()
+	before := restartTime.Add(-time.Hour)
+	after := restartTime.Add(time.Minute)
+	pods := []corev1.Pod{osdPod("0", "node-1", before, true), osdPod("1", "node-1", before, true), osdPod("2", "node-2", before, true)}
+	newStatus := func(phase cephlcmv1alpha1.OsdRestartPhase, message string, first, second cephlcmv1alpha1.OsdRestartDomainState) *cephlcmv1alpha1.CephDeploymentOsdRestartStatus {
+		status := &cephlcmv1alpha1.CephDeploymentOsdRestartStatus{
+			Reason:        "config update",
+			FailureDomain: "host",
+			Phase:         phase,
+			Message:       message,
+			StartedAt:     restartTime,
+			Domains: []cephlcmv1alpha1.CephDeploymentOsdRestartDomain{
+				{Name: "node-1", Osds: []string{"0", "1"}, State: first},
+				{Name: "node-2", Osds: []string{"2"}, State: second},
+			},
+		}
+		if first != cephlcmv1alpha1.OsdRestartDomainPending {
+			status.Domains[0].RestartedAt = restartTime.DeepCopy()
+		}
+		if second != cephlcmv1alpha1.OsdRestartDomainPending {
+			status.Domains[1].RestartedAt = restartTime.DeepCopy()
+		}
+		return status
+	}
+	cleanPgs := `{"pgmap":{"pgs_by_state":[{"state_name":"active+clean","count":32}],"num_pgs":32}}`
+	tests := []struct {
+		name               string
+		extraOpts          *cephlcmv1alpha1.CephDeploymentExtraOpts
+		status             *cephlcmv1alpha1.CephDeploymentOsdRestartStatus
+		clusterAnnotations map[string]string
+		pods               []corev1.Pod
+		cephStatus         string
+		okToStopFailed     bool
+		expectedStatus     *cephlcmv1alpha1.CephDeploymentOsdRestartStatus
+		expectedChanged    bool
+		expectedCmds       []string
+		expectedPods       []string
+		expectedError      string
+	}{
+		{
+			name:         "no osd restart reason - status is cleaned up",
+			status:       newStatus(cephlcmv1alpha1.OsdRestartCompleted, "all osds are restarted", cephlcmv1alpha1.OsdRestartDomainCompleted, cephlcmv1alpha1.OsdRestartDomainCompleted),
+			pods:         pods,
+			expectedCmds: []string{},
+			expectedPods: []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:               "osd restart reason is already applied through cephcluster annotations",
+			extraOpts:          &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			clusterAnnotations: map[string]string{"cephdeployment.lcm.mirantis.com/restart-osd-reason": "config update"},
+			pods:               pods,
+			expectedStatus: &cephlcmv1alpha1.CephDeploymentOsdRestartStatus{
+				Reason:        "config update",
+				FailureDomain: "host",
+				Phase:         cephlcmv1alpha1.OsdRestartCompleted,
+				Message:       "osds are already restarted with specified reason",
+				StartedAt:     restartTime,
+			},
+			expectedCmds: []string{},
+			expectedPods: []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:          "new osd restart reason - failure domain is not found",
+			extraOpts:     &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update", OsdRestartFailureDomain: "rack"},
+			pods:          pods,
+			expectedCmds:  []string{},
+			expectedPods:  []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+			expectedError: "failed to prepare osds restart: failed to find 'rack' failure domain for osd '0' pod rook-ceph/rook-ceph-osd-0-123",
+		},
+		{
+			name:      "new osd restart reason - first host is restarted",
+			extraOpts: &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			status: func() *cephlcmv1alpha1.CephDeploymentOsdRestartStatus {
+				status := newStatus(cephlcmv1alpha1.OsdRestartCompleted, "all osds are restarted", cephlcmv1alpha1.OsdRestartDomainCompleted, cephlcmv1alpha1.OsdRestartDomainCompleted)
+				status.Reason = "previous config update"
+				return status
+			}(),
+			pods:            pods,
+			cephStatus:      cleanPgs,
+			expectedStatus:  newStatus(cephlcmv1alpha1.OsdRestartInProgress, "osds of 'node-1' host are restarting", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			expectedChanged: true,
+			expectedCmds:    []string{"ceph status -f json", "ceph osd ok-to-stop 0 1"},
+			expectedPods:    []string{"rook-ceph-osd-2-123"},
+		},
+		{
+			name:            "new osd restart reason - waiting for osds ok-to-stop",
+			extraOpts:       &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			pods:            pods,
+			cephStatus:      cleanPgs,
+			okToStopFailed:  true,
+			expectedChanged: true,
+			expectedStatus:  newStatus(cephlcmv1alpha1.OsdRestartInProgress, "waiting for osds of 'node-1' host to be ok-to-stop", cephlcmv1alpha1.OsdRestartDomainPending, cephlcmv1alpha1.OsdRestartDomainPending),
+			expectedCmds:    []string{"ceph status -f json", "ceph osd ok-to-stop 0 1"},
+			expectedPods:    []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:            "osd restart in progress - waiting for host osds restarted",
+			extraOpts:       &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			status:          newStatus(cephlcmv1alpha1.OsdRestartInProgress, "osds of 'node-1' host are restarting", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			pods:            []corev1.Pod{osdPod("0", "node-1", after, true), osdPod("1", "node-1", after, false), osdPod("2", "node-2", before, true)},
+			expectedChanged: true,
+			expectedStatus:  newStatus(cephlcmv1alpha1.OsdRestartInProgress, "waiting for osds of 'node-1' host to be restarted", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			expectedCmds:    []string{},
+			expectedPods:    []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:            "osd restart in progress - waiting for pgs active+clean",
+			extraOpts:       &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			status:          newStatus(cephlcmv1alpha1.OsdRestartInProgress, "osds of 'node-1' host are restarting", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			pods:            []corev1.Pod{osdPod("0", "node-1", after, true), osdPod("1", "node-1", after, true), osdPod("2", "node-2", before, true)},
+			cephStatus:      `{"pgmap":{"pgs_by_state":[{"state_name":"active+clean","count":30},{"state_name":"active+undersized+degraded","count":2}],"num_pgs":32}}`,
+			expectedChanged: true,
+			expectedStatus:  newStatus(cephlcmv1alpha1.OsdRestartInProgress, "waiting for all placement groups to be active+clean", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			expectedCmds:    []string{"ceph status -f json"},
+			expectedPods:    []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:            "osd restart in progress - paused before next host",
+			extraOpts:       &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update", OsdRestartAction: cephlcmv1alpha1.OsdRestartActionPause},
+			status:          newStatus(cephlcmv1alpha1.OsdRestartInProgress, "osds of 'node-1' host are restarting", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			pods:            []corev1.Pod{osdPod("0", "node-1", after, true), osdPod("1", "node-1", after, true), osdPod("2", "node-2", before, true)},
+			cephStatus:      cleanPgs,
+			expectedStatus:  newStatus(cephlcmv1alpha1.OsdRestartPaused, "osds restart is paused before 'node-2' host", cephlcmv1alpha1.OsdRestartDomainCompleted, cephlcmv1alpha1.OsdRestartDomainPending),
+			expectedChanged: true,
+			expectedCmds:    []string{"ceph status -f json"},
+			expectedPods:    []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:            "osd restart paused - resumed and next host is restarted",
+			extraOpts:       &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			status:          newStatus(cephlcmv1alpha1.OsdRestartPaused, "osds restart is paused before 'node-2' host", cephlcmv1alpha1.OsdRestartDomainCompleted, cephlcmv1alpha1.OsdRestartDomainPending),
+			pods:            []corev1.Pod{osdPod("0", "node-1", after, true), osdPod("1", "node-1", after, true), osdPod("2", "node-2", before, true)},
+			cephStatus:      cleanPgs,
+			expectedStatus:  newStatus(cephlcmv1alpha1.OsdRestartInProgress, "osds of 'node-2' host are restarting", cephlcmv1alpha1.OsdRestartDomainCompleted, cephlcmv1alpha1.OsdRestartDomainRestarting),
+			expectedChanged: true,
+			expectedCmds:    []string{"ceph status -f json", "ceph osd ok-to-stop 2"},
+			expectedPods:    []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123"},
+		},
+		{
+			name:            "osd restart in progress - last host is restarted",
+			extraOpts:       &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			status:          newStatus(cephlcmv1alpha1.OsdRestartInProgress, "osds of 'node-2' host are restarting", cephlcmv1alpha1.OsdRestartDomainCompleted, cephlcmv1alpha1.OsdRestartDomainRestarting),
+			pods:            []corev1.Pod{osdPod("0", "node-1", after, true), osdPod("1", "node-1", after, true), osdPod("2", "node-2", after, true)},
+			cephStatus:      cleanPgs,
+			expectedStatus:  newStatus(cephlcmv1alpha1.OsdRestartCompleted, "all osds are restarted", cephlcmv1alpha1.OsdRestartDomainCompleted, cephlcmv1alpha1.OsdRestartDomainCompleted),
+			expectedChanged: true,
+			expectedCmds:    []string{"ceph status -f json"},
+			expectedPods:    []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:           "osd restart in progress - aborted",
+			extraOpts:      &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update", OsdRestartAction: cephlcmv1alpha1.OsdRestartActionAbort},
+			status:         newStatus(cephlcmv1alpha1.OsdRestartInProgress, "osds of 'node-1' host are restarting", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			pods:           pods,
+			expectedStatus: newStatus(cephlcmv1alpha1.OsdRestartAborted, "osds restart is aborted", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			expectedCmds:   []string{},
+			expectedPods:   []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+		{
+			name:           "osd restart aborted - nothing to do",
+			extraOpts:      &cephlcmv1alpha1.CephDeploymentExtraOpts{OsdRestartReason: "config update"},
+			status:         newStatus(cephlcmv1alpha1.OsdRestartAborted, "osds restart is aborted", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			pods:           pods,
+			expectedStatus: newStatus(cephlcmv1alpha1.OsdRestartAborted, "osds restart is aborted", cephlcmv1alpha1.OsdRestartDomainRestarting, cephlcmv1alpha1.OsdRestartDomainPending),
+			expectedCmds:   []string{},
+			expectedPods:   []string{"rook-ceph-osd-0-123", "rook-ceph-osd-1-123", "rook-ceph-osd-2-123"},
+		},
+	}
+	oldCmdFunc := lcmcommon.RunPodCommandWithValidation
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+			cephDpl.Spec.ExtraOpts = test.extraOpts
+			cephDpl.Status.OsdRestart = test.status.DeepCopy()
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl}, nil)
+			cephCluster := unitinputs.TestCephCluster.DeepCopy()
+			cephCluster.Annotations = test.clusterAnnotations
+			inputResources := map[string]runtime.Object{
+				"pods":         &corev1.PodList{Items: append([]corev1.Pod{}, test.pods...)},
+				"cephclusters": &cephv1.CephClusterList{Items: []cephv1.CephCluster{*cephCluster}},
+			}
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "list", []string{"pods"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "delete", []string{"pods"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Rookclientset, "get", []string{"cephclusters"}, inputResources, nil)
+
+			cmds := []string{}
+			lcmcommon.RunPodCommandWithValidation = func(e lcmcommon.ExecConfig) (string, string, error) {
+				cmds = append(cmds, e.Command)
+				if e.Command == "ceph status -f json" && test.cephStatus != "" {
+					return test.cephStatus, "", nil
+				}
+				if e.Command == "ceph osd ok-to-stop 0 1" || e.Command == "ceph osd ok-to-stop 2" {
+					if test.okToStopFailed {
+						return "", "", errors.New("osds are not ok to stop")
+					}
+					return "", "", nil
+				}
+				return "", "", errors.New("unexpected command")
+			}
+
+			changed, err := c.ensureOsdRestart()
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.expectedChanged, changed)
+			assert.Equal(t, test.expectedStatus, c.cdConfig.cephDpl.Status.OsdRestart)
+			assert.Equal(t, test.expectedCmds, cmds)
+			podNames := []string{}
+			for _, pod := range inputResources["pods"].(*corev1.PodList).Items {
+				podNames = append(podNames, pod.Name)
+			}
+			assert.Equal(t, test.expectedPods, podNames)
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+			faketestclients.CleanupFakeClientReactions(c.api.Rookclientset)
+		})
+	}
+	lcmcommon.RunPodCommandWithValidation = oldCmdFunc
+	lcmcommon.GetCurrentTimeString = oldTimeFunc
+}
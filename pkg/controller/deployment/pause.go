@@ -0,0 +1,140 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// getActivePause returns pause from CephDeployment spec if it is present and not expired yet
+func getActivePause(cephDpl *cephlcmv1alpha1.CephDeployment, now metav1.Time) *cephlcmv1alpha1.CephDeploymentPause {
+	pause := cephDpl.Spec.Pause
+	if pause == nil {
+		return nil
+	}
+	if pause.Until != nil && !now.Before(pause.Until) {
+		return nil
+	}
+	return pause
+}
+
+// getPausedSections returns ordered list of paused sections, if no sections
+// specified in pause, all sections are paused
+func getPausedSections(pause *cephlcmv1alpha1.CephDeploymentPause) []cephlcmv1alpha1.CephDeploymentSection {
+	sections := []cephlcmv1alpha1.CephDeploymentSection{}
+	for _, conditionType := range applyConditionTypes {
+		section := cephlcmv1alpha1.CephDeploymentSection(conditionType)
+		if len(pause.Sections) == 0 || slices.Contains(pause.Sections, section) {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+// processPause checks configuration apply pause in spec and reflects it in status
+func (c *cephDeploymentConfig) processPause() {
+	cephDpl := c.cdConfig.cephDpl
+	now := getConditionTransitionTime()
+	pause := getActivePause(cephDpl, now)
+	if pause == nil {
+		c.cdConfig.pausedSections = nil
+		if cephDpl.Status.Pause != nil {
+			if cephDpl.Spec.Pause != nil {
+				c.log.Info().Msgf("configuration apply pause is expired at %s", cephDpl.Spec.Pause.Until.UTC().Format(time.RFC3339))
+			}
+			c.log.Info().Msgf("configuration apply is resumed for CephDeployment %s/%s", cephDpl.Namespace, cephDpl.Name)
+			c.recordPauseEvent(false, "")
+			cephDpl.Status.Pause = nil
+		}
+		return
+	}
+	c.cdConfig.pausedSections = getPausedSections(pause)
+	if cephDpl.Status.Pause == nil {
+		c.log.Info().Msgf("configuration apply is paused for CephDeployment %s/%s: %s", cephDpl.Namespace, cephDpl.Name, pause.Reason)
+		cephDpl.Status.Pause = &cephlcmv1alpha1.CephDeploymentPauseStatus{Since: now}
+		c.recordPauseEvent(true, pause.Reason)
+	}
+	cephDpl.Status.Pause.Reason = pause.Reason
+	cephDpl.Status.Pause.Sections = c.cdConfig.pausedSections
+	cephDpl.Status.Pause.Until = pause.Until
+}
+
+func (c *cephDeploymentConfig) isSectionPaused(section string) bool {
+	return slices.Contains(c.cdConfig.pausedSections, cephlcmv1alpha1.CephDeploymentSection(section))
+}
+
+func (c *cephDeploymentConfig) isApplyPaused() bool {
+	return len(c.cdConfig.pausedSections) == len(applyConditionTypes)
+}
+
+// getPauseMessage returns human-readable pause description for status message
+func (c *cephDeploymentConfig) getPauseMessage() string {
+	pause := c.cdConfig.cephDpl.Status.Pause
+	if pause == nil {
+		return ""
+	}
+	msg := ""
+	if c.isApplyPaused() {
+		msg = fmt.Sprintf("configuration apply is paused: %s", pause.Reason)
+	} else {
+		sections := make([]string, 0, len(pause.Sections))
+		for _, section := range pause.Sections {
+			sections = append(sections, string(section))
+		}
+		msg = fmt.Sprintf("configuration apply is paused for %s: %s", strings.Join(sections, ", "), pause.Reason)
+	}
+	if pause.Until != nil {
+		msg = fmt.Sprintf("%s (until %s)", msg, pause.Until.UTC().Format(time.RFC3339))
+	}
+	return msg
+}
+
+// setPausedConditions marks all present configuration apply conditions as paused
+func (c *cephDeploymentConfig) setPausedConditions() {
+	results := applyConditionResults{}
+	for _, condition := range c.cdConfig.cephDpl.Status.Conditions {
+		if slices.Contains(applyConditionTypes, condition.Type) {
+			results.setPaused(condition.Type)
+		}
+	}
+	c.setApplyConditions(results)
+}
+
+// getRequeueInterval returns reconcile requeue interval, which is shortened
+// if pause expires earlier than next regular reconcile
+func (c *cephDeploymentConfig) getRequeueInterval() time.Duration {
+	pause := c.cdConfig.cephDpl.Status.Pause
+	if pause == nil || pause.Until == nil {
+		return requeueAfterInterval
+	}
+	expiresIn := pause.Until.Sub(getConditionTransitionTime().Time)
+	if expiresIn >= requeueAfterInterval {
+		return requeueAfterInterval
+	}
+	if expiresIn <= 0 {
+		return lcmcommon.DefaultImmediateRequeueInterval
+	}
+	return expiresIn
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func getTestPauseTime(t string) *metav1.Time {
+	parsedTime, _ := time.Parse(time.RFC3339, t)
+	pauseTime := metav1.NewTime(parsedTime.Local())
+	return &pauseTime
+}
+
+func TestProcessPause(t *testing.T) {
+	allSections := []cephlcmv1alpha1.CephDeploymentSection{}
+	for _, conditionType := range applyConditionTypes {
+		allSections = append(allSections, cephlcmv1alpha1.CephDeploymentSection(conditionType))
+	}
+	tests := []struct {
+		name             string
+		pause            *cephlcmv1alpha1.CephDeploymentPause
+		pauseStatus      *cephlcmv1alpha1.CephDeploymentPauseStatus
+		expectedStatus   *cephlcmv1alpha1.CephDeploymentPauseStatus
+		expectedSections []cephlcmv1alpha1.CephDeploymentSection
+		expectedPaused   bool
+		expectedMessage  string
+		expectedRequeue  time.Duration
+		expectedEvents   []string
+	}{
+		{
+			name:            "no pause",
+			expectedRequeue: requeueAfterInterval,
+			expectedEvents:  []string{},
+		},
+		{
+			name: "full pause is started",
+			pause: &cephlcmv1alpha1.CephDeploymentPause{
+				Reason: "incident",
+			},
+			expectedStatus: &cephlcmv1alpha1.CephDeploymentPauseStatus{
+				Reason:   "incident",
+				Sections: allSections,
+				Since:    *getTestPauseTime("2021-08-15T14:30:45+04:00"),
+			},
+			expectedSections: allSections,
+			expectedPaused:   true,
+			expectedMessage:  "configuration apply is paused: incident",
+			expectedRequeue:  requeueAfterInterval,
+			expectedEvents:   []string{"Warning ApplyPaused configuration apply is paused: incident"},
+		},
+		{
+			name: "sections pause is started with expiration",
+			pause: &cephlcmv1alpha1.CephDeploymentPause{
+				Reason:   "rgw hotfix",
+				Sections: []cephlcmv1alpha1.CephDeploymentSection{"CSI", "ObjectStorage"},
+				Until:    getTestPauseTime("2021-08-15T14:31:15+04:00"),
+			},
+			expectedStatus: &cephlcmv1alpha1.CephDeploymentPauseStatus{
+				Reason:   "rgw hotfix",
+				Sections: []cephlcmv1alpha1.CephDeploymentSection{"ObjectStorage", "CSI"},
+				Since:    *getTestPauseTime("2021-08-15T14:30:45+04:00"),
+				Until:    getTestPauseTime("2021-08-15T14:31:15+04:00"),
+			},
+			expectedSections: []cephlcmv1alpha1.CephDeploymentSection{"ObjectStorage", "CSI"},
+			expectedMessage:  "configuration apply is paused for ObjectStorage, CSI: rgw hotfix (until 2021-08-15T10:31:15Z)",
+			expectedRequeue:  30 * time.Second,
+			expectedEvents:   []string{"Warning ApplyPaused configuration apply is paused: rgw hotfix"},
+		},
+		{
+			name: "pause is in progress, sections updated",
+			pause: &cephlcmv1alpha1.CephDeploymentPause{
+				Reason:   "rgw hotfix",
+				Sections: []cephlcmv1alpha1.CephDeploymentSection{"ObjectStorage"},
+			},
+			pauseStatus: &cephlcmv1alpha1.CephDeploymentPauseStatus{
+				Reason:   "rgw hotfix",
+				Sections: []cephlcmv1alpha1.CephDeploymentSection{"ObjectStorage", "CSI"},
+				Since:    *getTestPauseTime("2021-08-15T14:00:00+04:00"),
+			},
+			expectedStatus: &cephlcmv1alpha1.CephDeploymentPauseStatus{
+				Reason:   "rgw hotfix",
+				Sections: []cephlcmv1alpha1.CephDeploymentSection{"ObjectStorage"},
+				Since:    *getTestPauseTime("2021-08-15T14:00:00+04:00"),
+			},
+			expectedSections: []cephlcmv1alpha1.CephDeploymentSection{"ObjectStorage"},
+			expectedMessage:  "configuration apply is paused for ObjectStorage: rgw hotfix",
+			expectedRequeue:  requeueAfterInterval,
+			expectedEvents:   []string{},
+		},
+		{
+			name: "pause is expired",
+			pause: &cephlcmv1alpha1.CephDeploymentPause{
+				Reason: "incident",
+				Until:  getTestPauseTime("2021-08-15T14:30:00+04:00"),
+			},
+			pauseStatus: &cephlcmv1alpha1.CephDeploymentPauseStatus{
+				Reason:   "incident",
+				Sections: allSections,
+				Since:    *getTestPauseTime("2021-08-15T14:00:00+04:00"),
+				Until:    getTestPauseTime("2021-08-15T14:30:00+04:00"),
+			},
+			expectedRequeue: requeueAfterInterval,
+			expectedEvents:  []string{"Normal ApplyResumed configuration apply is resumed"},
+		},
+		{
+			name: "pause is removed from spec",
+			pauseStatus: &cephlcmv1alpha1.CephDeploymentPauseStatus{
+				Reason:   "incident",
+				Sections: allSections,
+				Since:    *getTestPauseTime("2021-08-15T14:00:00+04:00"),
+			},
+			expectedRequeue: requeueAfterInterval,
+			expectedEvents:  []string{"Normal ApplyResumed configuration apply is resumed"},
+		},
+	}
+	oldTimeFunc := lcmcommon.GetCurrentTimeString
+	lcmcommon.GetCurrentTimeString = func() string {
+		return "2021-08-15T14:30:45+04:00"
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+			cephDpl.Spec.Pause = test.pause
+			cephDpl.Status.Pause = test.pauseStatus
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl}, nil)
+			recorder := events.NewFakeRecorder(10)
+			c.api.Recorder = recorder
+
+			c.processPause()
+			assert.Equal(t, test.expectedStatus, cephDpl.Status.Pause)
+			assert.Equal(t, test.expectedSections, c.cdConfig.pausedSections)
+			assert.Equal(t, test.expectedPaused, c.isApplyPaused())
+			assert.Equal(t, test.expectedMessage, c.getPauseMessage())
+			assert.Equal(t, test.expectedRequeue, c.getRequeueInterval())
+			assert.Equal(t, test.expectedEvents, readFakeEvents(recorder))
+		})
+	}
+	lcmcommon.GetCurrentTimeString = oldTimeFunc
+}
+
+func TestPausedSections(t *testing.T) {
+	oldTimeFunc := lcmcommon.GetCurrentTimeString
+	lcmcommon.GetCurrentTimeString = func() string {
+		return "2021-08-15T14:30:45+04:00"
+	}
+	cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+	cephDpl.Status.Conditions = getTestApplyConditions(10, "2021-08-15T14:00:00+04:00",
+		appliedTestCondition(cephlcmv1alpha1.ConditionTypeCluster),
+		appliedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+	)
+	c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl}, nil)
+	c.cdConfig.pausedSections = []cephlcmv1alpha1.CephDeploymentSection{cephlcmv1alpha1.ConditionTypeCSI}
+	assert.True(t, c.isSectionPaused(cephlcmv1alpha1.ConditionTypeCSI))
+	assert.False(t, c.isSectionPaused(cephlcmv1alpha1.ConditionTypeCluster))
+	assert.False(t, c.isApplyPaused())
+
+	c.setPausedConditions()
+	assert.Equal(t, getTestApplyConditions(10, "2021-08-15T14:30:45+04:00",
+		pausedTestCondition(cephlcmv1alpha1.ConditionTypeCluster),
+		pausedTestCondition(cephlcmv1alpha1.ConditionTypeCSI),
+	), cephDpl.Status.Conditions)
+	lcmcommon.GetCurrentTimeString = oldTimeFunc
+}
@@ -0,0 +1,563 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// planDetailsMaxSize limits plan details size to fit into ConfigMap
+const planDetailsMaxSize = 512 * 1024
+
+// plannedChange describes a single object change, which is going to be done
+// during CephDeployment configuration apply
+type plannedChange struct {
+	process   objectProcess
+	kind      string
+	namespace string
+	name      string
+	impact    string
+	diff      string
+}
+
+func (change plannedChange) String() string {
+	msg := fmt.Sprintf("%s %s %s", change.process, change.kind, change.name)
+	if change.namespace != "" {
+		msg = fmt.Sprintf("%s %s %s/%s", change.process, change.kind, change.namespace, change.name)
+	}
+	if change.impact != "" {
+		msg = fmt.Sprintf("%s: %s", msg, change.impact)
+	}
+	return msg
+}
+
+func newPlannedChange(process objectProcess, kind, namespace, name string, oldObject, newObject interface{}) plannedChange {
+	change := plannedChange{
+		process:   process,
+		kind:      kind,
+		namespace: namespace,
+		name:      name,
+	}
+	diff, err := lcmcommon.GetObjectDiff(oldObject, newObject)
+	if err != nil {
+		change.diff = fmt.Sprintf("failed to compare objects: %v", err)
+	} else {
+		change.diff = diff
+	}
+	return change
+}
+
+func isPlanModeEnabled(cephDpl *cephlcmv1alpha1.CephDeployment) bool {
+	return cephDpl.Annotations[planModeAnnotation] == "true"
+}
+
+func getPlanConfigMapName(cephDplName string) string {
+	return fmt.Sprintf(planConfigMapTemplate, cephDplName)
+}
+
+// buildConfigurationPlan calculates changes for Rook and Kubernetes objects managed
+// by CephDeployment without applying them
+func (c *cephDeploymentConfig) buildConfigurationPlan() ([]plannedChange, error) {
+	c.log.Debug().Msg("build configuration plan")
+	planners := []struct {
+		resource string
+		plan     func() ([]plannedChange, error)
+	}{
+		{resource: "cephcluster", plan: c.planCephCluster},
+		{resource: "ceph config", plan: c.planCephConfig},
+		{resource: "cephblockpools", plan: c.planPools},
+		{resource: "cephfilesystems", plan: c.planCephFS},
+		{resource: "storageclasses", plan: c.planStorageClasses},
+		{resource: "cephclients", plan: c.planCephClients},
+		{resource: "cephobjectstores", plan: c.planObjectStores},
+	}
+	changes := []plannedChange{}
+	errMsg := make([]string, 0)
+	for _, planner := range planners {
+		plannedChanges, err := planner.plan()
+		if err != nil {
+			msg := fmt.Sprintf("failed to plan %s changes", planner.resource)
+			c.log.Error().Err(err).Msg(msg)
+			errMsg = append(errMsg, msg)
+			continue
+		}
+		changes = append(changes, plannedChanges...)
+	}
+	if len(errMsg) > 0 {
+		return nil, errors.Errorf("error(s) during configuration plan build: %s", strings.Join(errMsg, ", "))
+	}
+	return changes, nil
+}
+
+// getConfigTimestamps returns ceph config sections update timestamps. If they are not
+// known yet, e.g. right after controller restart, they are read from current rook config
+// override to avoid false daemons annotations changes in plan. Plan is a dry run, so
+// known timestamps are not changed
+func (c *cephDeploymentConfig) getConfigTimestamps() (map[string]string, error) {
+	if len(resourceUpdateTimestamps.cephConfigMap) > 0 {
+		return maps.Clone(resourceUpdateTimestamps.cephConfigMap), nil
+	}
+	timestamps := map[string]string{}
+	currentRookCm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Get(c.context, rookConfigOverrideName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return timestamps, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get configmap %s/%s", c.lcmConfig.RookNamespace, rookConfigOverrideName)
+	}
+	prefix, suffix, _ := strings.Cut(cephConfigParametersUpdateTimestampLabel, "%s")
+	for annotation, timestamp := range currentRookCm.Annotations {
+		if strings.HasPrefix(annotation, prefix) && strings.HasSuffix(annotation, suffix) && len(annotation) > len(prefix)+len(suffix) {
+			timestamps[annotation[len(prefix):len(annotation)-len(suffix)]] = timestamp
+		}
+	}
+	return timestamps, nil
+}
+
+func (c *cephDeploymentConfig) planCephCluster() ([]plannedChange, error) {
+	configTimestamps := map[string]string{}
+	if !c.cdConfig.clusterSpec.External.Enable {
+		var err error
+		configTimestamps, err = c.getConfigTimestamps()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load ceph config timestamps")
+		}
+	}
+	generatedClusterSpec := generateCephClusterSpec(c.cdConfig.clusterSpec, c.cdConfig.currentCephImage, c.cdConfig.nodesListExpanded, configTimestamps)
+	cephCluster, err := c.api.Rookclientset.CephV1().CephClusters(c.lcmConfig.RookNamespace).Get(c.context, c.cdConfig.cephDpl.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return []plannedChange{newPlannedChange(objectCreate, "CephCluster", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name, cephv1.ClusterSpec{}, generatedClusterSpec)}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get %s/%s cephcluster", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name)
+	}
+	restartReason := cephCluster.Annotations[cephRestartOsdLabel]
+	restartTimestamp := cephCluster.Annotations[cephRestartOsdTimestampLabel]
+	if restartReason != "" {
+		if _, ok := generatedClusterSpec.Annotations[cephv1.KeyOSD]; !ok {
+			generatedClusterSpec.Annotations[cephv1.KeyOSD] = map[string]string{}
+		}
+		generatedClusterSpec.Annotations[cephv1.KeyOSD][cephRestartOsdLabel] = restartReason
+		generatedClusterSpec.Annotations[cephv1.KeyOSD][cephRestartOsdTimestampLabel] = restartTimestamp
+	}
+	if reflect.DeepEqual(cephCluster.Spec, generatedClusterSpec) {
+		return nil, nil
+	}
+	change := newPlannedChange(objectUpdate, "CephCluster", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name, cephCluster.Spec, generatedClusterSpec)
+	change.impact, err = c.getOsdDeploymentsImpact(cephCluster.Spec, generatedClusterSpec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate osd deployments affected by cephcluster update")
+	}
+	return []plannedChange{change}, nil
+}
+
+// getOsdDeploymentsImpact returns description of osd deployments, which are going to be
+// restarted or reconfigured by Rook after cephcluster spec update
+func (c *cephDeploymentConfig) getOsdDeploymentsImpact(oldSpec, newSpec cephv1.ClusterSpec) (string, error) {
+	oldStorage := oldSpec.Storage.DeepCopy()
+	oldStorage.Nodes = nil
+	newStorage := newSpec.Storage.DeepCopy()
+	newStorage.Nodes = nil
+	if oldSpec.CephVersion.Image != newSpec.CephVersion.Image || !reflect.DeepEqual(oldStorage, newStorage) ||
+		!reflect.DeepEqual(oldSpec.Annotations[cephv1.KeyOSD], newSpec.Annotations[cephv1.KeyOSD]) {
+		osdDeployments, err := c.api.Kubeclientset.AppsV1().Deployments(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{LabelSelector: "app=rook-ceph-osd"})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to list osd deployments")
+		}
+		return fmt.Sprintf("affects all %d osd deployment(s)", len(osdDeployments.Items)), nil
+	}
+
+	oldNodes := map[string]cephv1.Node{}
+	for _, node := range oldSpec.Storage.Nodes {
+		oldNodes[node.Name] = node
+	}
+	newNodes := map[string]cephv1.Node{}
+	for _, node := range newSpec.Storage.Nodes {
+		newNodes[node.Name] = node
+	}
+	changedNodes := []string{}
+	for name, node := range oldNodes {
+		if newNode, present := newNodes[name]; !present || !reflect.DeepEqual(node, newNode) {
+			changedNodes = append(changedNodes, name)
+		}
+	}
+	for name := range newNodes {
+		if _, present := oldNodes[name]; !present {
+			changedNodes = append(changedNodes, name)
+		}
+	}
+	if len(changedNodes) == 0 {
+		return "", nil
+	}
+	sort.Strings(changedNodes)
+	osdCount := 0
+	for _, nodeName := range changedNodes {
+		osdDeployments, err := c.api.Kubeclientset.AppsV1().Deployments(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{LabelSelector: fmt.Sprintf(nodeWithOSDSelectorTemplate, nodeName)})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to list osd deployments for node '%s'", nodeName)
+		}
+		osdCount += len(osdDeployments.Items)
+	}
+	return fmt.Sprintf("affects %d osd deployment(s) on node(s) %s", osdCount, strings.Join(changedNodes, ", ")), nil
+}
+
+func (c *cephDeploymentConfig) planCephConfig() ([]plannedChange, error) {
+	if c.cdConfig.clusterSpec.External.Enable {
+		return nil, nil
+	}
+	cephOverrideConfig, runtimeConfig, _, err := c.buildTargetCephConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare ceph config")
+	}
+	newData := map[string]string{
+		"config":  cephOverrideConfig,
+		"runtime": getRuntimeConfigString(runtimeConfig),
+	}
+	currentRookCm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Get(c.context, rookConfigOverrideName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return []plannedChange{newPlannedChange(objectCreate, "ConfigMap", c.lcmConfig.RookNamespace, rookConfigOverrideName, map[string]string{}, newData)}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get configmap %s/%s", c.lcmConfig.RookNamespace, rookConfigOverrideName)
+	}
+	if reflect.DeepEqual(currentRookCm.Data, newData) {
+		return nil, nil
+	}
+	return []plannedChange{newPlannedChange(objectUpdate, "ConfigMap", c.lcmConfig.RookNamespace, rookConfigOverrideName, currentRookCm.Data, newData)}, nil
+}
+
+func (c *cephDeploymentConfig) planPools() ([]plannedChange, error) {
+	pools, err := c.api.Rookclientset.CephV1().CephBlockPools(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get list pools")
+	}
+	presentPools := map[string]cephv1.CephBlockPool{}
+	for _, pool := range pools.Items {
+		if !lcmcommon.Contains(builtinCephPools, pool.Spec.Name) {
+			presentPools[pool.Name] = pool
+		}
+	}
+	changes := []plannedChange{}
+	if c.cdConfig.cephDpl.Spec.BlockStorage != nil {
+		for _, cephDplPool := range c.cdConfig.cephDpl.Spec.BlockStorage.Pools {
+			newPool := generatePool(cephDplPool, c.cdConfig.cephDpl.Spec.Crush, c.lcmConfig.RookNamespace)
+			presentPool, present := presentPools[newPool.Name]
+			if !present {
+				changes = append(changes, newPlannedChange(objectCreate, "CephBlockPool", newPool.Namespace, newPool.Name, cephv1.NamedBlockPoolSpec{}, newPool.Spec))
+				continue
+			}
+			delete(presentPools, newPool.Name)
+			if !reflect.DeepEqual(presentPool.Spec, newPool.Spec) {
+				changes = append(changes, newPlannedChange(objectUpdate, "CephBlockPool", newPool.Namespace, newPool.Name, presentPool.Spec, newPool.Spec))
+			}
+		}
+	}
+	for _, poolName := range slices.Sorted(maps.Keys(presentPools)) {
+		pool := presentPools[poolName]
+		if pool.Annotations[poolPreserveOnDeleteAnnotation] == "true" {
+			continue
+		}
+		change := newPlannedChange(objectDelete, "CephBlockPool", pool.Namespace, pool.Name, pool.Spec, cephv1.NamedBlockPoolSpec{})
+		change.impact = fmt.Sprintf("ceph pool '%s' and all its data will be removed", pool.Spec.Name)
+		if pool.Spec.Name == "" {
+			change.impact = fmt.Sprintf("ceph pool '%s' and all its data will be removed", pool.Name)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (c *cephDeploymentConfig) planCephFS() ([]plannedChange, error) {
+	cephFsList, err := c.api.Rookclientset.CephV1().CephFilesystems(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get CephFS list")
+	}
+	presentFS := map[string]cephv1.CephFilesystem{}
+	for _, cephFs := range cephFsList.Items {
+		presentFS[cephFs.Name] = cephFs
+	}
+	changes := []plannedChange{}
+	if c.cdConfig.cephDpl.Spec.SharedFilesystem != nil {
+		for _, cephDplCephFS := range c.cdConfig.cephDpl.Spec.SharedFilesystem.Filesystems {
+			cephFsResource := generateCephFS(cephDplCephFS, c.lcmConfig.RookNamespace)
+			cephFs, present := presentFS[cephFsResource.Name]
+			if !present {
+				changes = append(changes, newPlannedChange(objectCreate, "CephFilesystem", cephFsResource.Namespace, cephFsResource.Name, cephv1.FilesystemSpec{}, cephFsResource.Spec))
+				continue
+			}
+			delete(presentFS, cephFsResource.Name)
+			if !reflect.DeepEqual(cephFs.Spec, cephFsResource.Spec) {
+				changes = append(changes, newPlannedChange(objectUpdate, "CephFilesystem", cephFsResource.Namespace, cephFsResource.Name, cephFs.Spec, cephFsResource.Spec))
+			}
+		}
+	}
+	for _, fsName := range slices.Sorted(maps.Keys(presentFS)) {
+		cephFs := presentFS[fsName]
+		change := newPlannedChange(objectDelete, "CephFilesystem", cephFs.Namespace, cephFs.Name, cephFs.Spec, cephv1.FilesystemSpec{})
+		if !cephFs.Spec.PreserveFilesystemOnDelete {
+			change.impact = fmt.Sprintf("ceph filesystem '%s' and all its data will be removed", cephFs.Name)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (c *cephDeploymentConfig) planStorageClasses() ([]plannedChange, error) {
+	storageClassesList, err := c.api.Kubeclientset.StorageV1().StorageClasses().List(c.context, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get storage classes list")
+	}
+	storageClassesToDelete := map[string]bool{}
+	presentStorageClasses := map[string]int{}
+	for idx, storageClass := range storageClassesList.Items {
+		presentStorageClasses[storageClass.Name] = idx
+		if storageClass.Labels[rookStorageClassLabelKey] == "true" && storageClass.Labels[rookStorageClassKeepOnSpecRemove] != "true" {
+			storageClassesToDelete[storageClass.Name] = true
+		}
+	}
+
+	changes := []plannedChange{}
+	if c.cdConfig.cephDpl.Spec.BlockStorage != nil {
+		for idx, cephDplPool := range c.cdConfig.cephDpl.Spec.BlockStorage.Pools {
+			poolName := c.cdConfig.pools[idx]
+			storageResource := generateStorageClassPoolBased(c.lcmConfig.RookNamespace, poolName, cephDplPool.StorageClassOpts, c.lcmConfig.RookNamespace, c.cdConfig.clusterSpec.External.Enable)
+			delete(storageClassesToDelete, storageResource.Name)
+			scIdx, present := presentStorageClasses[storageResource.Name]
+			if !present {
+				changes = append(changes, newPlannedChange(objectCreate, "StorageClass", "", storageResource.Name, map[string]string{}, storageResource.Parameters))
+				continue
+			}
+			storageClass := storageClassesList.Items[scIdx]
+			defaultClass := fmt.Sprintf("%v", cephDplPool.StorageClassOpts.Default)
+			if storageClass.Annotations[rookDefaultSCAnnotationKey] != defaultClass {
+				change := newPlannedChange(objectUpdate, "StorageClass", "", storageClass.Name,
+					map[string]string{rookDefaultSCAnnotationKey: storageClass.Annotations[rookDefaultSCAnnotationKey]}, map[string]string{rookDefaultSCAnnotationKey: defaultClass})
+				changes = append(changes, change)
+			}
+			if !reflect.DeepEqual(storageClass.Parameters, storageResource.Parameters) {
+				change := newPlannedChange(objectUpdate, "StorageClass", "", storageClass.Name, storageClass.Parameters, storageResource.Parameters)
+				change.impact = "parameters section is immutable, update won't be applied until storage class is recreated"
+				changes = append(changes, change)
+			}
+		}
+	}
+	if c.cdConfig.cephDpl.Spec.SharedFilesystem != nil {
+		for _, cephFS := range c.cdConfig.cephDpl.Spec.SharedFilesystem.Filesystems {
+			castedSpec, _ := cephFS.GetSpec()
+			for _, dataPool := range castedSpec.DataPools {
+				storageResource := generateStorageClassCephFSBased(c.lcmConfig.RookNamespace, cephFS.Name, dataPool.Name, c.lcmConfig.RookNamespace, castedSpec.PreserveFilesystemOnDelete)
+				delete(storageClassesToDelete, storageResource.Name)
+				if _, present := presentStorageClasses[storageResource.Name]; !present {
+					changes = append(changes, newPlannedChange(objectCreate, "StorageClass", "", storageResource.Name, map[string]string{}, storageResource.Parameters))
+				}
+			}
+		}
+	}
+	for _, scName := range slices.Sorted(maps.Keys(storageClassesToDelete)) {
+		storageClass := storageClassesList.Items[presentStorageClasses[scName]]
+		changes = append(changes, newPlannedChange(objectDelete, "StorageClass", "", scName, storageClass.Parameters, map[string]string{}))
+	}
+	return changes, nil
+}
+
+func (c *cephDeploymentConfig) planCephClients() ([]plannedChange, error) {
+	cephClients, err := c.api.Rookclientset.CephV1().CephClients(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list CephClients in %s namespace", c.lcmConfig.RookNamespace)
+	}
+	presentClients := map[string]cephv1.CephClient{}
+	for _, client := range cephClients.Items {
+		presentClients[client.Name] = client
+	}
+	cephDplClients := make([]cephv1.ClientSpec, len(c.cdConfig.cephDpl.Spec.Clients))
+	for idx, cephDplClient := range c.cdConfig.cephDpl.Spec.Clients {
+		cephDplClients[idx], _ = cephDplClient.GetSpec()
+	}
+	if !c.cdConfig.clusterSpec.External.Enable && c.cdConfig.openstackSetup {
+		osClients, err := c.calculateOpenStackClients(cephDplClients)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate OpenStack CephClients")
+		}
+		cephDplClients = append(cephDplClients, osClients...)
+	}
+	changes := []plannedChange{}
+	for _, cephDplClientSpec := range cephDplClients {
+		newClient := generateClient(c.lcmConfig.RookNamespace, cephDplClientSpec)
+		presentClient, present := presentClients[newClient.Name]
+		if !present {
+			changes = append(changes, newPlannedChange(objectCreate, "CephClient", newClient.Namespace, newClient.Name, cephv1.ClientSpec{}, newClient.Spec))
+			continue
+		}
+		delete(presentClients, newClient.Name)
+		if !reflect.DeepEqual(presentClient.Spec, newClient.Spec) {
+			changes = append(changes, newPlannedChange(objectUpdate, "CephClient", newClient.Namespace, newClient.Name, presentClient.Spec, newClient.Spec))
+		}
+	}
+	for _, clientName := range slices.Sorted(maps.Keys(presentClients)) {
+		client := presentClients[clientName]
+		changes = append(changes, newPlannedChange(objectDelete, "CephClient", client.Namespace, client.Name, client.Spec, cephv1.ClientSpec{}))
+	}
+	return changes, nil
+}
+
+func (c *cephDeploymentConfig) planObjectStores() ([]plannedChange, error) {
+	rgwList, err := c.api.Rookclientset.CephV1().CephObjectStores(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list rgw object store")
+	}
+	presentRgws := map[string]cephv1.CephObjectStore{}
+	for _, rgw := range rgwList.Items {
+		presentRgws[rgw.Name] = rgw
+	}
+	useDedicatedNodes := false
+	for _, node := range c.cdConfig.cephDpl.Spec.Nodes {
+		if lcmcommon.Contains(node.Roles, "rgw") {
+			useDedicatedNodes = true
+			break
+		}
+	}
+	changes := []plannedChange{}
+	if c.cdConfig.cephDpl.Spec.ObjectStorage != nil {
+		for _, rgw := range c.cdConfig.cephDpl.Spec.ObjectStorage.Rgws {
+			castedSpec, _ := rgw.GetSpec()
+			var rgwStore *cephv1.CephObjectStore
+			if c.cdConfig.clusterSpec.External.Enable {
+				rgwStore, err = generateRgwExternal(castedSpec, rgw.Name, c.lcmConfig.RookNamespace)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to generate external rgw '%s'", rgw.Name)
+				}
+			} else {
+				rgwStore = generateRgw(castedSpec, rgw.Name, c.lcmConfig.RookNamespace, useDedicatedNodes)
+			}
+			presentRgw, present := presentRgws[rgwStore.Name]
+			if !present {
+				changes = append(changes, newPlannedChange(objectCreate, "CephObjectStore", rgwStore.Namespace, rgwStore.Name, cephv1.ObjectStoreSpec{}, rgwStore.Spec))
+				continue
+			}
+			delete(presentRgws, rgwStore.Name)
+			if !reflect.DeepEqual(presentRgw.Spec, rgwStore.Spec) {
+				changes = append(changes, newPlannedChange(objectUpdate, "CephObjectStore", rgwStore.Namespace, rgwStore.Name, presentRgw.Spec, rgwStore.Spec))
+			}
+		}
+	}
+	for _, rgwName := range slices.Sorted(maps.Keys(presentRgws)) {
+		rgw := presentRgws[rgwName]
+		change := newPlannedChange(objectDelete, "CephObjectStore", rgw.Namespace, rgw.Name, rgw.Spec, cephv1.ObjectStoreSpec{})
+		if !rgw.Spec.PreservePoolsOnDelete {
+			change.impact = fmt.Sprintf("object store '%s' pools and all its data will be removed", rgw.Name)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// getConfigurationPlanData returns plan summary and detailed plan with objects diffs
+func getConfigurationPlanData(generation int64, changes []plannedChange) map[string]string {
+	var summary strings.Builder
+	var details strings.Builder
+	summary.WriteString(fmt.Sprintf("generation: %d\nchanges: %d\n", generation, len(changes)))
+	for _, change := range changes {
+		summary.WriteString(fmt.Sprintf("- %s\n", change))
+		details.WriteString(fmt.Sprintf("### %s\n", change))
+		if change.diff != "" {
+			details.WriteString(change.diff)
+			if !strings.HasSuffix(change.diff, "\n") {
+				details.WriteString("\n")
+			}
+		}
+	}
+	detailsString := details.String()
+	if len(detailsString) > planDetailsMaxSize {
+		detailsString = detailsString[:planDetailsMaxSize] + "\n... details are truncated\n"
+	}
+	return map[string]string{
+		"summary": summary.String(),
+		"details": detailsString,
+	}
+}
+
+// publishConfigurationPlan creates or updates ConfigMap with configuration plan
+// and returns whether plan was changed since last publish
+func (c *cephDeploymentConfig) publishConfigurationPlan(changes []plannedChange) (bool, error) {
+	ownerRefs, err := lcmcommon.GetObjectOwnerRef(c.cdConfig.cephDpl, c.api.Scheme)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to prepare plan configmap owner refs")
+	}
+	planCm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            getPlanConfigMapName(c.cdConfig.cephDpl.Name),
+			Namespace:       c.cdConfig.cephDpl.Namespace,
+			Labels:          baseResourceLabels,
+			OwnerReferences: ownerRefs,
+		},
+		Data: getConfigurationPlanData(c.cdConfig.cephDpl.Generation, changes),
+	}
+	currentCm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(planCm.Namespace).Get(c.context, planCm.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "failed to get plan configmap %s/%s", planCm.Namespace, planCm.Name)
+		}
+		c.log.Info().Msgf("creating plan configmap %s/%s", planCm.Namespace, planCm.Name)
+		_, err = c.api.Kubeclientset.CoreV1().ConfigMaps(planCm.Namespace).Create(c.context, planCm, metav1.CreateOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to create plan configmap %s/%s", planCm.Namespace, planCm.Name)
+		}
+		return true, nil
+	}
+	if reflect.DeepEqual(currentCm.Data, planCm.Data) {
+		return false, nil
+	}
+	c.log.Info().Msgf("updating plan configmap %s/%s", planCm.Namespace, planCm.Name)
+	currentCm.Data = planCm.Data
+	_, err = c.api.Kubeclientset.CoreV1().ConfigMaps(planCm.Namespace).Update(c.context, currentCm, metav1.UpdateOptions{})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update plan configmap %s/%s", planCm.Namespace, planCm.Name)
+	}
+	return true, nil
+}
+
+// processConfigurationPlan builds and publishes configuration plan,
+// returns message to set in CephDeployment status
+func (c *cephDeploymentConfig) processConfigurationPlan() (string, error) {
+	changes, err := c.buildConfigurationPlan()
+	if err != nil {
+		return "", err
+	}
+	planChanged, err := c.publishConfigurationPlan(changes)
+	if err != nil {
+		return "", err
+	}
+	planCmName := fmt.Sprintf("%s/%s", c.cdConfig.cephDpl.Namespace, getPlanConfigMapName(c.cdConfig.cephDpl.Name))
+	if planChanged {
+		c.recordPlanGeneratedEvent(len(changes), planCmName)
+	}
+	return fmt.Sprintf("Ceph cluster configuration apply is skipped by plan mode, %d change(s) planned, see ConfigMap %s", len(changes), planCmName), nil
+}
@@ -0,0 +1,356 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeappsv1 "k8s.io/client-go/kubernetes/typed/apps/v1/fake"
+	gotesting "k8s.io/client-go/testing"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func getPlannedChangesStrings(changes []plannedChange) []string {
+	result := []string{}
+	for _, change := range changes {
+		result = append(result, change.String())
+	}
+	return result
+}
+
+func TestIsPlanModeEnabled(t *testing.T) {
+	cephDpl := unitinputs.BaseCephDeployment.DeepCopy()
+	assert.False(t, isPlanModeEnabled(cephDpl))
+	cephDpl.Annotations = map[string]string{"cephdeployment.lcm.mirantis.com/plan": "false"}
+	assert.False(t, isPlanModeEnabled(cephDpl))
+	cephDpl.Annotations["cephdeployment.lcm.mirantis.com/plan"] = "true"
+	assert.True(t, isPlanModeEnabled(cephDpl))
+}
+
+func TestPlanPools(t *testing.T) {
+	tests := []struct {
+		name            string
+		cephDpl         *cephlcmv1alpha1.CephDeployment
+		inputResources  map[string]runtime.Object
+		expectedChanges []string
+		expectedError   string
+	}{
+		{
+			name:           "plan pools - failed to list",
+			cephDpl:        &unitinputs.CephDeployNonMosk,
+			inputResources: map[string]runtime.Object{},
+			expectedError:  "failed to get list pools: failed to list cephblockpools",
+		},
+		{
+			name:    "plan pools - nothing changed",
+			cephDpl: &unitinputs.CephDeployNonMosk,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": unitinputs.CephBlockPoolListBaseReady.DeepCopy(),
+			},
+			expectedChanges: []string{},
+		},
+		{
+			name:    "plan pools - pool created",
+			cephDpl: &unitinputs.CephDeployNonMosk,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": unitinputs.CephBlockPoolListEmpty.DeepCopy(),
+			},
+			expectedChanges: []string{"create CephBlockPool rook-ceph/pool1-hdd"},
+		},
+		{
+			name:    "plan pools - pool updated",
+			cephDpl: &unitinputs.CephDeployNonMosk,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{unitinputs.GetReadyPoolWithRatio("pool1-hdd", true, 0.5)},
+				},
+			},
+			expectedChanges: []string{"update CephBlockPool rook-ceph/pool1-hdd"},
+		},
+		{
+			name:    "plan pools - pool deleted",
+			cephDpl: &unitinputs.BaseCephDeployment,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": unitinputs.CephBlockPoolListBaseReady.DeepCopy(),
+			},
+			expectedChanges: []string{"delete CephBlockPool rook-ceph/pool1-hdd: ceph pool 'pool1-hdd' and all its data will be removed"},
+		},
+		{
+			name:    "plan pools - pool with preserve annotation is not deleted",
+			cephDpl: &unitinputs.BaseCephDeployment,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{
+						func() cephv1.CephBlockPool {
+							cephpool := unitinputs.CephBlockPoolReplicated.DeepCopy()
+							cephpool.Annotations = map[string]string{"cephdeployment.lcm.mirantis.com/preserve-on-delete": "true"}
+							return *cephpool
+						}(),
+					},
+				},
+			},
+			expectedChanges: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: test.cephDpl}, nil)
+			faketestclients.FakeReaction(c.api.Rookclientset, "list", []string{"cephblockpools"}, test.inputResources, nil)
+
+			changes, err := c.planPools()
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expectedChanges, getPlannedChangesStrings(changes))
+				for _, change := range changes {
+					assert.NotEmpty(t, change.diff)
+				}
+			}
+			// no any changes are applied in plan mode
+			assert.Equal(t, map[string]int{"list": 1, "create": 0, "update": 0, "delete": 0}, faketestclients.GetActionsCount(faketestclients.GetFakeClientForInterface(c.api.Rookclientset), []string{"list", "create", "update", "delete"}))
+			faketestclients.CleanupFakeClientReactions(c.api.Rookclientset)
+		})
+	}
+}
+
+func TestGetOsdDeploymentsImpact(t *testing.T) {
+	osdDeployments := map[string]int{
+		"app=rook-ceph-osd":                           5,
+		"app=rook-ceph-osd,failure-domain=node-1":     2,
+		"app=rook-ceph-osd,failure-domain=node-2":     3,
+		"app=rook-ceph-osd,failure-domain=node-3":     0,
+		"app=rook-ceph-osd,failure-domain=node-error": -1,
+	}
+	baseSpec := cephv1.ClusterSpec{
+		CephVersion: cephv1.CephVersionSpec{Image: "ceph:v19.2.3"},
+		Storage: cephv1.StorageScopeSpec{
+			Nodes: []cephv1.Node{
+				{Name: "node-1", Config: map[string]string{"deviceClass": "hdd"}},
+				{Name: "node-2"},
+			},
+		},
+	}
+	tests := []struct {
+		name           string
+		newSpec        func() cephv1.ClusterSpec
+		expectedImpact string
+		expectedError  string
+	}{
+		{
+			name:    "no osd nodes changed",
+			newSpec: func() cephv1.ClusterSpec { return *baseSpec.DeepCopy() },
+		},
+		{
+			name: "single node changed",
+			newSpec: func() cephv1.ClusterSpec {
+				spec := baseSpec.DeepCopy()
+				spec.Storage.Nodes[0].Config["deviceClass"] = "ssd"
+				return *spec
+			},
+			expectedImpact: "affects 2 osd deployment(s) on node(s) node-1",
+		},
+		{
+			name: "nodes added and removed",
+			newSpec: func() cephv1.ClusterSpec {
+				spec := baseSpec.DeepCopy()
+				spec.Storage.Nodes = []cephv1.Node{spec.Storage.Nodes[0], {Name: "node-3"}}
+				return *spec
+			},
+			expectedImpact: "affects 3 osd deployment(s) on node(s) node-2, node-3",
+		},
+		{
+			name: "ceph image changed",
+			newSpec: func() cephv1.ClusterSpec {
+				spec := baseSpec.DeepCopy()
+				spec.CephVersion.Image = "ceph:v20.2.0"
+				return *spec
+			},
+			expectedImpact: "affects all 5 osd deployment(s)",
+		},
+		{
+			name: "failed to list osd deployments",
+			newSpec: func() cephv1.ClusterSpec {
+				spec := baseSpec.DeepCopy()
+				spec.Storage.Nodes = append(spec.Storage.Nodes, cephv1.Node{Name: "node-error"})
+				return *spec
+			},
+			expectedError: "failed to list osd deployments for node 'node-error': list failed",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(nil, nil)
+			c.api.Kubeclientset.AppsV1().(*fakeappsv1.FakeAppsV1).AddReactor("list", "deployments", func(action gotesting.Action) (handled bool, ret runtime.Object, err error) {
+				selector := action.(gotesting.ListActionImpl).ListOptions.LabelSelector
+				count := osdDeployments[selector]
+				if count < 0 {
+					return true, nil, errors.New("list failed")
+				}
+				list := &appsv1.DeploymentList{}
+				for idx := 0; idx < count; idx++ {
+					list.Items = append(list.Items, appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("rook-ceph-osd-%d", idx)}})
+				}
+				return true, list, nil
+			})
+
+			impact, err := c.getOsdDeploymentsImpact(baseSpec, test.newSpec())
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, test.expectedImpact, impact)
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.AppsV1())
+		})
+	}
+}
+
+func TestGetConfigTimestamps(t *testing.T) {
+	c := fakeDeploymentConfig(nil, nil)
+	unsetTimestampsVar()
+	inputResources := map[string]runtime.Object{
+		"configmaps": &v1.ConfigMapList{
+			Items: []v1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "rook-config-override",
+						Namespace: "rook-ceph",
+						Annotations: map[string]string{
+							"cephdeployment.lcm.mirantis.com/config-generated":      "time-0",
+							"cephdeployment.lcm.mirantis.com/config-global-hash":    "hash",
+							"cephdeployment.lcm.mirantis.com/config-global-updated": "time-1",
+							"cephdeployment.lcm.mirantis.com/config-mon-updated":    "time-2",
+						},
+					},
+				},
+			},
+		},
+	}
+	faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "get", []string{"configmaps"}, inputResources, nil)
+
+	timestamps, err := c.getConfigTimestamps()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"global": "time-1", "mon": "time-2"}, timestamps)
+	// dry run does not change known timestamps
+	assert.Equal(t, map[string]string{}, resourceUpdateTimestamps.cephConfigMap)
+
+	// already known timestamps are used as is
+	resourceUpdateTimestamps.cephConfigMap = map[string]string{"global": "time-3"}
+	timestamps, err = c.getConfigTimestamps()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"global": "time-3"}, timestamps)
+	timestamps["mon"] = "time-4"
+	assert.Equal(t, map[string]string{"global": "time-3"}, resourceUpdateTimestamps.cephConfigMap)
+
+	unsetTimestampsVar()
+	faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+}
+
+func TestGetConfigurationPlanData(t *testing.T) {
+	changes := []plannedChange{
+		{process: objectUpdate, kind: "CephCluster", namespace: "rook-ceph", name: "cephcluster", impact: "affects 2 osd deployment(s) on node(s) node-1", diff: "-a\n+b"},
+		{process: objectDelete, kind: "CephBlockPool", namespace: "rook-ceph", name: "pool1-hdd", diff: "-pool\n"},
+		{process: objectCreate, kind: "StorageClass", name: "pool2-hdd"},
+	}
+	expected := map[string]string{
+		"summary": "generation: 3\nchanges: 3\n" +
+			"- update CephCluster rook-ceph/cephcluster: affects 2 osd deployment(s) on node(s) node-1\n" +
+			"- delete CephBlockPool rook-ceph/pool1-hdd\n" +
+			"- create StorageClass pool2-hdd\n",
+		"details": "### update CephCluster rook-ceph/cephcluster: affects 2 osd deployment(s) on node(s) node-1\n-a\n+b\n" +
+			"### delete CephBlockPool rook-ceph/pool1-hdd\n-pool\n" +
+			"### create StorageClass pool2-hdd\n",
+	}
+	assert.Equal(t, expected, getConfigurationPlanData(3, changes))
+	assert.Equal(t, map[string]string{"summary": "generation: 1\nchanges: 0\n", "details": ""}, getConfigurationPlanData(1, nil))
+}
+
+func TestPublishConfigurationPlan(t *testing.T) {
+	planChanges := []plannedChange{{process: objectCreate, kind: "CephBlockPool", namespace: "rook-ceph", name: "pool1-hdd"}}
+	planData := getConfigurationPlanData(0, planChanges)
+	tests := []struct {
+		name           string
+		inputResources map[string]runtime.Object
+		apiErrors      map[string]error
+		changed        bool
+		expectedError  string
+	}{
+		{
+			name:           "plan configmap created",
+			inputResources: map[string]runtime.Object{"configmaps": &v1.ConfigMapList{}},
+			changed:        true,
+		},
+		{
+			name:           "plan configmap create failed",
+			inputResources: map[string]runtime.Object{"configmaps": &v1.ConfigMapList{}},
+			apiErrors:      map[string]error{"create-configmaps": errors.New("create failed")},
+			expectedError:  "failed to create plan configmap lcm-namespace/cephcluster-plan: create failed",
+		},
+		{
+			name: "plan configmap updated",
+			inputResources: map[string]runtime.Object{
+				"configmaps": &v1.ConfigMapList{
+					Items: []v1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "cephcluster-plan", Namespace: "lcm-namespace"}, Data: map[string]string{"summary": "old"}}},
+				},
+			},
+			changed: true,
+		},
+		{
+			name: "plan configmap is not changed",
+			inputResources: map[string]runtime.Object{
+				"configmaps": &v1.ConfigMapList{
+					Items: []v1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "cephcluster-plan", Namespace: "lcm-namespace"}, Data: planData}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: unitinputs.CephDeployNonMosk.DeepCopy()}, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "get", []string{"configmaps"}, test.inputResources, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "create", []string{"configmaps"}, test.inputResources, test.apiErrors)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "update", []string{"configmaps"}, test.inputResources, test.apiErrors)
+
+			changed, err := c.publishConfigurationPlan(planChanges)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+				planCm := test.inputResources["configmaps"].(*v1.ConfigMapList).Items[0]
+				assert.Equal(t, "cephcluster-plan", planCm.Name)
+				assert.Equal(t, planData, planCm.Data)
+			}
+			assert.Equal(t, test.changed, changed)
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+		})
+	}
+}
@@ -18,18 +18,19 @@ package deployment
 
 import (
 	"fmt"
-	"reflect"
+	"strconv"
 
 	"github.com/pkg/errors"
 	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
 	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
 )
 
-func generatePool(pool cephlcmv1alpha1.CephPool, namespace string) (newpool *cephv1.CephBlockPool) {
+func generatePool(pool cephlcmv1alpha1.CephPool, crush *cephlcmv1alpha1.CephDeploymentCrush, namespace string) (newpool *cephv1.CephBlockPool) {
 	cephpool := cephv1.CephBlockPool{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      buildPoolName(pool),
@@ -40,6 +41,37 @@ func generatePool(pool cephlcmv1alpha1.CephPool, namespace string) (newpool *cep
 	// skip check, since validation handles it
 	castedPoolSpec, _ := pool.GetSpec()
 	cephpool.Spec = cephv1.NamedBlockPoolSpec{PoolSpec: castedPoolSpec}
+	if rule := getCrushRule(crush, pool.CrushRule); rule != nil {
+		if rule.Root != "" {
+			cephpool.Spec.CrushRoot = rule.Root
+		}
+		if rule.FailureDomain != "" {
+			cephpool.Spec.FailureDomain = rule.FailureDomain
+		}
+	}
+	if pool.Quota != nil {
+		if pool.Quota.MaxBytes != "" {
+			maxSize := pool.Quota.MaxBytes
+			cephpool.Spec.Quotas.MaxSize = &maxSize
+		}
+		if pool.Quota.MaxObjects > 0 {
+			maxObjects := pool.Quota.MaxObjects
+			cephpool.Spec.Quotas.MaxObjects = &maxObjects
+		}
+	}
+	if pool.TargetSizeRatio != "" || pool.TargetSizeBytes != "" {
+		if cephpool.Spec.Parameters == nil {
+			cephpool.Spec.Parameters = map[string]string{}
+		}
+		if pool.TargetSizeRatio != "" {
+			cephpool.Spec.Parameters[poolTargetSizeRatioParam] = pool.TargetSizeRatio
+		}
+		if pool.TargetSizeBytes != "" {
+			// skip check, since validation handles it
+			targetBytes, _ := getQuantityBytes(pool.TargetSizeBytes)
+			cephpool.Spec.Parameters[poolTargetSizeBytesParam] = strconv.FormatInt(targetBytes, 10)
+		}
+	}
 	if lcmcommon.Contains(builtinCephPools, pool.Name) {
 		cephpool.Spec.Name = pool.Name
 	}
@@ -51,6 +83,7 @@ func generatePool(pool cephlcmv1alpha1.CephPool, namespace string) (newpool *cep
 
 func (c *cephDeploymentConfig) ensurePools() (bool, error) {
 	c.log.Debug().Msg("ensure ceph block pools")
+	c.markDriftChecked("CephBlockPool")
 	// List CephBlockPools
 	pools, err := c.api.Rookclientset.CephV1().CephBlockPools(c.lcmConfig.RookNamespace).List(c.context, metav1.ListOptions{})
 	if err != nil {
@@ -67,7 +100,7 @@ func (c *cephDeploymentConfig) ensurePools() (bool, error) {
 	poolsChanged := false
 	if c.cdConfig.cephDpl.Spec.BlockStorage != nil {
 		for _, cephDplPool := range c.cdConfig.cephDpl.Spec.BlockStorage.Pools {
-			newPool := generatePool(cephDplPool, c.lcmConfig.RookNamespace)
+			newPool := generatePool(cephDplPool, c.cdConfig.cephDpl.Spec.Crush, c.lcmConfig.RookNamespace)
 			if presentPool, ok := presentPools[newPool.Name]; ok {
 				if presentPool.Status == nil || !isTypeReadyToUpdate(presentPool.Status.Phase) {
 					err := fmt.Sprintf("found not ready CephBlockPool %s/%s, waiting for readiness", c.lcmConfig.RookNamespace, presentPool.Name)
@@ -76,10 +109,13 @@ func (c *cephDeploymentConfig) ensurePools() (bool, error) {
 					}
 					c.log.Error().Msg(err)
 					errMsg = append(errMsg, errors.New(err))
+				} else if err := validateErasureCodedPoolUpdate(fmt.Sprintf("CephBlockPool %s/%s", presentPool.Namespace, presentPool.Name), presentPool.Spec.PoolSpec, newPool.Spec.PoolSpec); err != nil {
+					c.log.Error().Err(err).Msg("")
+					errMsg = append(errMsg, err)
 				} else {
 					changedBaseLabels := lcmcommon.AlignBaseLabels(*c.log, "CephBlockPool", &presentPool.ObjectMeta, newPool.Labels)
-					specUpdated := !reflect.DeepEqual(newPool.Spec, presentPool.Spec)
-					if specUpdated || changedBaseLabels {
+					specUpdated, annotationsUpdated := c.checkObjectDrift("CephBlockPool", &presentPool.ObjectMeta, presentPool.Spec, newPool.Spec)
+					if specUpdated || changedBaseLabels || annotationsUpdated {
 						if specUpdated {
 							lcmcommon.ShowObjectDiff(*c.log, presentPool.Spec, newPool.Spec)
 							presentPool.Spec = newPool.Spec
@@ -92,6 +128,7 @@ func (c *cephDeploymentConfig) ensurePools() (bool, error) {
 				}
 				delete(presentPools, newPool.Name)
 			} else {
+				markSpecApplied(&newPool.ObjectMeta, newPool.Spec)
 				if err := c.processBlockPools(objectCreate, newPool); err != nil {
 					errMsg = append(errMsg, err)
 				}
@@ -166,5 +203,29 @@ func (c *cephDeploymentConfig) processBlockPools(process objectProcess, pool *ce
 		c.log.Error().Msg(err.Error())
 		return err
 	}
+	c.recordObjectEvent(process, "CephBlockPool", pool.Namespace, pool.Name)
 	return nil
 }
+
+// getQuantityBytes parses quantity, like "100Gi", and returns its value in bytes
+func getQuantityBytes(value string) (int64, error) {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, err
+	}
+	if quantity.Sign() <= 0 {
+		return 0, errors.New("quantity should be positive")
+	}
+	return quantity.Value(), nil
+}
+
+// getPoolReplicationFactor returns ratio of raw capacity used by pool to pool data size
+func getPoolReplicationFactor(spec cephv1.PoolSpec) float64 {
+	if spec.ErasureCoded.DataChunks > 0 {
+		return float64(spec.ErasureCoded.DataChunks+spec.ErasureCoded.CodingChunks) / float64(spec.ErasureCoded.DataChunks)
+	}
+	if spec.Replicated.Size > 0 {
+		return float64(spec.Replicated.Size)
+	}
+	return 1
+}
@@ -33,6 +33,7 @@ func TestGeneratePool(t *testing.T) {
 	tests := []struct {
 		name         string
 		cephDpl      cephlcmv1alpha1.CephPool
+		crush        *cephlcmv1alpha1.CephDeploymentCrush
 		expectedPool *cephv1.CephBlockPool
 	}{
 		{
@@ -127,10 +128,64 @@ func TestGeneratePool(t *testing.T) {
 				return cephpool
 			}(),
 		},
+		{
+			name: "generate pool with crush rule",
+			cephDpl: func() cephlcmv1alpha1.CephPool {
+				cephDplPool := unitinputs.CephDeployPoolReplicated.DeepCopy()
+				cephDplPool.CrushRule = "nvme-rack"
+				return *cephDplPool
+			}(),
+			crush: &cephlcmv1alpha1.CephDeploymentCrush{
+				Roots: []string{"nvme"},
+				Rules: []cephlcmv1alpha1.CephCrushRule{{Name: "nvme-rack", Root: "nvme", FailureDomain: "rack"}},
+			},
+			expectedPool: func() *cephv1.CephBlockPool {
+				cephpool := unitinputs.CephBlockPoolReplicated.DeepCopy()
+				cephpool.Spec.CrushRoot = "nvme"
+				cephpool.Spec.FailureDomain = "rack"
+				return cephpool
+			}(),
+		},
+		{
+			name: "generate pool with quota and target size bytes",
+			cephDpl: func() cephlcmv1alpha1.CephPool {
+				cephDplPool := unitinputs.CephDeployPoolReplicated.DeepCopy()
+				cephDplPool.Quota = &cephlcmv1alpha1.CephPoolQuota{MaxBytes: "100Gi", MaxObjects: 1000}
+				cephDplPool.TargetSizeBytes = "50Gi"
+				return *cephDplPool
+			}(),
+			expectedPool: func() *cephv1.CephBlockPool {
+				cephpool := unitinputs.CephBlockPoolReplicated.DeepCopy()
+				maxSize := "100Gi"
+				maxObjects := uint64(1000)
+				cephpool.Spec.Quotas = cephv1.QuotaSpec{MaxSize: &maxSize, MaxObjects: &maxObjects}
+				cephpool.Spec.Parameters = map[string]string{"target_size_bytes": "53687091200"}
+				return cephpool
+			}(),
+		},
+		{
+			name: "generate pool with target size ratio and parameters",
+			cephDpl: func() cephlcmv1alpha1.CephPool {
+				cephDplPool := unitinputs.CephDeployPoolReplicated.DeepCopy()
+				spec, _ := cephDplPool.GetSpec()
+				spec.Parameters = map[string]string{"pg_autoscale_mode": "on"}
+				cephDplPool.PoolSpec.Raw = unitinputs.ConvertStructToRaw(spec)
+				cephDplPool.TargetSizeRatio = "0.3"
+				return *cephDplPool
+			}(),
+			expectedPool: func() *cephv1.CephBlockPool {
+				cephpool := unitinputs.CephBlockPoolReplicated.DeepCopy()
+				cephpool.Spec.Parameters = map[string]string{
+					"pg_autoscale_mode": "on",
+					"target_size_ratio": "0.3",
+				}
+				return cephpool
+			}(),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			actualPool := generatePool(test.cephDpl, "rook-ceph")
+			actualPool := generatePool(test.cephDpl, test.crush, "rook-ceph")
 			assert.Equal(t, test.expectedPool, actualPool)
 		})
 	}
@@ -167,7 +222,7 @@ func TestEnsurePools(t *testing.T) {
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephblockpools": &cephv1.CephBlockPoolList{
-					Items: []cephv1.CephBlockPool{unitinputs.CephBlockPoolReplicated},
+					Items: []cephv1.CephBlockPool{getAppliedPool(unitinputs.CephBlockPoolReplicated)},
 				},
 			},
 			stateChanged: true,
@@ -196,7 +251,7 @@ func TestEnsurePools(t *testing.T) {
 				"cephblockpools": &cephv1.CephBlockPoolList{
 					Items: []cephv1.CephBlockPool{
 						func() cephv1.CephBlockPool {
-							cephpool := unitinputs.GetReadyPoolWithRatio("pool1-hdd", true, 0.5)
+							cephpool := getAppliedPool(unitinputs.GetReadyPoolWithRatio("pool1-hdd", true, 0.5))
 							delete(cephpool.Labels, "app.kubernetes.io/part-of")
 							return cephpool
 						}(),
@@ -204,7 +259,9 @@ func TestEnsurePools(t *testing.T) {
 				},
 			},
 			expectedResources: map[string]runtime.Object{
-				"cephblockpools": unitinputs.CephBlockPoolListBaseReady.DeepCopy(),
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{getAppliedPool(unitinputs.CephBlockPoolListBaseReady.Items[0])},
+				},
 			},
 			stateChanged: true,
 		},
@@ -219,6 +276,17 @@ func TestEnsurePools(t *testing.T) {
 			apiErrors:     map[string]error{"update-cephblockpools": errors.New("update failed")},
 			expectedError: "failed to ensure CephBlockPools: failed to update CephBlockPool rook-ceph/pool1-hdd: update failed",
 		},
+		{
+			name:    "ensure pools - pool type change rejected",
+			cephDpl: &unitinputs.CephDeployNonMosk,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{unitinputs.GetCephBlockPoolWithStatus(unitinputs.CephBlockPoolErasureCoded, true)},
+				},
+			},
+			apiErrors:     map[string]error{"update-cephblockpools": errors.New("unexpected update call")},
+			expectedError: "failed to ensure CephBlockPools: CephBlockPool rook-ceph/pool1-hdd pool type can't be changed from erasureCoded to replicated, create a new pool and migrate data instead",
+		},
 		{
 			name:    "ensure pools - pool deleted",
 			cephDpl: &unitinputs.BaseCephDeployment,
@@ -265,11 +333,47 @@ func TestEnsurePools(t *testing.T) {
 			expectedError: "failed to ensure CephBlockPools, multiple errors during pools ensure",
 		},
 		{
-			name:    "ensure pools - nothing changed",
+			name:    "ensure pools - not tracked pool in sync, applied spec is saved",
 			cephDpl: &unitinputs.CephDeployNonMosk,
 			inputResources: map[string]runtime.Object{
 				"cephblockpools": unitinputs.CephBlockPoolListBaseReady.DeepCopy(),
 			},
+			expectedResources: map[string]runtime.Object{
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{getAppliedPool(unitinputs.CephBlockPoolListBaseReady.Items[0])},
+				},
+			},
+			stateChanged: true,
+		},
+		{
+			name:    "ensure pools - pool changed outside, changes are reverted",
+			cephDpl: &unitinputs.CephDeployNonMosk,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{
+						func() cephv1.CephBlockPool {
+							cephpool := getAppliedPool(unitinputs.CephBlockPoolListBaseReady.Items[0])
+							cephpool.Spec.Replicated.Size = 2
+							return cephpool
+						}(),
+					},
+				},
+			},
+			expectedResources: map[string]runtime.Object{
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{getAppliedPool(unitinputs.CephBlockPoolListBaseReady.Items[0])},
+				},
+			},
+			stateChanged: true,
+		},
+		{
+			name:    "ensure pools - nothing changed",
+			cephDpl: &unitinputs.CephDeployNonMosk,
+			inputResources: map[string]runtime.Object{
+				"cephblockpools": &cephv1.CephBlockPoolList{
+					Items: []cephv1.CephBlockPool{getAppliedPool(unitinputs.CephBlockPoolListBaseReady.Items[0])},
+				},
+			},
 		},
 	}
 
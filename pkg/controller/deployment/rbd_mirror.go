@@ -59,6 +59,9 @@ func (c *cephDeploymentConfig) ensureRBDMirroring() (bool, error) {
 		if err != nil && !apierrors.IsNotFound(err) {
 			return false, errors.Wrapf(err, "failed to remove unspecified %v CephRBDMirror", mirror.Name)
 		}
+		if err == nil {
+			c.recordObjectEvent(objectDelete, "CephRBDMirror", c.lcmConfig.RookNamespace, mirror.Name)
+		}
 		rbdConfgChanged = true
 	}
 
@@ -77,6 +80,7 @@ func (c *cephDeploymentConfig) ensureRBDMirroring() (bool, error) {
 		if err != nil {
 			return false, errors.Wrapf(err, "failed to create %s CephRBDMirror", c.cdConfig.cephDpl.Name)
 		}
+		c.recordObjectEvent(objectCreate, "CephRBDMirror", c.lcmConfig.RookNamespace, newRbdMirror.Name)
 		return true, nil
 	}
 
@@ -116,6 +120,7 @@ func (c *cephDeploymentConfig) deleteRBDMirroring() (bool, error) {
 		}
 	} else {
 		c.log.Info().Msgf("CephRBDMirror %q removed", c.cdConfig.cephDpl.Name)
+		c.recordObjectEvent(objectDelete, "CephRBDMirror", c.lcmConfig.RookNamespace, c.cdConfig.cephDpl.Name)
 		rbdRemoved = false
 	}
 	removedSecrets, err := c.deleteRBDSecrets()
@@ -37,6 +37,7 @@ import (
 
 func (c *cephDeploymentConfig) ensureRgw() (bool, error) {
 	c.log.Debug().Msg("ensure object stores")
+	c.markDriftChecked("CephObjectStore")
 	// Ensure that we have rgw designed by spec
 	consistent, err := c.ensureRgwConsistence()
 	if err != nil {
@@ -238,6 +239,8 @@ func (c *cephDeploymentConfig) deleteRgw(rgwToRemove string) (bool, error) {
 			if err != nil {
 				c.log.Error().Err(err).Msgf("failed to remove ceph object store %s", rgw.Name)
 				errMsg++
+			} else {
+				c.recordObjectEvent(objectDelete, "CephObjectStore", c.lcmConfig.RookNamespace, rgw.Name)
 			}
 			delete(resourceUpdateTimestamps.cephConfigMap, rgwConfigSectionName(rgw.Name))
 			delete(resourceUpdateTimestamps.rgwSSLCert, rgw.Name)
@@ -296,22 +299,27 @@ func (c *cephDeploymentConfig) ensureRgwObject(rgwIndexInSpec int) (bool, error)
 		if !apierrors.IsNotFound(err) {
 			return false, errors.Wrap(err, "failed to get rgw")
 		}
+		markSpecApplied(&rgwStore.ObjectMeta, rgwStore.Spec)
 		c.log.Info().Msgf("create rgw object store %s/%s", namespace, rgwStore.Name)
 		_, err := c.api.Rookclientset.CephV1().CephObjectStores(namespace).Create(c.context, rgwStore, metav1.CreateOptions{})
 		if err != nil {
 			return false, errors.Wrap(err, "failed to create rgw")
 		}
+		c.recordObjectEvent(objectCreate, "CephObjectStore", namespace, rgwStore.Name)
 		changed = true
 	} else {
-		specUpdated := !reflect.DeepEqual(rgw.Spec, rgwStore.Spec)
+		specUpdated, annotationsUpdated := c.checkObjectDrift("CephObjectStore", &rgw.ObjectMeta, rgw.Spec, rgwStore.Spec)
 		changedBaseLabels := lcmcommon.AlignBaseLabels(*c.log, "CephObjectStore", &rgw.ObjectMeta, rgwStore.Labels)
-		if specUpdated || changedBaseLabels {
+		if specUpdated || changedBaseLabels || annotationsUpdated {
 			if specUpdated {
 				// when rgw.Spec.Zone.Name is empty and going to be changed, probably that
 				// is switching to multisite configuration
 				if rgw.Spec.Zone.Name != rgwStore.Spec.Zone.Name && rgw.Spec.Zone.Name != "" {
 					return false, errors.New("failed to update rgw, zone change is not supported")
 				}
+				if err := validateErasureCodedPoolUpdate("data", rgw.Spec.DataPool, rgwStore.Spec.DataPool); err != nil {
+					return false, errors.Wrap(err, "failed to update rgw")
+				}
 				lcmcommon.ShowObjectDiff(*c.log, rgw.Spec, rgwStore.Spec)
 				rgw.Spec = rgwStore.Spec
 			}
@@ -449,6 +457,7 @@ func (c *cephDeploymentConfig) processRgwUsers(process objectProcess, rgwUser *c
 		c.log.Error().Err(err).Msg("")
 		return err
 	}
+	c.recordObjectEvent(process, "CephObjectStoreUser", rgwUser.Namespace, rgwUser.Name)
 	return nil
 }
 
@@ -1062,5 +1071,6 @@ func (c *cephDeploymentConfig) deleteRgwBuiltInPool() (bool, error) {
 		return false, errors.Wrapf(err, "failed to delete builtin rgw pool %s/%s", c.lcmConfig.RookNamespace, poolName)
 	}
 	c.log.Info().Msgf("removed builtin CephBlockPool %s/%s", c.lcmConfig.RookNamespace, poolName)
+	c.recordObjectEvent(objectDelete, "CephBlockPool", c.lcmConfig.RookNamespace, poolName)
 	return false, nil
 }
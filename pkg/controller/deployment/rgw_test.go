@@ -706,7 +706,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreBase},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreBase)},
 				},
 			},
 			changed: true,
@@ -720,7 +720,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			}(),
 			inputResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreBase.DeepCopy()},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreBase)},
 				},
 			},
 			newTimestamps: &updateTimestamps{
@@ -759,7 +759,7 @@ func TestEnsureRgwObject(t *testing.T) {
 									Operator: "Exists",
 								},
 							}
-							return *rgw
+							return getAppliedRgw(*rgw)
 						}(),
 					},
 				},
@@ -782,7 +782,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			cephDpl: &unitinputs.CephDeployMosk,
 			inputResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreBase},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreBase)},
 				},
 			},
 			newTimestamps: &updateTimestamps{
@@ -840,7 +840,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreExternal},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreExternal)},
 				},
 			},
 			changed: true,
@@ -855,7 +855,7 @@ func TestEnsureRgwObject(t *testing.T) {
 							rgw := unitinputs.CephObjectStoreExternal.DeepCopy()
 							rgw.Labels = nil
 							rgw.Spec.Gateway.Port = 3333
-							return *rgw
+							return getAppliedRgw(*rgw)
 						}(),
 					},
 				},
@@ -865,7 +865,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreExternal},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreExternal)},
 				},
 			},
 			changed: true,
@@ -875,7 +875,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			cephDpl: &unitinputs.CephDeployExternalRgw,
 			inputResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreExternal},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreExternal)},
 				},
 				"secrets": &v1.SecretList{
 					Items: []v1.Secret{unitinputs.RookCephRgwAdminSecret},
@@ -926,7 +926,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreWithZone},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreWithZone)},
 				},
 			},
 			newTimestamps: &updateTimestamps{
@@ -955,12 +955,12 @@ func TestEnsureRgwObject(t *testing.T) {
 			cephDpl: &unitinputs.CephDeployMultisiteMasterRgw,
 			inputResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreBase.DeepCopy()},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreBase)},
 				},
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreWithZone},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreWithZone)},
 				},
 			},
 			newTimestamps: &updateTimestamps{
@@ -979,7 +979,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			cephDpl: &unitinputs.CephDeployMultisiteMasterRgw,
 			inputResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreWithZone.DeepCopy()},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreWithZone)},
 				},
 			},
 			newTimestamps: &updateTimestamps{
@@ -1001,7 +1001,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			},
 			expectedResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreWithSyncDaemon},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreWithSyncDaemon)},
 				},
 			},
 			changed: true,
@@ -1021,7 +1021,7 @@ func TestEnsureRgwObject(t *testing.T) {
 						func() cephv1.CephObjectStore {
 							store := unitinputs.CephObjectStoreWithSyncDaemon.DeepCopy()
 							store.Spec.Gateway.Port = 3333
-							return *store
+							return getAppliedRgw(*store)
 						}(),
 					},
 				},
@@ -1045,7 +1045,7 @@ func TestEnsureRgwObject(t *testing.T) {
 							rgw := unitinputs.CephObjectStoreWithSyncDaemon.DeepCopy()
 							rgw.Spec.Gateway.Annotations["cephdeployment.lcm.mirantis.com/config-client.rgw.rgw.store.sync.a-updated"] = "new-rgw-sync-time"
 							rgw.Spec.Gateway.Annotations["cephdeployment.lcm.mirantis.com/config-global-updated"] = "new-global-time"
-							return *rgw
+							return getAppliedRgw(*rgw)
 						}(),
 					},
 				},
@@ -1058,7 +1058,7 @@ func TestEnsureRgwObject(t *testing.T) {
 			rgwIdx:  1,
 			inputResources: map[string]runtime.Object{
 				"cephobjectstores": &cephv1.CephObjectStoreList{
-					Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreWithSyncDaemon},
+					Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreWithSyncDaemon)},
 				},
 			},
 		},
@@ -1515,7 +1515,7 @@ func TestEnsureRgwMain(t *testing.T) {
 					func() cephv1.CephObjectStore {
 						store := unitinputs.CephObjectStoreBase.DeepCopy()
 						store.Spec.Gateway.Annotations["cephdeployment.lcm.mirantis.com/ssl-cert-generated"] = "test-4-time"
-						return *store
+						return getAppliedRgw(*store)
 					}(),
 				},
 				},
@@ -1547,7 +1547,7 @@ func TestEnsureRgwMain(t *testing.T) {
 					func() cephv1.CephObjectStore {
 						store := unitinputs.CephObjectStoreBase.DeepCopy()
 						store.Spec.Gateway.Annotations["cephdeployment.lcm.mirantis.com/ssl-cert-generated"] = "test-5-time"
-						return *store
+						return getAppliedRgw(*store)
 					}(),
 				},
 				},
@@ -1571,7 +1571,7 @@ func TestEnsureRgwMain(t *testing.T) {
 			name:    "ensure rgw - ensure rgw, nothing to do",
 			cephDpl: &unitinputs.CephDeployNonMosk,
 			inputResources: map[string]runtime.Object{
-				"cephobjectstores":     unitinputs.CephObjectStoreBaseListReady.DeepCopy(),
+				"cephobjectstores":     getAppliedRgwList(unitinputs.CephObjectStoreBaseListReady),
 				"cephobjectstoreusers": unitinputs.CephRgwUsersList.DeepCopy(),
 				"secrets": &v1.SecretList{Items: []v1.Secret{
 					func() v1.Secret {
@@ -1603,7 +1603,7 @@ func TestEnsureRgwMain(t *testing.T) {
 				},
 				"cephobjectstoreusers": unitinputs.CephObjectStoreUserListEmpty.DeepCopy(),
 				"storageclasses":       &v1storage.StorageClassList{Items: []v1storage.StorageClass{unitinputs.RgwStorageClass}},
-				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreExternal}},
+				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreExternal)}},
 			},
 			changed: true,
 		},
@@ -1616,7 +1616,7 @@ func TestEnsureRgwMain(t *testing.T) {
 				},
 				"cephobjectstoreusers": unitinputs.CephObjectStoreUserListEmpty.DeepCopy(),
 				"storageclasses":       &v1storage.StorageClassList{Items: []v1storage.StorageClass{unitinputs.RgwStorageClass}},
-				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{*unitinputs.CephObjectStoreExternal}},
+				"cephobjectstores":     &cephv1.CephObjectStoreList{Items: []cephv1.CephObjectStore{getAppliedRgw(*unitinputs.CephObjectStoreExternal)}},
 			},
 		},
 		{
@@ -1635,7 +1635,7 @@ func TestEnsureRgwMain(t *testing.T) {
 					func() cephv1.CephObjectStore {
 						store := unitinputs.CephObjectStoreBase.DeepCopy()
 						store.Spec.Gateway.Annotations["cephdeployment.lcm.mirantis.com/ssl-cert-generated"] = "test-9-time"
-						return *store
+						return getAppliedRgw(*store)
 					}(),
 				},
 				},
@@ -1658,7 +1658,7 @@ func TestEnsureRgwMain(t *testing.T) {
 			name:    "ensure rgw - ensure rgw, openstack, nothing to do",
 			cephDpl: &unitinputs.CephDeployMoskWithoutIngress,
 			inputResources: map[string]runtime.Object{
-				"cephobjectstores":     unitinputs.CephObjectStoreBaseListReady.DeepCopy(),
+				"cephobjectstores":     getAppliedRgwList(unitinputs.CephObjectStoreBaseListReady),
 				"cephobjectstoreusers": unitinputs.CephObjectStoreUserListMetrics.DeepCopy(),
 				"secrets": &v1.SecretList{Items: []v1.Secret{
 					unitinputs.OpenstackRgwCredsSecret,
@@ -1693,9 +1693,9 @@ func TestEnsureRgwMain(t *testing.T) {
 							rgw.Spec.Zone.Name = "secondary-zone1"
 							rgw.Spec.Gateway.DisableMultisiteSyncTraffic = true
 							rgw.Spec.Gateway.Annotations["cephdeployment.lcm.mirantis.com/ssl-cert-generated"] = "test-11-time"
-							return *rgw
+							return getAppliedRgw(*rgw)
 						}(),
-						*unitinputs.CephObjectStoreWithSyncDaemon,
+						getAppliedRgw(*unitinputs.CephObjectStoreWithSyncDaemon),
 					},
 				},
 				"cephobjectstoreusers": unitinputs.CephObjectStoreUserListEmpty.DeepCopy(),
@@ -1717,7 +1717,7 @@ func TestEnsureRgwMain(t *testing.T) {
 			name:    "ensure rgw - multisite rgw with sync daemon, nothing to do",
 			cephDpl: &unitinputs.MultisiteRgwWithSyncDaemon,
 			inputResources: map[string]runtime.Object{
-				"cephobjectstores":     &unitinputs.CephObjectStoreMultisiteSyncList,
+				"cephobjectstores":     getAppliedRgwList(unitinputs.CephObjectStoreMultisiteSyncList),
 				"cephobjectstoreusers": unitinputs.CephObjectStoreUserListEmpty.DeepCopy(),
 				"secrets": &v1.SecretList{Items: []v1.Secret{
 					unitinputs.MultisiteCabundleSecret,
@@ -0,0 +1,343 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// cephConfigSchema is a Ceph options schema for a particular Ceph version
+type cephConfigSchema struct {
+	// all known options from 'ceph config ls' output
+	options map[string]bool
+	// options details from 'ceph config help' output, loaded on demand
+	help map[string]*lcmcommon.CephConfigOptionHelp
+}
+
+// max number of 'ceph config help' commands run in parallel
+const cephConfigHelpMaxParallel = 5
+
+var (
+	// Ceph options schema is not changed until Ceph cluster upgrade,
+	// so it is cached per Ceph cluster version
+	cephConfigSchemaCache = map[string]*cephConfigSchema{}
+	cephConfigSchemaLock  sync.Mutex
+
+	cephOptionSIIntRegexp  = regexp.MustCompile(`^(-?\d+)([KMGTPE]?)$`)
+	cephOptionSizeRegexp   = regexp.MustCompile(`^(\d+)\s*(?:([KMGTPE])i?)?B?$`)
+	cephOptionTimeRegexp   = regexp.MustCompile(`^(\d+\s*[a-z]+\s*)+$`)
+	cephOptionUUIDRegexp   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	cephOptionBoolValues   = []string{"true", "false", "yes", "no", "1", "0"}
+	cephOptionUnitPrefixes = "KMGTPE"
+)
+
+// getCachedCephConfigSchema returns a copy of cached Ceph options schema for specified Ceph version
+// with details for requested options and the list of known options, which details are not cached yet.
+// Returns nil schema if options list for the version is not cached
+func getCachedCephConfigSchema(version string, names []string) (*cephConfigSchema, []string) {
+	cephConfigSchemaLock.Lock()
+	defer cephConfigSchemaLock.Unlock()
+	cached, present := cephConfigSchemaCache[version]
+	if !present {
+		return nil, nil
+	}
+	schema := &cephConfigSchema{
+		options: cached.options,
+		help:    map[string]*lcmcommon.CephConfigOptionHelp{},
+	}
+	missing := []string{}
+	for _, name := range names {
+		if !schema.options[name] {
+			continue
+		}
+		if help, loaded := cached.help[name]; loaded {
+			schema.help[name] = help
+		} else if !lcmcommon.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+	return schema, missing
+}
+
+// getCephConfigSchema returns Ceph options schema for specified Ceph version with details
+// for requested options, missed data is loaded through toolbox in parallel and cached
+func (c *cephDeploymentConfig) getCephConfigSchema(version string, names []string) (*cephConfigSchema, error) {
+	schema, missing := getCachedCephConfigSchema(version, names)
+	if schema == nil {
+		var options []string
+		err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, "ceph config ls -f json", &options)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list Ceph config options")
+		}
+		cephConfigSchemaLock.Lock()
+		if _, present := cephConfigSchemaCache[version]; !present {
+			cached := &cephConfigSchema{
+				options: map[string]bool{},
+				help:    map[string]*lcmcommon.CephConfigOptionHelp{},
+			}
+			for _, option := range options {
+				cached.options[option] = true
+			}
+			cephConfigSchemaCache[version] = cached
+		}
+		cephConfigSchemaLock.Unlock()
+		schema, missing = getCachedCephConfigSchema(version, names)
+	}
+	if len(missing) == 0 {
+		return schema, nil
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var loadErr error
+	threads := make(chan struct{}, cephConfigHelpMaxParallel)
+	for _, name := range missing {
+		wg.Add(1)
+		threads <- struct{}{}
+		go func(name string) {
+			defer func() {
+				<-threads
+				wg.Done()
+			}()
+			help := &lcmcommon.CephConfigOptionHelp{}
+			err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, fmt.Sprintf("ceph config help %s -f json", name), help)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if loadErr == nil {
+					loadErr = errors.Wrapf(err, "failed to get Ceph config option '%s' details", name)
+				}
+				return
+			}
+			schema.help[name] = help
+		}(name)
+	}
+	wg.Wait()
+	// cache loaded details even on partial failure to not request them again
+	cephConfigSchemaLock.Lock()
+	if cached, present := cephConfigSchemaCache[version]; present {
+		for name, help := range schema.help {
+			cached.help[name] = help
+		}
+	}
+	cephConfigSchemaLock.Unlock()
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	return schema, nil
+}
+
+// validateRookConfig verifies rookConfig options against Ceph options schema of the running Ceph
+// version. Returns issues for unknown options and invalid values, and warnings for options
+// which are not applicable for the specified section or can not be updated at runtime.
+// With cachedOnly options are verified only if schema is already cached, to not run Ceph
+// commands during admission requests
+func (c *cephDeploymentConfig) validateRookConfig(cachedOnly bool) ([]string, []string) {
+	rookConfig := c.cdConfig.cephDpl.Spec.RookConfig
+	if len(rookConfig) == 0 {
+		return nil, nil
+	}
+	version := c.cdConfig.cephDpl.Status.ClusterVersion
+	if version == "" {
+		c.log.Debug().Msg("Ceph cluster version is not known yet, rookConfig is not verified against Ceph options schema")
+		return nil, nil
+	}
+	keys := lcmcommon.SortedMapKeys(rookConfig)
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		_, rawKey := getSectionAndKey(key)
+		names = append(names, getCephOptionName(rawKey))
+	}
+	var schema *cephConfigSchema
+	if cachedOnly {
+		cached, missing := getCachedCephConfigSchema(version, names)
+		if cached == nil || len(missing) > 0 {
+			c.log.Debug().Msg("Ceph options schema is not cached yet, rookConfig is not verified against Ceph options schema")
+			return nil, nil
+		}
+		schema = cached
+	} else {
+		var err error
+		schema, err = c.getCephConfigSchema(version, names)
+		if err != nil {
+			c.log.Warn().Err(err).Msg("failed to get Ceph options schema, rookConfig is not verified against Ceph options schema")
+			return nil, nil
+		}
+	}
+	issues := []string{}
+	warnings := []string{}
+	for idx, key := range keys {
+		name := names[idx]
+		// Ceph Manager modules options are not listed in Ceph options schema
+		if strings.Contains(name, "/") {
+			continue
+		}
+		if !schema.options[name] {
+			issues = append(issues, fmt.Sprintf("rookConfig option '%s' is unknown for Ceph version %s", key, version))
+			continue
+		}
+		help := schema.help[name]
+		if err := validateCephOptionValue(help, rookConfig[key]); err != nil {
+			value := rookConfig[key]
+			if lcmcommon.Contains(passwordKeys, name) {
+				value = "*"
+			}
+			issues = append(issues, fmt.Sprintf("rookConfig option '%s' has invalid value '%s': %v", key, value, err))
+		}
+		section, _ := getSectionAndKey(key)
+		if section != "" && !isCephOptionApplicableToSection(help.Services, section) {
+			warnings = append(warnings, fmt.Sprintf("rookConfig option '%s' is not applicable for '%s' section, option is used by %v", key, section, help.Services))
+		}
+		if !help.CanUpdateAtRuntime || lcmcommon.Contains(help.Flags, "startup") {
+			warnings = append(warnings, fmt.Sprintf("rookConfig option '%s' can not be updated at runtime, affected Ceph daemons restart is required to apply it", key))
+		}
+	}
+	return issues, warnings
+}
+
+// getCephOptionName returns Ceph option name as it is known by Ceph
+func getCephOptionName(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(strings.TrimSpace(key), " ", "_"), "-", "_")
+}
+
+// parseCephOptionNumber parses numeric Ceph option value with respect of option type,
+// returns false if value is valid, but can not be compared with option range
+func parseCephOptionNumber(optionType, value string) (float64, bool, error) {
+	switch optionType {
+	case "int", "uint":
+		match := cephOptionSIIntRegexp.FindStringSubmatch(value)
+		if match == nil {
+			return 0, false, errors.Errorf("expected %s value", optionType)
+		}
+		number, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return 0, false, errors.Errorf("expected %s value", optionType)
+		}
+		if optionType == "uint" && number < 0 {
+			return 0, false, errors.New("expected non-negative value")
+		}
+		return number * math.Pow(1000, float64(strings.Index(cephOptionUnitPrefixes, match[2])+1)), true, nil
+	case "size":
+		match := cephOptionSizeRegexp.FindStringSubmatch(value)
+		if match == nil {
+			return 0, false, errors.New("expected size value, for example '4096', '64K' or '1GiB'")
+		}
+		number, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return 0, false, errors.New("expected size value, for example '4096', '64K' or '1GiB'")
+		}
+		return number * math.Pow(1024, float64(strings.Index(cephOptionUnitPrefixes, match[2])+1)), true, nil
+	case "float":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, false, errors.New("expected float value")
+		}
+		return number, true, nil
+	case "secs", "millisecs":
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number, true, nil
+		}
+		if !cephOptionTimeRegexp.MatchString(value) {
+			return 0, false, errors.New("expected time span value, for example '30' or '1h 30m'")
+		}
+		return 0, false, nil
+	}
+	return 0, false, nil
+}
+
+// getCephOptionBound returns option min or max bound if it is set
+func getCephOptionBound(bound interface{}) (float64, bool) {
+	switch v := bound.(type) {
+	case float64:
+		return v, true
+	case string:
+		if number, err := strconv.ParseFloat(v, 64); err == nil {
+			return number, true
+		}
+	}
+	return 0, false
+}
+
+// validateCephOptionValue verifies option value type and range
+func validateCephOptionValue(help *lcmcommon.CephConfigOptionHelp, value string) error {
+	value = strings.TrimSpace(value)
+	switch help.Type {
+	case "bool":
+		if !lcmcommon.Contains(cephOptionBoolValues, strings.ToLower(value)) {
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return errors.New("expected bool value")
+			}
+		}
+		return nil
+	case "uuid":
+		if !cephOptionUUIDRegexp.MatchString(value) {
+			return errors.New("expected uuid value")
+		}
+		return nil
+	case "str":
+		if len(help.EnumValues) > 0 && !lcmcommon.Contains(help.EnumValues, value) {
+			enumValues := append([]string{}, help.EnumValues...)
+			sort.Strings(enumValues)
+			return errors.Errorf("expected one of %v", enumValues)
+		}
+		return nil
+	}
+	number, comparable, err := parseCephOptionNumber(help.Type, value)
+	if err != nil || !comparable {
+		return err
+	}
+	if minValue, present := getCephOptionBound(help.Min); present && number < minValue {
+		return errors.Errorf("value is less than allowed minimum %v", minValue)
+	}
+	if maxValue, present := getCephOptionBound(help.Max); present && number > maxValue {
+		return errors.Errorf("value is greater than allowed maximum %v", maxValue)
+	}
+	return nil
+}
+
+// isCephOptionApplicableToSection checks that option is used by daemons of the section
+func isCephOptionApplicableToSection(services []string, section string) bool {
+	if len(services) == 0 || lcmcommon.Contains(services, "common") || section == "global" {
+		return true
+	}
+	who := strings.Split(section, "/")[0]
+	daemon := strings.Split(who, ".")[0]
+	switch daemon {
+	case "mon", "mgr", "osd", "mds":
+		return lcmcommon.Contains(services, daemon)
+	case "client":
+		if strings.HasPrefix(who, "client.rgw") {
+			return lcmcommon.Contains(services, "rgw")
+		}
+		for _, service := range services {
+			if !lcmcommon.Contains([]string{"mon", "mgr", "osd", "mds"}, service) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+var cephConfigHelpOutput = map[string]string{
+	"ceph config help osd_max_backfills -f json":                  `{"name":"osd_max_backfills","type":"uint","level":"advanced","services":["osd"],"enum_values":[],"min":"","max":"","can_update_at_runtime":true,"flags":[]}`,
+	"ceph config help osd_op_num_shards -f json":                  `{"name":"osd_op_num_shards","type":"int","level":"advanced","services":["osd"],"enum_values":[],"min":"","max":"","can_update_at_runtime":false,"flags":["startup"]}`,
+	"ceph config help mon_max_pg_per_osd -f json":                 `{"name":"mon_max_pg_per_osd","type":"uint","level":"advanced","services":["mgr","mon"],"enum_values":[],"min":1,"max":"","can_update_at_runtime":true,"flags":[]}`,
+	"ceph config help osd_pool_default_pg_autoscale_mode -f json": `{"name":"osd_pool_default_pg_autoscale_mode","type":"str","level":"advanced","services":["mon"],"enum_values":["off","warn","on"],"min":"","max":"","can_update_at_runtime":true,"flags":[]}`,
+	"ceph config help bluestore_cache_size -f json":               `{"name":"bluestore_cache_size","type":"size","level":"dev","services":["osd"],"enum_values":[],"min":"","max":"","can_update_at_runtime":true,"flags":[]}`,
+	"ceph config help rgw_keystone_admin_password -f json":        `{"name":"rgw_keystone_admin_password","type":"str","level":"advanced","services":["rgw"],"enum_values":[],"min":"","max":"","can_update_at_runtime":true,"flags":[]}`,
+}
+
+var cephConfigLsOutput = `["osd_max_backfills", "osd_op_num_shards", "mon_max_pg_per_osd", "osd_pool_default_pg_autoscale_mode", "bluestore_cache_size", "rgw_keystone_admin_password"]`
+
+func TestValidateRookConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		rookConfig       map[string]string
+		clusterVersion   string
+		cliFailed        bool
+		cachedVersion    bool
+		cachedOnly       bool
+		expectedIssues   []string
+		expectedWarnings []string
+		expectedCommands []string
+	}{
+		{
+			name:           "no rookConfig specified",
+			clusterVersion: "v19.2.3",
+		},
+		{
+			name:           "admission request, ceph options schema is not cached",
+			rookConfig:     map[string]string{"osd_max_backfills": "64"},
+			clusterVersion: "v19.2.3",
+			cachedOnly:     true,
+		},
+		{
+			name:       "cluster version is not known yet",
+			rookConfig: map[string]string{"osd_max_backfills": "64"},
+		},
+		{
+			name:             "failed to get ceph options schema",
+			rookConfig:       map[string]string{"osd_max_backfills": "64"},
+			clusterVersion:   "v19.2.3",
+			cliFailed:        true,
+			expectedCommands: []string{"ceph config ls -f json"},
+		},
+		{
+			name: "rookConfig is valid",
+			rookConfig: map[string]string{
+				"osd|osd_max_backfills":              "64",
+				"mon max pg per osd":                 "300",
+				"osd_pool_default_pg_autoscale_mode": "warn",
+				"osd|bluestore_cache_size":           "3GiB",
+				"mgr|mgr/dashboard/ssl":              "false",
+			},
+			clusterVersion:   "v19.2.3",
+			expectedIssues:   []string{},
+			expectedWarnings: []string{},
+			expectedCommands: []string{
+				"ceph config ls -f json",
+				"ceph config help bluestore_cache_size -f json",
+				"ceph config help mon_max_pg_per_osd -f json",
+				"ceph config help osd_max_backfills -f json",
+				"ceph config help osd_pool_default_pg_autoscale_mode -f json",
+			},
+		},
+		{
+			name: "admission request, ceph options schema is cached partially",
+			rookConfig: map[string]string{
+				"mon|mon_max_pg_per_osd": "0",
+				"mon|osd_op_num_shards":  "8",
+			},
+			clusterVersion: "v19.2.3",
+			cachedVersion:  true,
+			cachedOnly:     true,
+		},
+		{
+			name: "rookConfig has issues and warnings, schema is cached",
+			rookConfig: map[string]string{
+				"osd|osd_max_backfils":                               "64",
+				"osd|osd_max_backfills":                              "-1",
+				"mon|mon_max_pg_per_osd":                             "0",
+				"osd_pool_default_pg_autoscale_mode":                 "enabled",
+				"osd|bluestore_cache_size":                           "3 gigabytes",
+				"mon|osd_op_num_shards":                              "8",
+				"client.rgw.rgw.store.a|rgw_keystone_admin_password": "password",
+			},
+			clusterVersion: "v19.2.3",
+			cachedVersion:  true,
+			expectedIssues: []string{
+				"rookConfig option 'mon|mon_max_pg_per_osd' has invalid value '0': value is less than allowed minimum 1",
+				"rookConfig option 'osd_pool_default_pg_autoscale_mode' has invalid value 'enabled': expected one of [off on warn]",
+				"rookConfig option 'osd|bluestore_cache_size' has invalid value '3 gigabytes': expected size value, for example '4096', '64K' or '1GiB'",
+				"rookConfig option 'osd|osd_max_backfils' is unknown for Ceph version v19.2.3",
+				"rookConfig option 'osd|osd_max_backfills' has invalid value '-1': expected non-negative value",
+			},
+			expectedWarnings: []string{
+				"rookConfig option 'mon|osd_op_num_shards' is not applicable for 'mon' section, option is used by [osd]",
+				"rookConfig option 'mon|osd_op_num_shards' can not be updated at runtime, affected Ceph daemons restart is required to apply it",
+			},
+			expectedCommands: []string{
+				"ceph config help osd_op_num_shards -f json",
+				"ceph config help rgw_keystone_admin_password -f json",
+			},
+		},
+		{
+			name: "admission request, ceph options schema is cached",
+			rookConfig: map[string]string{
+				"mon|mon_max_pg_per_osd": "0",
+				"mon|osd_op_num_shards":  "8",
+			},
+			clusterVersion: "v19.2.3",
+			cachedVersion:  true,
+			cachedOnly:     true,
+			expectedIssues: []string{
+				"rookConfig option 'mon|mon_max_pg_per_osd' has invalid value '0': value is less than allowed minimum 1",
+			},
+			expectedWarnings: []string{
+				"rookConfig option 'mon|osd_op_num_shards' is not applicable for 'mon' section, option is used by [osd]",
+				"rookConfig option 'mon|osd_op_num_shards' can not be updated at runtime, affected Ceph daemons restart is required to apply it",
+			},
+		},
+	}
+	oldCmdFunc := lcmcommon.RunPodCommandWithValidation
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !test.cachedVersion {
+				cephConfigSchemaCache = map[string]*cephConfigSchema{}
+			}
+			cephDpl := unitinputs.BaseCephDeployment.DeepCopy()
+			cephDpl.Spec.RookConfig = test.rookConfig
+			cephDpl.Status.ClusterVersion = test.clusterVersion
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl}, nil)
+
+			commands := []string{}
+			var commandsLock sync.Mutex
+			lcmcommon.RunPodCommandWithValidation = func(e lcmcommon.ExecConfig) (string, string, error) {
+				commandsLock.Lock()
+				commands = append(commands, e.Command)
+				commandsLock.Unlock()
+				if test.cliFailed {
+					return "", "", errors.New("command failed")
+				}
+				if e.Command == "ceph config ls -f json" {
+					return cephConfigLsOutput, "", nil
+				}
+				if output, present := cephConfigHelpOutput[e.Command]; present {
+					return output, "", nil
+				}
+				return "", "", errors.New("unexpected command")
+			}
+
+			issues, warnings := c.validateRookConfig(test.cachedOnly)
+			assert.Equal(t, test.expectedIssues, issues)
+			assert.Equal(t, test.expectedWarnings, warnings)
+			if len(test.expectedCommands) > 0 {
+				assert.ElementsMatch(t, test.expectedCommands, commands)
+			} else {
+				assert.Empty(t, commands)
+			}
+		})
+	}
+	lcmcommon.RunPodCommandWithValidation = oldCmdFunc
+	cephConfigSchemaCache = map[string]*cephConfigSchema{}
+}
+
+func TestValidateCephOptionValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		help          lcmcommon.CephConfigOptionHelp
+		value         string
+		expectedError string
+	}{
+		{
+			name:  "bool value",
+			help:  lcmcommon.CephConfigOptionHelp{Type: "bool"},
+			value: "True",
+		},
+		{
+			name:          "invalid bool value",
+			help:          lcmcommon.CephConfigOptionHelp{Type: "bool"},
+			value:         "enabled",
+			expectedError: "expected bool value",
+		},
+		{
+			name:  "int value with SI suffix",
+			help:  lcmcommon.CephConfigOptionHelp{Type: "int", Max: float64(2000)},
+			value: "2K",
+		},
+		{
+			name:          "int value with SI suffix is out of range",
+			help:          lcmcommon.CephConfigOptionHelp{Type: "int", Max: float64(2000)},
+			value:         "3K",
+			expectedError: "value is greater than allowed maximum 2000",
+		},
+		{
+			name:          "invalid int value",
+			help:          lcmcommon.CephConfigOptionHelp{Type: "int"},
+			value:         "1.5",
+			expectedError: "expected int value",
+		},
+		{
+			name:  "float value in range",
+			help:  lcmcommon.CephConfigOptionHelp{Type: "float", Min: "0", Max: "1"},
+			value: "0.75",
+		},
+		{
+			name:          "float value out of range",
+			help:          lcmcommon.CephConfigOptionHelp{Type: "float", Min: "0", Max: "1"},
+			value:         "1.5",
+			expectedError: "value is greater than allowed maximum 1",
+		},
+		{
+			name:  "time span value",
+			help:  lcmcommon.CephConfigOptionHelp{Type: "secs"},
+			value: "1h 30m",
+		},
+		{
+			name:          "invalid time span value",
+			help:          lcmcommon.CephConfigOptionHelp{Type: "millisecs"},
+			value:         "-",
+			expectedError: "expected time span value, for example '30' or '1h 30m'",
+		},
+		{
+			name:  "uuid value",
+			help:  lcmcommon.CephConfigOptionHelp{Type: "uuid"},
+			value: "8668f062-3faa-358a-85f3-f80fe6c1e306",
+		},
+		{
+			name:          "invalid uuid value",
+			help:          lcmcommon.CephConfigOptionHelp{Type: "uuid"},
+			value:         "8668f062",
+			expectedError: "expected uuid value",
+		},
+		{
+			name:  "address value is not verified",
+			help:  lcmcommon.CephConfigOptionHelp{Type: "addr"},
+			value: "10.0.0.1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateCephOptionValue(&test.help, test.value)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestIsCephOptionApplicableToSection(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []string
+		section  string
+		expected bool
+	}{
+		{
+			name:     "common option",
+			services: []string{"common"},
+			section:  "osd",
+			expected: true,
+		},
+		{
+			name:     "global section",
+			services: []string{"osd"},
+			section:  "global",
+			expected: true,
+		},
+		{
+			name:     "osd option for particular osd with mask",
+			services: []string{"osd"},
+			section:  "osd.1/host:node-1",
+			expected: true,
+		},
+		{
+			name:     "osd option for mon section",
+			services: []string{"osd"},
+			section:  "mon",
+			expected: false,
+		},
+		{
+			name:     "rgw option for rgw client",
+			services: []string{"rgw"},
+			section:  "client.rgw.rgw.store.a",
+			expected: true,
+		},
+		{
+			name:     "rbd option for rgw client",
+			services: []string{"rbd"},
+			section:  "client.rgw.rgw.store.a",
+			expected: false,
+		},
+		{
+			name:     "rbd option for client",
+			services: []string{"rbd"},
+			section:  "client",
+			expected: true,
+		},
+		{
+			name:     "osd option for client",
+			services: []string{"osd"},
+			section:  "client",
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isCephOptionApplicableToSection(test.services, test.section))
+		})
+	}
+}
@@ -90,6 +90,7 @@ func (c *cephDeploymentConfig) ensureCephFS() (bool, error) {
 				fsErrors = append(fsErrors, msg)
 				continue
 			}
+			c.recordObjectEvent(objectCreate, "CephFilesystem", c.lcmConfig.RookNamespace, cephDplCephFS.Name)
 			changed = true
 			createInProgress = true
 		}
@@ -97,6 +98,12 @@ func (c *cephDeploymentConfig) ensureCephFS() (bool, error) {
 		if createInProgress {
 			continue
 		}
+		if msg := getCephFSDataPoolsUpdateIssue(cephFs.Spec.DataPools, cephFsResource.Spec.DataPools); msg != "" {
+			msg = fmt.Sprintf("failed to update CephFilesytem '%s/%s': %s", c.lcmConfig.RookNamespace, cephDplCephFS.Name, msg)
+			c.log.Error().Msg(msg)
+			fsErrors = append(fsErrors, msg)
+			continue
+		}
 		changedBaseLabels := lcmcommon.AlignBaseLabels(*c.log, "CephFilesystem", &cephFs.ObjectMeta, cephFsResource.Labels)
 		specUpdated := !reflect.DeepEqual(cephFsResource.Spec, cephFs.Spec)
 		if specUpdated || changedBaseLabels {
@@ -171,6 +178,9 @@ func (c *cephDeploymentConfig) removeCephFilesystem(cephfs string) error {
 	if err != nil && !apierrors.IsNotFound(err) {
 		return errors.Wrapf(err, "failed to remove CephFilesytem '%s/%s'", c.lcmConfig.RookNamespace, cephfs)
 	}
+	if err == nil {
+		c.recordObjectEvent(objectDelete, "CephFilesystem", c.lcmConfig.RookNamespace, cephfs)
+	}
 	delete(resourceUpdateTimestamps.cephConfigMap, fmt.Sprintf("mds.%s", cephfs))
 	return nil
 }
@@ -271,3 +281,18 @@ func generateCephFS(cephDplCephFS cephlcmv1alpha1.CephFilesystem, namespace stri
 	cephFS.Spec = castedSpec
 	return cephFS
 }
+
+// getCephFSDataPoolsUpdateIssue verifies that CephFS data pools type or erasure coded profile are not changed
+func getCephFSDataPoolsUpdateIssue(presentPools, desiredPools []cephv1.NamedPoolSpec) string {
+	for _, desiredPool := range desiredPools {
+		for _, presentPool := range presentPools {
+			if presentPool.Name == desiredPool.Name {
+				if err := validateErasureCodedPoolUpdate(fmt.Sprintf("data %s", desiredPool.Name), presentPool.PoolSpec, desiredPool.PoolSpec); err != nil {
+					return err.Error()
+				}
+				break
+			}
+		}
+	}
+	return ""
+}
@@ -60,6 +60,19 @@ func isTypeReadyToUpdate(condition cephv1.ConditionType) bool {
 	return true
 }
 
+// getCrushRule returns named crush rule from CephDeployment crush section or nil if not found
+func getCrushRule(crush *cephlcmv1alpha1.CephDeploymentCrush, name string) *cephlcmv1alpha1.CephCrushRule {
+	if crush == nil || name == "" {
+		return nil
+	}
+	for idx := range crush.Rules {
+		if crush.Rules[idx].Name == name {
+			return &crush.Rules[idx]
+		}
+	}
+	return nil
+}
+
 func getCephPoolName(pool cephlcmv1alpha1.CephPool) string {
 	if pool.UseAsFullName {
 		return pool.Name
@@ -177,14 +190,8 @@ func resourceCreatedByPelagia(currentLabels map[string]string) bool {
 
 // CLI utils
 
-type cephConfigOptionDump struct {
-	Section string `json:"section"`
-	Name    string `json:"name"`
-	Value   string `json:"value"`
-}
-
-func (c *cephDeploymentConfig) getCephConfigDump() ([]cephConfigOptionDump, error) {
-	var cephConfigDump []cephConfigOptionDump
+func (c *cephDeploymentConfig) getCephConfigDump() ([]lcmcommon.CephConfigOption, error) {
+	var cephConfigDump []lcmcommon.CephConfigOption
 	err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, "ceph config dump --format json", &cephConfigDump)
 	if err != nil {
 		return nil, err
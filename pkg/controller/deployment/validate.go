@@ -18,6 +18,10 @@ package deployment
 
 import (
 	"fmt"
+	"maps"
+	"math"
+	"net"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -31,7 +35,27 @@ import (
 )
 
 func (c *cephDeploymentConfig) validateSpec() cephlcmv1alpha1.CephDeploymentValidation {
+	errMsgs, warnMsgs := c.getSpecIssues(false)
+	validationResult := cephlcmv1alpha1.CephDeploymentValidation{
+		Result:                  cephlcmv1alpha1.ValidationSucceed,
+		LastValidatedGeneration: c.cdConfig.cephDpl.Generation,
+	}
+	if len(errMsgs) > 0 {
+		validationResult.Result = cephlcmv1alpha1.ValidationFailed
+	}
+	// warnings go after errors to keep them visible for failed validation as well
+	if messages := append(errMsgs, warnMsgs...); len(messages) > 0 {
+		validationResult.Messages = messages
+	}
+	return validationResult
+}
+
+// getSpecIssues runs all CephDeployment spec checks and returns found errors and warnings.
+// For admission requests nodes which are absent in k8s cluster are treated as warnings and
+// rookConfig is verified only against already cached Ceph options schema
+func (c *cephDeploymentConfig) getSpecIssues(admission bool) ([]string, []string) {
 	errMsgs := make([]string, 0)
+	warnMsgs := make([]string, 0)
 	if c.cdConfig.cephDpl.Spec.CSIResources != nil {
 		if errs := validateCSIDrivers(c.cdConfig.cephDpl.Spec.CSIResources.Drivers); len(errs) > 0 {
 			c.log.Error().Msgf("failed to validate CSI drivers spec: %v", errs)
@@ -43,13 +67,31 @@ func (c *cephDeploymentConfig) validateSpec() cephlcmv1alpha1.CephDeploymentVali
 			c.log.Error().Msgf("failed to validate cluster network spec: %v", errs)
 			errMsgs = append(errMsgs, errs...)
 		}
+		validateNodes := true
 		if err := c.validateClusterNodes(); err != nil {
-			c.log.Error().Err(err).Msg("failed to validate provided nodes in cluster")
-			errMsgs = append(errMsgs, err.Error())
-		} else if errs := validateNodesSpec(c.cdConfig.cephDpl, c.cdConfig.nodesListExpanded); len(errs) > 0 {
-			c.log.Error().Msgf("failed to validate nodes spec: %v", errs)
+			if !admission {
+				c.log.Error().Err(err).Msg("failed to validate provided nodes in cluster")
+				errMsgs = append(errMsgs, err.Error())
+				validateNodes = false
+			} else {
+				c.log.Warn().Err(err).Msg("failed to validate provided nodes in cluster")
+				warnMsgs = append(warnMsgs, err.Error())
+			}
+		}
+		if errs := validateCrushSpec(c.cdConfig.cephDpl); len(errs) > 0 {
+			c.log.Error().Msgf("failed to validate crush spec: %v", errs)
 			errMsgs = append(errMsgs, errs...)
 		}
+		if validateNodes {
+			if errs := validateNodesSpec(c.cdConfig.cephDpl, c.cdConfig.nodesListExpanded); len(errs) > 0 {
+				c.log.Error().Msgf("failed to validate nodes spec: %v", errs)
+				errMsgs = append(errMsgs, errs...)
+			}
+			if errs := validateMonitorIPs(c.cdConfig.nodesListExpanded, c.cdConfig.clusterSpec.Network); len(errs) > 0 {
+				c.log.Error().Msgf("failed to validate nodes monitor IPs: %v", errs)
+				errMsgs = append(errMsgs, errs...)
+			}
+		}
 		// TODO: keep rbdmirror as is, requires total rework
 		if err := rbdPeersValidate(c.cdConfig.cephDpl); err != "" {
 			c.log.Error().Msgf("failed to validate rbd mirror spec: %s", err)
@@ -60,6 +102,17 @@ func (c *cephDeploymentConfig) validateSpec() cephlcmv1alpha1.CephDeploymentVali
 		c.log.Error().Msgf("failed to validate block storage pools spec: %v", errs)
 		errMsgs = append(errMsgs, errs...)
 	}
+	if !c.cdConfig.clusterSpec.External.Enable {
+		if issues := c.validatePoolsCapacity(admission); len(issues) > 0 {
+			if c.lcmConfig.DeployParams.PoolsCapacityStrict {
+				c.log.Error().Msgf("failed to validate block storage pools capacity: %v", issues)
+				errMsgs = append(errMsgs, issues...)
+			} else {
+				c.log.Warn().Msgf("block storage pools capacity issues found: %v", issues)
+				warnMsgs = append(warnMsgs, issues...)
+			}
+		}
+	}
 	if errs := validateFilesystemSpec(c.cdConfig.cephDpl, c.cdConfig.nodesListExpanded, c.cdConfig.clusterSpec.External.Enable); len(errs) > 0 {
 		c.log.Error().Msgf("failed to validate shared filesystem spec: %v", errs)
 		errMsgs = append(errMsgs, errs...)
@@ -68,16 +121,23 @@ func (c *cephDeploymentConfig) validateSpec() cephlcmv1alpha1.CephDeploymentVali
 		c.log.Error().Msgf("failed to validate object storage spec: %v", errs)
 		errMsgs = append(errMsgs, errs...)
 	}
-
-	validationResult := cephlcmv1alpha1.CephDeploymentValidation{
-		Result:                  cephlcmv1alpha1.ValidationSucceed,
-		LastValidatedGeneration: c.cdConfig.cephDpl.Generation,
-	}
-	if len(errMsgs) > 0 {
-		validationResult.Result = cephlcmv1alpha1.ValidationFailed
-		validationResult.Messages = errMsgs
+	if !c.cdConfig.clusterSpec.External.Enable {
+		issues, warnings := c.validateRookConfig(admission)
+		if len(issues) > 0 {
+			if c.lcmConfig.DeployParams.RookConfigStrict {
+				c.log.Error().Msgf("failed to validate rookConfig: %v", issues)
+				errMsgs = append(errMsgs, issues...)
+			} else {
+				c.log.Warn().Msgf("rookConfig issues found: %v", issues)
+				warnMsgs = append(warnMsgs, issues...)
+			}
+		}
+		if len(warnings) > 0 {
+			c.log.Warn().Msgf("rookConfig warnings found: %v", warnings)
+			warnMsgs = append(warnMsgs, warnings...)
+		}
 	}
-	return validationResult
+	return errMsgs, warnMsgs
 }
 
 func validateCSIDrivers(drivers []cephlcmv1alpha1.CephCSIDriver) []string {
@@ -104,26 +164,28 @@ func validateNetworkSpec(clusterNetwork cephv1.NetworkSpec) []string {
 		if clusterNetwork.AddressRanges == nil {
 			errMsgs = append(errMsgs, "cluster network addressRanges parameter is not specified")
 		} else {
+			var publicFamilies, clusterFamilies map[cephv1.IPFamilyType]bool
 			if len(clusterNetwork.AddressRanges.Public) == 0 {
 				errMsgs = append(errMsgs, "cluster network addressRanges public parameter not specified")
 			} else {
-				for _, net := range clusterNetwork.AddressRanges.Public {
-					if string(net) == "" || strings.HasPrefix(string(net), "0.0.0.0") {
-						errMsgs = append(errMsgs, "cluster network address ranges public parameter should not be empty or contain range 0.0.0.0")
-						break
-					}
+				var issue string
+				publicFamilies, issue = getAddressRangesFamilies("public", clusterNetwork.AddressRanges.Public)
+				if issue != "" {
+					errMsgs = append(errMsgs, issue)
 				}
 			}
 			if len(clusterNetwork.AddressRanges.Cluster) == 0 {
 				errMsgs = append(errMsgs, "cluster network addressRanges cluster parameter not specified")
 			} else {
-				for _, net := range clusterNetwork.AddressRanges.Cluster {
-					if string(net) == "" || strings.HasPrefix(string(net), "0.0.0.0") {
-						errMsgs = append(errMsgs, "cluster network address ranges cluster parameter should not be empty or contain range 0.0.0.0")
-						break
-					}
+				var issue string
+				clusterFamilies, issue = getAddressRangesFamilies("cluster", clusterNetwork.AddressRanges.Cluster)
+				if issue != "" {
+					errMsgs = append(errMsgs, issue)
 				}
 			}
+			if len(errMsgs) == 0 {
+				errMsgs = append(errMsgs, validateIPFamilies(clusterNetwork, publicFamilies, clusterFamilies)...)
+			}
 		}
 		if clusterNetwork.Provider == "multus" {
 			if clusterNetwork.Selectors[cephv1.CephNetworkPublic] == "" || clusterNetwork.Selectors[cephv1.CephNetworkCluster] == "" {
@@ -136,6 +198,81 @@ func validateNetworkSpec(clusterNetwork cephv1.NetworkSpec) []string {
 	return errMsgs
 }
 
+// getAddressRangesFamilies returns IP families of provided address ranges or issue, if any range is invalid
+func getAddressRangesFamilies(rangesType string, ranges []cephv1.CIDR) (map[cephv1.IPFamilyType]bool, string) {
+	families := map[cephv1.IPFamilyType]bool{}
+	for _, cidr := range ranges {
+		_, ipNet, err := net.ParseCIDR(string(cidr))
+		if string(cidr) == "" || strings.HasPrefix(string(cidr), "0.0.0.0") || (err == nil && ipNet.IP.IsUnspecified()) {
+			return nil, fmt.Sprintf("cluster network address ranges %s parameter should not be empty or contain unspecified range 0.0.0.0 or ::", rangesType)
+		}
+		if err != nil {
+			return nil, fmt.Sprintf("cluster network address ranges %s parameter contains invalid CIDR '%s'", rangesType, cidr)
+		}
+		families[getIPFamily(ipNet.IP)] = true
+	}
+	return families, ""
+}
+
+func getIPFamily(ip net.IP) cephv1.IPFamilyType {
+	if ip.To4() != nil {
+		return cephv1.IPv4
+	}
+	return cephv1.IPv6
+}
+
+// validateIPFamilies checks address ranges families are consistent with ipFamily and dualStack parameters
+func validateIPFamilies(clusterNetwork cephv1.NetworkSpec, publicFamilies, clusterFamilies map[cephv1.IPFamilyType]bool) []string {
+	errMsgs := []string{}
+	if clusterNetwork.DualStack {
+		if !publicFamilies[cephv1.IPv4] || !publicFamilies[cephv1.IPv6] {
+			errMsgs = append(errMsgs, "cluster network dualStack requires both IPv4 and IPv6 ranges in address ranges public parameter")
+		}
+		return errMsgs
+	}
+	ipFamily := clusterNetwork.IPFamily
+	if ipFamily == "" {
+		ipFamily = cephv1.IPv4
+	}
+	for _, families := range []map[cephv1.IPFamilyType]bool{publicFamilies, clusterFamilies} {
+		if len(families) > 1 {
+			return append(errMsgs, "cluster network address ranges contain both IPv4 and IPv6 ranges, while dualStack is not enabled")
+		}
+		for family := range families {
+			if family != ipFamily {
+				return append(errMsgs, fmt.Sprintf("cluster network address ranges contain %s ranges, while ipFamily is '%s'", family, ipFamily))
+			}
+		}
+	}
+	return errMsgs
+}
+
+// validateMonitorIPs checks nodes monitor IPs are valid and match cluster network IP family
+func validateMonitorIPs(nodesListExpanded []cephlcmv1alpha1.CephDeploymentNode, clusterNetwork cephv1.NetworkSpec) []string {
+	errMsgs := []string{}
+	for _, node := range nodesListExpanded {
+		if node.MonitorIP == "" {
+			continue
+		}
+		ip := net.ParseIP(node.MonitorIP)
+		if ip == nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("node '%s' has invalid monitorIP '%s'", node.Name, node.MonitorIP))
+			continue
+		}
+		if clusterNetwork.DualStack {
+			continue
+		}
+		ipFamily := clusterNetwork.IPFamily
+		if ipFamily == "" {
+			ipFamily = cephv1.IPv4
+		}
+		if family := getIPFamily(ip); family != ipFamily {
+			errMsgs = append(errMsgs, fmt.Sprintf("node '%s' has %s monitorIP '%s', while cluster network ipFamily is '%s'", node.Name, family, node.MonitorIP, ipFamily))
+		}
+	}
+	return errMsgs
+}
+
 func (c *cephDeploymentConfig) validateClusterNodes() error {
 	unknownNodes := make([]string, 0)
 	allNodes, err := lcmcommon.GetNodeList(c.context, c.api.Kubeclientset, metav1.ListOptions{})
@@ -159,7 +296,9 @@ func (c *cephDeploymentConfig) validateClusterNodes() error {
 
 func validateNodesSpec(cephDpl *cephlcmv1alpha1.CephDeployment, nodesListExpanded []cephlcmv1alpha1.CephDeploymentNode) []string {
 	errMsgs := []string{}
-	validCrushKeys := strings.Join(getCrushKeys(), ", ")
+	crushKeys := append(getCrushKeys(), crushRootKey)
+	sort.Strings(crushKeys)
+	validCrushKeys := strings.Join(crushKeys, ", ")
 	for _, node := range cephDpl.Spec.Nodes {
 		nodeType := "node"
 		if node.NodesByLabel != "" || len(node.NodeGroup) > 0 {
@@ -178,7 +317,16 @@ func validateNodesSpec(cephDpl *cephlcmv1alpha1.CephDeployment, nodesListExpande
 			continue
 		}
 		// check node crush topology
-		for crush := range node.Crush {
+		for crush, value := range node.Crush {
+			if crush == crushRootKey {
+				if !isCrushRootDeclared(cephDpl.Spec.Crush, value) {
+					errMsgs = append(errMsgs, fmt.Sprintf("nodes item %s '%s' uses crush root '%s', which is not specified in crush roots", nodeType, node.Name, value))
+				}
+				if node.Config != nil && node.Config["crushRoot"] != "" && node.Config["crushRoot"] != value {
+					errMsgs = append(errMsgs, fmt.Sprintf("nodes item %s '%s' has crush root '%s' conflicting with config crushRoot '%s'", nodeType, node.Name, value, node.Config["crushRoot"]))
+				}
+				continue
+			}
 			if _, ok := crushTopologyAllowedKeys[crush]; !ok {
 				err := fmt.Sprintf("nodes item %s '%s' contains invalid crush topology key '%s'. Valid are: %v", nodeType, node.Name, crush, validCrushKeys)
 				errMsgs = append(errMsgs, err)
@@ -284,9 +432,28 @@ func validatePoolsSpec(cephDpl *cephlcmv1alpha1.CephDeployment, nodesListExpande
 			continue
 		}
 
-		if poolErrs := validatePoolSpec(castedPool, false, cephDplPool.Name, nodesListExpanded); len(poolErrs) > 0 {
+		poolNodes := nodesListExpanded
+		if cephDplPool.CrushRule != "" {
+			rule := getCrushRule(cephDpl.Spec.Crush, cephDplPool.CrushRule)
+			if rule == nil {
+				errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' references crush rule '%s', which is not specified in crush rules", cephDplPool.Name, cephDplPool.CrushRule))
+			} else {
+				if errs := validatePoolCrushRule(castedPool, cephDplPool.Name, *rule); len(errs) > 0 {
+					errMsgs = append(errMsgs, errs...)
+				}
+				if rule.FailureDomain != "" {
+					castedPool.FailureDomain = rule.FailureDomain
+				}
+				// device class should be present among nodes of rule crush root
+				poolNodes = getCrushRootNodes(nodesListExpanded, rule.Root)
+			}
+		}
+		if poolErrs := validatePoolSpec(castedPool, false, cephDplPool.Name, poolNodes); len(poolErrs) > 0 {
 			errMsgs = append(errMsgs, poolErrs...)
 		}
+		if errs := validatePoolCapacitySpec(cephDplPool, castedPool); len(errs) > 0 {
+			errMsgs = append(errMsgs, errs...)
+		}
 		if cephDplPool.StorageClassOpts.ReclaimPolicy != "" && !lcmcommon.Contains(poolReclaimPolicies, cephDplPool.StorageClassOpts.ReclaimPolicy) {
 			errMsgs = append(errMsgs, fmt.Sprintf("pool %s contains invalid reclaimPolicy '%s', valid are: %v",
 				cephDplPool.Name, cephDplPool.StorageClassOpts.ReclaimPolicy, poolReclaimPolicies))
@@ -303,6 +470,210 @@ func validatePoolsSpec(cephDpl *cephlcmv1alpha1.CephDeployment, nodesListExpande
 	return errMsgs
 }
 
+// validatePoolCapacitySpec verifies pool quota and target size fields
+func validatePoolCapacitySpec(pool cephlcmv1alpha1.CephPool, spec cephv1.PoolSpec) []string {
+	errMsgs := []string{}
+	if pool.Quota != nil {
+		if pool.Quota.MaxBytes != "" {
+			if _, err := getQuantityBytes(pool.Quota.MaxBytes); err != nil {
+				errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has incorrect quota maxBytes '%s', expected positive quantity", pool.Name, pool.Quota.MaxBytes))
+			}
+		}
+		if spec.Quotas.MaxBytes != nil || spec.Quotas.MaxSize != nil || spec.Quotas.MaxObjects != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has both quota and spec quotas specified, use only one of them", pool.Name))
+		}
+	}
+	if pool.TargetSizeRatio == "" && pool.TargetSizeBytes == "" {
+		return errMsgs
+	}
+	if pool.TargetSizeRatio != "" && pool.TargetSizeBytes != "" {
+		errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has both targetSizeRatio and targetSizeBytes specified, use only one of them", pool.Name))
+	}
+	if pool.TargetSizeRatio != "" {
+		if ratio, err := strconv.ParseFloat(pool.TargetSizeRatio, 64); err != nil || ratio <= 0 {
+			errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has incorrect targetSizeRatio '%s', expected positive number", pool.Name, pool.TargetSizeRatio))
+		}
+	}
+	if pool.TargetSizeBytes != "" {
+		if _, err := getQuantityBytes(pool.TargetSizeBytes); err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has incorrect targetSizeBytes '%s', expected positive quantity", pool.Name, pool.TargetSizeBytes))
+		}
+	}
+	_, ratioParamSet := spec.Parameters[poolTargetSizeRatioParam]
+	_, bytesParamSet := spec.Parameters[poolTargetSizeBytesParam]
+	if spec.Replicated.TargetSizeRatio > 0 || ratioParamSet || bytesParamSet {
+		errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has target size specified both in pool and in pool spec, use only one of them", pool.Name))
+	}
+	return errMsgs
+}
+
+// validatePoolsCapacity verifies that pools target size ratios do not exceed device class
+// capacity and, for non admission requests, that raw capacity required by pools target sizes
+// fits device classes capacity, reported in CephDeploymentHealth usage details
+func (c *cephDeploymentConfig) validatePoolsCapacity(admission bool) []string {
+	if c.cdConfig.cephDpl.Spec.BlockStorage == nil {
+		return nil
+	}
+	requiredBytes := map[string]float64{}
+	classPools := map[string][]string{}
+	ratios := map[string]float64{}
+	ratioPools := map[string][]string{}
+	for _, pool := range c.cdConfig.cephDpl.Spec.BlockStorage.Pools {
+		spec, _ := pool.GetSpec()
+		if pool.TargetSizeRatio != "" || spec.Replicated.TargetSizeRatio > 0 {
+			ratio := spec.Replicated.TargetSizeRatio
+			if pool.TargetSizeRatio != "" {
+				// incorrect ratio is reported by pools spec validation
+				ratio, _ = strconv.ParseFloat(pool.TargetSizeRatio, 64)
+			}
+			if ratio > 0 {
+				ratios[spec.DeviceClass] += ratio
+				ratioPools[spec.DeviceClass] = append(ratioPools[spec.DeviceClass], pool.Name)
+			}
+		}
+		if pool.TargetSizeBytes == "" {
+			continue
+		}
+		targetBytes, err := getQuantityBytes(pool.TargetSizeBytes)
+		if err != nil {
+			// reported by pools spec validation
+			continue
+		}
+		requiredBytes[spec.DeviceClass] += float64(targetBytes) * getPoolReplicationFactor(spec)
+		classPools[spec.DeviceClass] = append(classPools[spec.DeviceClass], pool.Name)
+	}
+	issues := []string{}
+	for _, class := range slices.Sorted(maps.Keys(ratios)) {
+		if ratios[class] > 1.0 {
+			issues = append(issues, fmt.Sprintf("pools %v target size ratios sum %s for device class '%s' is greater than 1.0",
+				ratioPools[class], strconv.FormatFloat(ratios[class], 'f', -1, 64), class))
+		}
+	}
+	// capacity from health report is verified only during reconcile, since it
+	// requires api call and health report may be outdated on admission
+	if admission || len(requiredBytes) == 0 {
+		return issues
+	}
+	health, err := c.api.CephLcmclientset.LcmV1alpha1().CephDeploymentHealths(c.cdConfig.cephDpl.Namespace).Get(c.context, c.cdConfig.cephDpl.Name, metav1.GetOptions{})
+	if err != nil {
+		c.log.Warn().Err(err).Msg("failed to get CephDeploymentHealth, pools target sizes are not verified against cluster capacity")
+		return issues
+	}
+	report := health.Status.HealthReport
+	if report == nil || report.ClusterDetails == nil || report.ClusterDetails.UsageDetails == nil {
+		c.log.Debug().Msg("no usage details found in CephDeploymentHealth, pools target sizes are not verified against cluster capacity")
+		return issues
+	}
+	for _, class := range slices.Sorted(maps.Keys(requiredBytes)) {
+		stats, present := report.ClusterDetails.UsageDetails.ClassesDetail[class]
+		if !present {
+			continue
+		}
+		totalBytes, err := strconv.ParseUint(stats.TotalBytes, 10, 64)
+		if err != nil || totalBytes == 0 {
+			continue
+		}
+		if requiredBytes[class] > float64(totalBytes) {
+			issues = append(issues, fmt.Sprintf("pools %v target sizes require %d bytes of raw capacity for device class '%s', while device class capacity is %d bytes",
+				classPools[class], uint64(math.Ceil(requiredBytes[class])), class, totalBytes))
+		}
+	}
+	return issues
+}
+
+// validateCrushSpec verifies crush roots and rules declared in CephDeployment
+func validateCrushSpec(cephDpl *cephlcmv1alpha1.CephDeployment) []string {
+	crush := cephDpl.Spec.Crush
+	if crush == nil {
+		return nil
+	}
+	errMsgs := []string{}
+	// crush bucket names are unique over the whole crush map, so roots
+	// should not be named as hosts or topology buckets
+	bucketNames := map[string]bool{}
+	for _, node := range cephDpl.Spec.Nodes {
+		bucketNames[node.Name] = true
+		for key, value := range node.Crush {
+			if key != crushRootKey {
+				bucketNames[value] = true
+			}
+		}
+	}
+	roots := map[string]bool{}
+	for _, root := range crush.Roots {
+		if root == "" {
+			errMsgs = append(errMsgs, "crush roots contain empty root name")
+			continue
+		}
+		if roots[root] {
+			errMsgs = append(errMsgs, fmt.Sprintf("crush root '%s' is specified more than once", root))
+		}
+		if bucketNames[root] {
+			errMsgs = append(errMsgs, fmt.Sprintf("crush root '%s' has the same name as node or crush topology bucket", root))
+		}
+		roots[root] = true
+	}
+	validFailureDomains := append([]string{"osd", "host"}, getCrushKeys()...)
+	rules := map[string]bool{}
+	for _, rule := range crush.Rules {
+		if rule.Name == "" {
+			errMsgs = append(errMsgs, "crush rules contain rule with empty name")
+			continue
+		}
+		if rules[rule.Name] {
+			errMsgs = append(errMsgs, fmt.Sprintf("crush rule '%s' is specified more than once", rule.Name))
+		}
+		rules[rule.Name] = true
+		if !isCrushRootDeclared(crush, rule.Root) {
+			errMsgs = append(errMsgs, fmt.Sprintf("crush rule '%s' uses crush root '%s', which is not specified in crush roots", rule.Name, rule.Root))
+		}
+		if rule.FailureDomain != "" && !lcmcommon.Contains(validFailureDomains, rule.FailureDomain) {
+			errMsgs = append(errMsgs, fmt.Sprintf("crush rule '%s' has invalid failure domain '%s', valid are: %v", rule.Name, rule.FailureDomain, validFailureDomains))
+		}
+	}
+	return errMsgs
+}
+
+// validatePoolCrushRule verifies pool spec does not conflict with crush rule pool references
+func validatePoolCrushRule(spec cephv1.PoolSpec, poolName string, rule cephlcmv1alpha1.CephCrushRule) []string {
+	errMsgs := []string{}
+	if rule.DeviceClass != "" && spec.DeviceClass != rule.DeviceClass {
+		errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has device class '%s', while crush rule '%s' requires '%s'", poolName, spec.DeviceClass, rule.Name, rule.DeviceClass))
+	}
+	if rule.Root != "" && spec.CrushRoot != "" && spec.CrushRoot != rule.Root {
+		errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has crushRoot '%s' conflicting with crush rule '%s' root '%s'", poolName, spec.CrushRoot, rule.Name, rule.Root))
+	}
+	if rule.FailureDomain != "" && spec.FailureDomain != "" && spec.FailureDomain != rule.FailureDomain {
+		errMsgs = append(errMsgs, fmt.Sprintf("pool '%s' has failureDomain '%s' conflicting with crush rule '%s' failure domain '%s'", poolName, spec.FailureDomain, rule.Name, rule.FailureDomain))
+	}
+	return errMsgs
+}
+
+func isCrushRootDeclared(crush *cephlcmv1alpha1.CephDeploymentCrush, root string) bool {
+	if root == "" || root == defaultCrushRoot {
+		return true
+	}
+	return crush != nil && lcmcommon.Contains(crush.Roots, root)
+}
+
+// getCrushRootNodes returns nodes placed to specified crush root
+func getCrushRootNodes(nodesListExpanded []cephlcmv1alpha1.CephDeploymentNode, root string) []cephlcmv1alpha1.CephDeploymentNode {
+	if root == "" {
+		root = defaultCrushRoot
+	}
+	nodes := []cephlcmv1alpha1.CephDeploymentNode{}
+	for _, node := range nodesListExpanded {
+		nodeRoot := node.Crush[crushRootKey]
+		if nodeRoot == "" {
+			nodeRoot = defaultCrushRoot
+		}
+		if nodeRoot == root {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
 func openstackPoolsValidate(specPools []cephlcmv1alpha1.CephPool) []string {
 	openstackRoles := map[string]int{
 		"images":  0,
@@ -552,6 +923,17 @@ func validatePoolSpec(spec cephv1.PoolSpec, metapool bool, poolName string, node
 
 	if err := validateDeviceClass(spec.DeviceClass, nodesListExpanded); err != nil {
 		issues = append(issues, fmt.Sprintf("%s pool has %s", poolName, err.Error()))
+	} else if spec.ErasureCoded.DataChunks > 0 && spec.ErasureCoded.CodingChunks > 0 {
+		// each data and coding chunk is placed to separate failure domain
+		chunks := int(spec.ErasureCoded.DataChunks + spec.ErasureCoded.CodingChunks)
+		failureDomain := spec.FailureDomain
+		if failureDomain == "" {
+			failureDomain = "host"
+		}
+		if domains, ok := countFailureDomains(nodesListExpanded, spec.DeviceClass, failureDomain); ok && domains < chunks {
+			issues = append(issues, fmt.Sprintf("erasureCoded %s pool requires %d failure domains '%s' with deviceClass '%s' for dataChunks and codingChunks, but found only %d in nodes spec",
+				poolName, chunks, failureDomain, spec.DeviceClass, domains))
+		}
 	}
 	if spec.FailureDomain == "osd" && len(nodesListExpanded) != 1 {
 		issues = append(issues, fmt.Sprintf("%s pool contains prohibited 'osd' failureDomain", poolName))
@@ -559,6 +941,137 @@ func validatePoolSpec(spec cephv1.PoolSpec, metapool bool, poolName string, node
 	return issues
 }
 
+// countFailureDomains counts failure domains of specified type, which contain storage nodes with specified device class.
+// Returns false if failure domains can not be counted by nodes spec, for example, for 'osd' failure domain.
+func countFailureDomains(nodesListExpanded []cephlcmv1alpha1.CephDeploymentNode, deviceClass, failureDomain string) (int, bool) {
+	if failureDomain != "host" {
+		if _, ok := crushTopologyAllowedKeys[failureDomain]; !ok {
+			return 0, false
+		}
+	}
+	domains := map[string]bool{}
+	for _, node := range nodesListExpanded {
+		if !nodeHasDeviceClass(node, deviceClass) {
+			continue
+		}
+		if failureDomain == "host" {
+			domains[node.Name] = true
+		} else if node.Crush[failureDomain] != "" {
+			domains[node.Crush[failureDomain]] = true
+		}
+	}
+	return len(domains), true
+}
+
+// nodeHasDeviceClass checks whether storage node has devices with specified device class
+func nodeHasDeviceClass(node cephlcmv1alpha1.CephDeploymentNode, deviceClass string) bool {
+	if !lcmcommon.IsCephOsdNode(node.Node) {
+		return false
+	}
+	nodeClass := ""
+	if node.Config != nil {
+		nodeClass = node.Config["deviceClass"]
+	}
+	if len(node.Devices) == 0 {
+		return nodeClass == deviceClass
+	}
+	for _, device := range node.Devices {
+		class := nodeClass
+		if device.Config != nil && device.Config["deviceClass"] != "" {
+			class = device.Config["deviceClass"]
+		}
+		if class == deviceClass {
+			return true
+		}
+	}
+	return false
+}
+
+// validateErasureCodedPoolUpdate verifies that pool type and erasure coded profile are not changed
+// for existing pool, since Ceph does not allow to change them for already created pool
+func validateErasureCodedPoolUpdate(poolName string, present, desired cephv1.PoolSpec) error {
+	poolType := func(spec cephv1.PoolSpec) string {
+		if spec.ErasureCoded.DataChunks > 0 || spec.ErasureCoded.CodingChunks > 0 {
+			return "erasureCoded"
+		}
+		if spec.Replicated.Size > 0 {
+			return "replicated"
+		}
+		return ""
+	}
+	presentType := poolType(present)
+	desiredType := poolType(desired)
+	// pool spec is not set, nothing to compare
+	if presentType == "" || desiredType == "" {
+		return nil
+	}
+	if presentType != desiredType {
+		return errors.Errorf("%s pool type can't be changed from %s to %s, create a new pool and migrate data instead", poolName, presentType, desiredType)
+	}
+	if presentType == "erasureCoded" && (present.ErasureCoded.DataChunks != desired.ErasureCoded.DataChunks ||
+		present.ErasureCoded.CodingChunks != desired.ErasureCoded.CodingChunks || present.ErasureCoded.Algorithm != desired.ErasureCoded.Algorithm) {
+		return errors.Errorf("%s pool erasure coded profile can't be changed from dataChunks=%d, codingChunks=%d to dataChunks=%d, codingChunks=%d, create a new pool and migrate data instead",
+			poolName, present.ErasureCoded.DataChunks, present.ErasureCoded.CodingChunks, desired.ErasureCoded.DataChunks, desired.ErasureCoded.CodingChunks)
+	}
+	return nil
+}
+
+// getErasureCodedUpdateIssues compares pools from current and updated CephDeployment specs
+// and returns issues for pools with changed type or erasure coded profile
+func getErasureCodedUpdateIssues(oldCephDpl, newCephDpl *cephlcmv1alpha1.CephDeployment) []string {
+	oldPools := getDataPoolsSpecs(oldCephDpl)
+	newPools := getDataPoolsSpecs(newCephDpl)
+	poolNames := make([]string, 0, len(newPools))
+	for name := range newPools {
+		poolNames = append(poolNames, name)
+	}
+	sort.Strings(poolNames)
+	issues := []string{}
+	for _, name := range poolNames {
+		if oldSpec, present := oldPools[name]; present {
+			if err := validateErasureCodedPoolUpdate(name, oldSpec, newPools[name]); err != nil {
+				issues = append(issues, err.Error())
+			}
+		}
+	}
+	return issues
+}
+
+// getDataPoolsSpecs returns block storage, CephFS and RGW data pools specs from CephDeployment
+// keyed by pool description
+func getDataPoolsSpecs(cephDpl *cephlcmv1alpha1.CephDeployment) map[string]cephv1.PoolSpec {
+	pools := map[string]cephv1.PoolSpec{}
+	if cephDpl.Spec.BlockStorage != nil {
+		for _, pool := range cephDpl.Spec.BlockStorage.Pools {
+			if spec, err := pool.GetSpec(); err == nil {
+				pools[fmt.Sprintf("block storage '%s'", buildPoolName(pool))] = spec
+			}
+		}
+	}
+	if cephDpl.Spec.SharedFilesystem != nil {
+		for _, cephFS := range cephDpl.Spec.SharedFilesystem.Filesystems {
+			if spec, err := cephFS.GetSpec(); err == nil {
+				for _, dataPool := range spec.DataPools {
+					pools[fmt.Sprintf("cephfs '%s' data %s", cephFS.Name, dataPool.Name)] = dataPool.PoolSpec
+				}
+			}
+		}
+	}
+	if cephDpl.Spec.ObjectStorage != nil {
+		for _, zone := range cephDpl.Spec.ObjectStorage.Zones {
+			if spec, err := zone.GetSpec(); err == nil {
+				pools[fmt.Sprintf("zone '%s' data", zone.Name)] = spec.DataPool
+			}
+		}
+		for _, rgw := range cephDpl.Spec.ObjectStorage.Rgws {
+			if spec, err := rgw.GetSpec(); err == nil {
+				pools[fmt.Sprintf("rgw '%s' data", rgw.Name)] = spec.DataPool
+			}
+		}
+	}
+	return pools
+}
+
 func validateDeviceClass(deviceClass string, nodesListExpanded []cephlcmv1alpha1.CephDeploymentNode) error {
 	if deviceClass == "" {
 		return errors.Errorf("no deviceClass set")
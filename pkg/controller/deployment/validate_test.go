@@ -98,8 +98,8 @@ func TestValidateNetworkSpec(t *testing.T) {
 				},
 			},
 			expectedIssues: []string{
-				"cluster network address ranges public parameter should not be empty or contain range 0.0.0.0",
-				"cluster network address ranges cluster parameter should not be empty or contain range 0.0.0.0",
+				"cluster network address ranges public parameter should not be empty or contain unspecified range 0.0.0.0 or ::",
+				"cluster network address ranges cluster parameter should not be empty or contain unspecified range 0.0.0.0 or ::",
 			},
 		},
 		{
@@ -111,10 +111,89 @@ func TestValidateNetworkSpec(t *testing.T) {
 				},
 			},
 			expectedIssues: []string{
-				"cluster network address ranges public parameter should not be empty or contain range 0.0.0.0",
-				"cluster network address ranges cluster parameter should not be empty or contain range 0.0.0.0",
+				"cluster network address ranges public parameter should not be empty or contain unspecified range 0.0.0.0 or ::",
+				"cluster network address ranges cluster parameter should not be empty or contain unspecified range 0.0.0.0 or ::",
 			},
 		},
+		{
+			name: ":: ranges provided",
+			networkSpec: cephv1.NetworkSpec{
+				IPFamily: cephv1.IPv6,
+				AddressRanges: &cephv1.AddressRangesSpec{
+					Public:  []cephv1.CIDR{cephv1.CIDR("::/0")},
+					Cluster: []cephv1.CIDR{cephv1.CIDR("fd00:10::/64")},
+				},
+			},
+			expectedIssues: []string{
+				"cluster network address ranges public parameter should not be empty or contain unspecified range 0.0.0.0 or ::",
+			},
+		},
+		{
+			name: "invalid ranges provided",
+			networkSpec: cephv1.NetworkSpec{
+				AddressRanges: &cephv1.AddressRangesSpec{
+					Public:  []cephv1.CIDR{cephv1.CIDR("10.0.0.0/16"), cephv1.CIDR("10.0.0.300/16")},
+					Cluster: []cephv1.CIDR{cephv1.CIDR("fd00:10::1")},
+				},
+			},
+			expectedIssues: []string{
+				"cluster network address ranges public parameter contains invalid CIDR '10.0.0.300/16'",
+				"cluster network address ranges cluster parameter contains invalid CIDR 'fd00:10::1'",
+			},
+		},
+		{
+			name: "ipv6 ranges provided without ipFamily",
+			networkSpec: cephv1.NetworkSpec{
+				AddressRanges: &cephv1.AddressRangesSpec{
+					Public:  []cephv1.CIDR{cephv1.CIDR("fd00:10::/64")},
+					Cluster: []cephv1.CIDR{cephv1.CIDR("fd00:20::/64")},
+				},
+			},
+			expectedIssues: []string{"cluster network address ranges contain IPv6 ranges, while ipFamily is 'IPv4'"},
+		},
+		{
+			name: "ipv4 and ipv6 ranges provided without dualStack",
+			networkSpec: cephv1.NetworkSpec{
+				AddressRanges: &cephv1.AddressRangesSpec{
+					Public:  []cephv1.CIDR{cephv1.CIDR("10.0.0.0/16"), cephv1.CIDR("fd00:10::/64")},
+					Cluster: []cephv1.CIDR{cephv1.CIDR("10.1.0.0/16")},
+				},
+			},
+			expectedIssues: []string{"cluster network address ranges contain both IPv4 and IPv6 ranges, while dualStack is not enabled"},
+		},
+		{
+			name: "dualStack without ipv6 public range",
+			networkSpec: cephv1.NetworkSpec{
+				DualStack: true,
+				AddressRanges: &cephv1.AddressRangesSpec{
+					Public:  []cephv1.CIDR{cephv1.CIDR("10.0.0.0/16")},
+					Cluster: []cephv1.CIDR{cephv1.CIDR("10.1.0.0/16"), cephv1.CIDR("fd00:20::/64")},
+				},
+			},
+			expectedIssues: []string{"cluster network dualStack requires both IPv4 and IPv6 ranges in address ranges public parameter"},
+		},
+		{
+			name: "ipv6 network spec ok",
+			networkSpec: cephv1.NetworkSpec{
+				IPFamily: cephv1.IPv6,
+				AddressRanges: &cephv1.AddressRangesSpec{
+					Public:  []cephv1.CIDR{cephv1.CIDR("fd00:10::/64")},
+					Cluster: []cephv1.CIDR{cephv1.CIDR("fd00:20::/64")},
+				},
+			},
+			expectedIssues: []string{},
+		},
+		{
+			name: "dualStack network spec ok",
+			networkSpec: cephv1.NetworkSpec{
+				DualStack: true,
+				AddressRanges: &cephv1.AddressRangesSpec{
+					Public:  []cephv1.CIDR{cephv1.CIDR("10.0.0.0/16"), cephv1.CIDR("fd00:10::/64")},
+					Cluster: []cephv1.CIDR{cephv1.CIDR("fd00:20::/64")},
+				},
+			},
+			expectedIssues: []string{},
+		},
 		{
 			name: "multus network selector is not provided",
 			networkSpec: cephv1.NetworkSpec{
@@ -160,6 +239,46 @@ func TestValidateNetworkSpec(t *testing.T) {
 	}
 }
 
+func TestValidateMonitorIPs(t *testing.T) {
+	nodes := []cephlcmv1alpha1.CephDeploymentNode{
+		{Node: cephv1.Node{Name: "node-1"}, MonitorIP: "10.0.0.10"},
+		{Node: cephv1.Node{Name: "node-2"}, MonitorIP: "fd00:10::10"},
+		{Node: cephv1.Node{Name: "node-3"}, MonitorIP: "10.0.0.300"},
+		{Node: cephv1.Node{Name: "node-4"}},
+	}
+	tests := []struct {
+		name           string
+		networkSpec    cephv1.NetworkSpec
+		expectedIssues []string
+	}{
+		{
+			name: "ipv4 network",
+			expectedIssues: []string{
+				"node 'node-2' has IPv6 monitorIP 'fd00:10::10', while cluster network ipFamily is 'IPv4'",
+				"node 'node-3' has invalid monitorIP '10.0.0.300'",
+			},
+		},
+		{
+			name:        "ipv6 network",
+			networkSpec: cephv1.NetworkSpec{IPFamily: cephv1.IPv6},
+			expectedIssues: []string{
+				"node 'node-1' has IPv4 monitorIP '10.0.0.10', while cluster network ipFamily is 'IPv6'",
+				"node 'node-3' has invalid monitorIP '10.0.0.300'",
+			},
+		},
+		{
+			name:           "dualStack network",
+			networkSpec:    cephv1.NetworkSpec{DualStack: true},
+			expectedIssues: []string{"node 'node-3' has invalid monitorIP '10.0.0.300'"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedIssues, validateMonitorIPs(nodes, test.networkSpec))
+		})
+	}
+}
+
 func TestValidateClusterNodes(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -266,7 +385,7 @@ func TestValidateNodesSpec(t *testing.T) {
 			expectedIssues: []string{
 				"found 'useAllDevices' field for nodes item node 'node-1', which is not supported, remove field",
 				"found 'volumeClaimTemplates' field for nodes item node 'node-2', which is not supported, remove field",
-				"nodes item nodeGroup 'node-group-1' contains invalid crush topology key 'fake'. Valid are: chassis, datacenter, pdu, rack, region, room, row, zone",
+				"nodes item nodeGroup 'node-group-1' contains invalid crush topology key 'fake'. Valid are: chassis, datacenter, pdu, rack, region, room, root, row, zone",
 				"failed to parse config parameter 'osdsPerDevice' from nodes item node 'node-6': strconv.Atoi: parsing \"aas\": invalid syntax",
 				"config parameter 'deviceClass' is not specified for nodes item node 'node-7', but it is required",
 				"no nodes with 'mon' roles specified",
@@ -396,6 +515,46 @@ func TestValidateNodesSpec(t *testing.T) {
 			},
 			expectedIssues: []string{},
 		},
+		{
+			name: "validate nodes crush roots",
+			cephDpl: &cephlcmv1alpha1.CephDeployment{
+				Spec: cephlcmv1alpha1.CephDeploymentSpec{
+					Cluster: unitinputs.BaseCephDeployment.Spec.Cluster.DeepCopy(),
+					Crush:   &cephlcmv1alpha1.CephDeploymentCrush{Roots: []string{"nvme"}},
+					Nodes: []cephlcmv1alpha1.CephDeploymentNode{
+						{
+							Node: cephv1.Node{
+								Name:      "node-1",
+								Selection: cephv1.Selection{DeviceFilter: "vdf"},
+								Config:    map[string]string{"deviceClass": "nvme"},
+							},
+							Crush: map[string]string{"root": "nvme"},
+							Roles: []string{"mon", "mgr"},
+						},
+						{
+							Node: cephv1.Node{
+								Name:      "node-2",
+								Selection: cephv1.Selection{DeviceFilter: "vdf"},
+								Config:    map[string]string{"deviceClass": "ssd"},
+							},
+							Crush: map[string]string{"root": "ssd"},
+						},
+						{
+							Node: cephv1.Node{
+								Name:      "node-3",
+								Selection: cephv1.Selection{DeviceFilter: "vdf"},
+								Config:    map[string]string{"deviceClass": "nvme", "crushRoot": "other"},
+							},
+							Crush: map[string]string{"root": "nvme"},
+						},
+					},
+				},
+			},
+			expectedIssues: []string{
+				"nodes item node 'node-2' uses crush root 'ssd', which is not specified in crush roots",
+				"nodes item node 'node-3' has crush root 'nvme' conflicting with config crushRoot 'other'",
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -531,6 +690,50 @@ func TestValidatePoolsSpec(t *testing.T) {
 			externalCluster: true,
 			expectedIssues:  []string{"pool 'pool-1' has no device class specified"},
 		},
+		{
+			name: "incorrect pools crush rules",
+			cephDpl: &cephlcmv1alpha1.CephDeployment{
+				Spec: cephlcmv1alpha1.CephDeploymentSpec{
+					Cluster: &cephlcmv1alpha1.CephCluster{
+						RawExtension: runtime.RawExtension{Raw: []byte(`{}`)},
+					},
+					Crush: &cephlcmv1alpha1.CephDeploymentCrush{
+						Roots: []string{"nvme"},
+						Rules: []cephlcmv1alpha1.CephCrushRule{
+							{Name: "hdd-rack", FailureDomain: "rack"},
+							{Name: "nvme-host", Root: "nvme", DeviceClass: "nvme"},
+						},
+					},
+					BlockStorage: &cephlcmv1alpha1.CephBlockStorage{
+						Pools: []cephlcmv1alpha1.CephPool{
+							{
+								Name:             "pool-1",
+								CrushRule:        "hdd-rack",
+								StorageClassOpts: cephlcmv1alpha1.CephStorageClassSpec{Default: true},
+								PoolSpec:         unitinputs.CephDeployPoolReplicated.PoolSpec,
+							},
+							{
+								Name:      "pool-2",
+								CrushRule: "nvme-host",
+								PoolSpec:  unitinputs.CephDeployPoolReplicated.PoolSpec,
+							},
+							{
+								Name:      "pool-3",
+								CrushRule: "unknown",
+								PoolSpec:  unitinputs.CephDeployPoolReplicated.PoolSpec,
+							},
+						},
+					},
+					Nodes: unitinputs.CephNodesOk,
+				},
+			},
+			expectedIssues: []string{
+				"pool 'pool-1' has failureDomain 'host' conflicting with crush rule 'hdd-rack' failure domain 'rack'",
+				"pool 'pool-2' has device class 'hdd', while crush rule 'nvme-host' requires 'nvme'",
+				"pool-2 pool has failed to find deviceClass 'hdd' among storage devices spec",
+				"pool 'pool-3' references crush rule 'unknown', which is not specified in crush rules",
+			},
+		},
 		{
 			name:           "block storage ok for mosk",
 			cephDpl:        &unitinputs.CephDeployMosk,
@@ -560,6 +763,258 @@ func TestValidatePoolsSpec(t *testing.T) {
 	}
 }
 
+func TestValidateCrushSpec(t *testing.T) {
+	tests := []struct {
+		name           string
+		crush          *cephlcmv1alpha1.CephDeploymentCrush
+		expectedIssues []string
+	}{
+		{
+			name: "no crush section",
+		},
+		{
+			name: "correct crush section",
+			crush: &cephlcmv1alpha1.CephDeploymentCrush{
+				Roots: []string{"nvme", "tenant-a"},
+				Rules: []cephlcmv1alpha1.CephCrushRule{
+					{Name: "nvme-rack", Root: "nvme", FailureDomain: "rack", DeviceClass: "nvme"},
+					{Name: "tenant-a", Root: "tenant-a"},
+					{Name: "default-host", FailureDomain: "host"},
+				},
+			},
+			expectedIssues: []string{},
+		},
+		{
+			name: "incorrect crush section",
+			crush: &cephlcmv1alpha1.CephDeploymentCrush{
+				Roots: []string{"nvme", "", "nvme", "node-1"},
+				Rules: []cephlcmv1alpha1.CephCrushRule{
+					{Name: "nvme-rack", Root: "nvme", FailureDomain: "rack"},
+					{Name: "nvme-rack", Root: "nvme"},
+					{Name: ""},
+					{Name: "ssd", Root: "ssd", FailureDomain: "disk"},
+				},
+			},
+			expectedIssues: []string{
+				"crush roots contain empty root name",
+				"crush root 'nvme' is specified more than once",
+				"crush root 'node-1' has the same name as node or crush topology bucket",
+				"crush rule 'nvme-rack' is specified more than once",
+				"crush rules contain rule with empty name",
+				"crush rule 'ssd' uses crush root 'ssd', which is not specified in crush roots",
+				"crush rule 'ssd' has invalid failure domain 'disk', valid are: [osd host chassis datacenter pdu rack region room row zone]",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+			cephDpl.Spec.Crush = test.crush
+			errs := validateCrushSpec(cephDpl)
+			assert.Equal(t, test.expectedIssues, errs)
+		})
+	}
+}
+
+func TestValidatePoolCapacitySpec(t *testing.T) {
+	tests := []struct {
+		name           string
+		pool           cephlcmv1alpha1.CephPool
+		specQuotas     cephv1.QuotaSpec
+		specParameters map[string]string
+		expectedIssues []string
+	}{
+		{
+			name:           "no quota and target size",
+			pool:           unitinputs.CephDeployPoolReplicated,
+			expectedIssues: []string{},
+		},
+		{
+			name: "correct quota and target size",
+			pool: func() cephlcmv1alpha1.CephPool {
+				pool := unitinputs.CephDeployPoolReplicated.DeepCopy()
+				pool.Quota = &cephlcmv1alpha1.CephPoolQuota{MaxBytes: "100Gi", MaxObjects: 1000}
+				pool.TargetSizeBytes = "50Gi"
+				return *pool
+			}(),
+			specParameters: map[string]string{"pg_autoscale_mode": "on"},
+			expectedIssues: []string{},
+		},
+		{
+			name: "incorrect quota and target size",
+			pool: func() cephlcmv1alpha1.CephPool {
+				pool := unitinputs.CephDeployPoolReplicated.DeepCopy()
+				pool.Quota = &cephlcmv1alpha1.CephPoolQuota{MaxBytes: "-1Gi"}
+				pool.TargetSizeBytes = "many"
+				pool.TargetSizeRatio = "0"
+				return *pool
+			}(),
+			expectedIssues: []string{
+				"pool 'pool1' has incorrect quota maxBytes '-1Gi', expected positive quantity",
+				"pool 'pool1' has both targetSizeRatio and targetSizeBytes specified, use only one of them",
+				"pool 'pool1' has incorrect targetSizeRatio '0', expected positive number",
+				"pool 'pool1' has incorrect targetSizeBytes 'many', expected positive quantity",
+			},
+		},
+		{
+			name: "quota and target size are specified in pool spec as well",
+			pool: func() cephlcmv1alpha1.CephPool {
+				pool := unitinputs.CephDeployPoolReplicated.DeepCopy()
+				pool.Quota = &cephlcmv1alpha1.CephPoolQuota{MaxObjects: 1000}
+				pool.TargetSizeRatio = "0.2"
+				return *pool
+			}(),
+			specQuotas:     cephv1.QuotaSpec{MaxSize: &[]string{"10Gi"}[0]},
+			specParameters: map[string]string{"target_size_ratio": "0.1"},
+			expectedIssues: []string{
+				"pool 'pool1' has both quota and spec quotas specified, use only one of them",
+				"pool 'pool1' has target size specified both in pool and in pool spec, use only one of them",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec, _ := test.pool.GetSpec()
+			spec.Quotas = test.specQuotas
+			spec.Parameters = test.specParameters
+			errs := validatePoolCapacitySpec(test.pool, spec)
+			assert.Equal(t, test.expectedIssues, errs)
+		})
+	}
+}
+
+func TestValidatePoolsCapacity(t *testing.T) {
+	healthWithUsage := &cephlcmv1alpha1.CephDeploymentHealthList{
+		Items: []cephlcmv1alpha1.CephDeploymentHealth{
+			{
+				ObjectMeta: unitinputs.LcmObjectMeta,
+				Status: cephlcmv1alpha1.CephDeploymentHealthStatus{
+					HealthReport: &cephlcmv1alpha1.CephDeploymentHealthReport{
+						ClusterDetails: &cephlcmv1alpha1.ClusterDetails{UsageDetails: unitinputs.CephBaseUsageDetails},
+					},
+				},
+			},
+		},
+	}
+	getPool := func(base cephlcmv1alpha1.CephPool, name, targetSize string) cephlcmv1alpha1.CephPool {
+		pool := base.DeepCopy()
+		pool.Name = name
+		pool.TargetSizeBytes = targetSize
+		return *pool
+	}
+	getRatioPool := func(base cephlcmv1alpha1.CephPool, name, ratio string) cephlcmv1alpha1.CephPool {
+		pool := base.DeepCopy()
+		pool.Name = name
+		pool.TargetSizeRatio = ratio
+		return *pool
+	}
+	tests := []struct {
+		name           string
+		pools          []cephlcmv1alpha1.CephPool
+		health         *cephlcmv1alpha1.CephDeploymentHealthList
+		admission      bool
+		expectedIssues []string
+	}{
+		{
+			name:           "no pools with target size, nothing to verify",
+			pools:          []cephlcmv1alpha1.CephPool{unitinputs.CephDeployPoolReplicated},
+			expectedIssues: []string{},
+		},
+		{
+			name:           "no cephdeploymenthealth found, capacity is not verified",
+			pools:          []cephlcmv1alpha1.CephPool{getPool(unitinputs.CephDeployPoolReplicated, "pool1", "1Ti")},
+			health:         &cephlcmv1alpha1.CephDeploymentHealthList{},
+			expectedIssues: []string{},
+		},
+		{
+			name:           "no usage details for cluster, capacity is not verified",
+			pools:          []cephlcmv1alpha1.CephPool{getPool(unitinputs.CephDeployPoolReplicated, "pool1", "1Ti")},
+			health:         &cephlcmv1alpha1.CephDeploymentHealthList{Items: []cephlcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth}},
+			expectedIssues: []string{},
+		},
+		{
+			name: "pools target size ratios fit device class",
+			pools: []cephlcmv1alpha1.CephPool{
+				getRatioPool(unitinputs.CephDeployPoolReplicated, "pool1", "0.6"),
+				getRatioPool(unitinputs.CephDeployPoolErasureCoded, "pool2", "0.4"),
+			},
+			admission:      true,
+			expectedIssues: []string{},
+		},
+		{
+			name: "pools target size ratios exceed device class",
+			pools: []cephlcmv1alpha1.CephPool{
+				getRatioPool(unitinputs.CephDeployPoolReplicated, "pool1", "0.7"),
+				getRatioPool(unitinputs.CephDeployPoolErasureCoded, "pool2", "0.5"),
+				getRatioPool(unitinputs.CephDeployPoolReplicated, "pool3", "wrong"),
+			},
+			admission: true,
+			expectedIssues: []string{
+				"pools [pool1 pool2] target size ratios sum 1.2 for device class 'hdd' is greater than 1.0",
+			},
+		},
+		{
+			name: "admission request, capacity is not verified against health report",
+			pools: []cephlcmv1alpha1.CephPool{
+				getPool(unitinputs.CephDeployPoolReplicated, "pool1", "200Gi"),
+				getPool(unitinputs.CephDeployPoolErasureCoded, "pool2", "100Gi"),
+			},
+			health:         healthWithUsage,
+			admission:      true,
+			expectedIssues: []string{},
+		},
+		{
+			name: "pools target sizes fit device class capacity",
+			pools: []cephlcmv1alpha1.CephPool{
+				getPool(unitinputs.CephDeployPoolReplicated, "pool1", "100Gi"),
+				getPool(unitinputs.CephDeployPoolErasureCoded, "pool2", "100Gi"),
+			},
+			health:         healthWithUsage,
+			expectedIssues: []string{},
+		},
+		{
+			name: "pools target sizes exceed device class capacity",
+			pools: []cephlcmv1alpha1.CephPool{
+				getPool(unitinputs.CephDeployPoolReplicated, "pool1", "200Gi"),
+				getPool(unitinputs.CephDeployPoolErasureCoded, "pool2", "100Gi"),
+				getPool(unitinputs.CephDeployPoolReplicated, "pool3", ""),
+			},
+			health: healthWithUsage,
+			expectedIssues: []string{
+				"pools [pool1 pool2] target sizes require 805306368000 bytes of raw capacity for device class 'hdd', while device class capacity is 509981204480 bytes",
+			},
+		},
+		{
+			name: "device class is not present in usage details",
+			pools: []cephlcmv1alpha1.CephPool{
+				func() cephlcmv1alpha1.CephPool {
+					pool := getPool(unitinputs.CephDeployPoolReplicated, "pool1", "1Ti")
+					spec, _ := pool.GetSpec()
+					spec.DeviceClass = "ssd"
+					pool.PoolSpec.Raw = unitinputs.ConvertStructToRaw(spec)
+					return pool
+				}(),
+			},
+			health:         healthWithUsage,
+			expectedIssues: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+			cephDpl.Spec.BlockStorage.Pools = test.pools
+			c := fakeDeploymentConfig(&deployConfig{cephDpl: cephDpl}, nil)
+			if test.health != nil {
+				faketestclients.FakeReaction(c.api.CephLcmclientset, "get", []string{"cephdeploymenthealths"}, map[string]runtime.Object{"cephdeploymenthealths": test.health}, nil)
+			}
+
+			issues := c.validatePoolsCapacity(test.admission)
+			assert.Equal(t, test.expectedIssues, issues)
+			faketestclients.CleanupFakeClientReactions(c.api.CephLcmclientset)
+		})
+	}
+}
+
 func TestValidatePoolSpec(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -634,6 +1089,53 @@ func TestValidatePoolSpec(t *testing.T) {
 			nodesListExpanded: unitinputs.CephNodesOk,
 			expectedIssues:    []string{},
 		},
+		{
+			name:     "ec pool does not fit hosts",
+			poolName: "ec",
+			poolSpec: func() cephv1.PoolSpec {
+				spec, _ := unitinputs.CephDeployPoolErasureCoded.GetSpec()
+				spec.ErasureCoded.DataChunks = 4
+				spec.ErasureCoded.CodingChunks = 2
+				return spec
+			}(),
+			nodesListExpanded: unitinputs.CephNodesOk,
+			expectedIssues: []string{
+				"erasureCoded ec pool requires 6 failure domains 'host' with deviceClass 'hdd' for dataChunks and codingChunks, but found only 3 in nodes spec",
+			},
+		},
+		{
+			name:     "ec pool does not fit racks",
+			poolName: "ec",
+			poolSpec: func() cephv1.PoolSpec {
+				spec, _ := unitinputs.CephDeployPoolErasureCoded.GetSpec()
+				spec.FailureDomain = "rack"
+				return spec
+			}(),
+			nodesListExpanded: func() []cephlcmv1alpha1.CephDeploymentNode {
+				nodes := []cephlcmv1alpha1.CephDeploymentNode{}
+				racks := []string{"rack-1", "rack-2", "rack-1"}
+				for idx, node := range unitinputs.CephNodesOk {
+					newNode := *node.DeepCopy()
+					newNode.Crush = map[string]string{"rack": racks[idx]}
+					nodes = append(nodes, newNode)
+				}
+				return nodes
+			}(),
+			expectedIssues: []string{
+				"erasureCoded ec pool requires 3 failure domains 'rack' with deviceClass 'hdd' for dataChunks and codingChunks, but found only 2 in nodes spec",
+			},
+		},
+		{
+			name:     "ec pool with osd failure domain is not verified",
+			poolName: "ec",
+			poolSpec: func() cephv1.PoolSpec {
+				spec, _ := unitinputs.CephDeployPoolErasureCoded.GetSpec()
+				spec.FailureDomain = "osd"
+				return spec
+			}(),
+			nodesListExpanded: unitinputs.CephNodesOk[:1],
+			expectedIssues:    []string{},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -643,6 +1145,70 @@ func TestValidatePoolSpec(t *testing.T) {
 	}
 }
 
+func TestValidateErasureCodedPoolUpdate(t *testing.T) {
+	replicated := cephv1.PoolSpec{DeviceClass: "hdd", Replicated: cephv1.ReplicatedSpec{Size: 3}}
+	erasureCoded := cephv1.PoolSpec{DeviceClass: "hdd", ErasureCoded: cephv1.ErasureCodedSpec{DataChunks: 2, CodingChunks: 1}}
+	tests := []struct {
+		name          string
+		present       cephv1.PoolSpec
+		desired       cephv1.PoolSpec
+		expectedError string
+	}{
+		{
+			name:    "replicated pool updated",
+			present: replicated,
+			desired: cephv1.PoolSpec{DeviceClass: "hdd", Replicated: cephv1.ReplicatedSpec{Size: 2}},
+		},
+		{
+			name:    "erasure coded pool updated without profile change",
+			present: erasureCoded,
+			desired: cephv1.PoolSpec{DeviceClass: "hdd", FailureDomain: "rack", ErasureCoded: cephv1.ErasureCodedSpec{DataChunks: 2, CodingChunks: 1}},
+		},
+		{
+			name:    "pool spec is not specified",
+			present: erasureCoded,
+		},
+		{
+			name:          "pool type changed",
+			present:       replicated,
+			desired:       erasureCoded,
+			expectedError: "rgw 'rgw-store' data pool type can't be changed from replicated to erasureCoded, create a new pool and migrate data instead",
+		},
+		{
+			name:          "erasure coded profile changed",
+			present:       erasureCoded,
+			desired:       cephv1.PoolSpec{DeviceClass: "hdd", ErasureCoded: cephv1.ErasureCodedSpec{DataChunks: 4, CodingChunks: 2}},
+			expectedError: "rgw 'rgw-store' data pool erasure coded profile can't be changed from dataChunks=2, codingChunks=1 to dataChunks=4, codingChunks=2, create a new pool and migrate data instead",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateErasureCodedPoolUpdate("rgw 'rgw-store' data", test.present, test.desired)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestGetErasureCodedUpdateIssues(t *testing.T) {
+	oldCephDpl := unitinputs.CephDeployNonMosk.DeepCopy()
+	assert.Equal(t, []string{}, getErasureCodedUpdateIssues(oldCephDpl, oldCephDpl))
+
+	newCephDpl := oldCephDpl.DeepCopy()
+	newCephDpl.Spec.BlockStorage.Pools[0].PoolSpec = unitinputs.CephDeployPoolErasureCoded.PoolSpec
+	rgwSpec, _ := newCephDpl.Spec.ObjectStorage.Rgws[0].GetSpec()
+	rgwSpec.DataPool.ErasureCoded.DataChunks = 3
+	newCephDpl.Spec.ObjectStorage.Rgws[0].Spec.Raw = unitinputs.ConvertStructToRaw(rgwSpec)
+	assert.Equal(t, []string{
+		"block storage 'pool1-hdd' pool type can't be changed from replicated to erasureCoded, create a new pool and migrate data instead",
+		"rgw 'rgw-store' data pool erasure coded profile can't be changed from dataChunks=2, codingChunks=1 to dataChunks=3, codingChunks=1, create a new pool and migrate data instead",
+	}, getErasureCodedUpdateIssues(oldCephDpl, newCephDpl))
+}
+
 func TestValidateDeviceClass(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -1065,6 +1631,7 @@ func TestValidateSpec(t *testing.T) {
 		name           string
 		cephDpl        *cephlcmv1alpha1.CephDeployment
 		nodeList       *v1.NodeList
+		health         *cephlcmv1alpha1.CephDeploymentHealthList
 		expectedStatus cephlcmv1alpha1.CephDeploymentValidation
 	}{
 		{
@@ -1133,6 +1700,38 @@ func TestValidateSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "validate cephdeployment with errors and warnings, failed",
+			cephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.CephDeployNonMosk.DeepCopy()
+				clusterSpec, _ := cd.Spec.Cluster.GetSpec()
+				clusterSpec.Network.AddressRanges = nil
+				cd.Spec.Cluster.Raw = unitinputs.ConvertStructToRaw(clusterSpec)
+				cd.Spec.BlockStorage.Pools[0].TargetSizeBytes = "300Gi"
+				return cd
+			}(),
+			nodeList: unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			health: &cephlcmv1alpha1.CephDeploymentHealthList{
+				Items: []cephlcmv1alpha1.CephDeploymentHealth{
+					{
+						ObjectMeta: unitinputs.LcmObjectMeta,
+						Status: cephlcmv1alpha1.CephDeploymentHealthStatus{
+							HealthReport: &cephlcmv1alpha1.CephDeploymentHealthReport{
+								ClusterDetails: &cephlcmv1alpha1.ClusterDetails{UsageDetails: unitinputs.CephBaseUsageDetails},
+							},
+						},
+					},
+				},
+			},
+			expectedStatus: cephlcmv1alpha1.CephDeploymentValidation{
+				Result:                  cephlcmv1alpha1.ValidationFailed,
+				LastValidatedGeneration: 10,
+				Messages: []string{
+					"cluster network addressRanges parameter is not specified",
+					"pools [pool1] target sizes require 966367641600 bytes of raw capacity for device class 'hdd', while device class capacity is 509981204480 bytes",
+				},
+			},
+		},
 		{
 			name:     "validate non-mosk cephdeployment, success",
 			cephDpl:  unitinputs.CephDeployNonMosk.DeepCopy(),
@@ -1210,6 +1809,9 @@ func TestValidateSpec(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			c := fakeDeploymentConfig(&deployConfig{cephDpl: test.cephDpl}, nil)
 			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "list", []string{"nodes"}, map[string]runtime.Object{"nodes": test.nodeList}, nil)
+			if test.health != nil {
+				faketestclients.FakeReaction(c.api.CephLcmclientset, "get", []string{"cephdeploymenthealths"}, map[string]runtime.Object{"cephdeploymenthealths": test.health}, nil)
+			}
 
 			err := c.castExtensions()
 			assert.Nil(t, err)
@@ -1217,6 +1819,7 @@ func TestValidateSpec(t *testing.T) {
 			actualStatus := c.validateSpec()
 			assert.Equal(t, test.expectedStatus, actualStatus)
 			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+			faketestclients.CleanupFakeClientReactions(c.api.CephLcmclientset)
 		})
 	}
 }
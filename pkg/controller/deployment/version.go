@@ -288,6 +288,7 @@ func (c *cephDeploymentConfig) ensureCephClusterVersion() error {
 	}
 	if cephCluster.Spec.CephVersion.Image != c.cdConfig.currentCephImage {
 		c.log.Info().Msgf("updating CephCluster image from '%s' to '%s'", cephCluster.Spec.CephVersion.Image, c.cdConfig.currentCephImage)
+		prevSpec := cephCluster.Spec.DeepCopy()
 		cephCluster.Spec.CephVersion.Image = c.cdConfig.currentCephImage
 		// Remove hostNetwork because Rook now fails validation if it is set within
 		// provider=host
@@ -299,6 +300,8 @@ func (c *cephDeploymentConfig) ensureCephClusterVersion() error {
 			delete(cephCluster.Annotations, cephRestartOsdTimestampLabel)
 			delete(cephCluster.Spec.Annotations, cephv1.KeyOSD)
 		}
+		// version update is made by controller itself and should not be treated as drift
+		refreshAppliedSpecHash(&cephCluster.ObjectMeta, *prevSpec, cephCluster.Spec)
 		_, err := c.api.Rookclientset.CephV1().CephClusters(c.lcmConfig.RookNamespace).Update(c.context, cephCluster, metav1.UpdateOptions{})
 		if err != nil {
 			return errors.Wrapf(err, "failed to update CephCluster %s/%s version", cephCluster.Namespace, cephCluster.Name)
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	lcmconfig "github.com/Mirantis/pelagia/v3/pkg/controller/config"
+)
+
+// cephDeploymentValidator runs CephDeployment spec validation on admission,
+// the same checks are used during reconcile for status validation section
+type cephDeploymentValidator struct {
+	api *ReconcileCephDeployment
+}
+
+var _ admission.Validator[*cephlcmv1alpha1.CephDeployment] = &cephDeploymentValidator{}
+
+// AddWebhook registers CephDeployment validating admission webhook in the Manager webhook server,
+// conversion webhook CA bundle is injected as well, since CephDeployment has several API versions
+func AddWebhook(mgr manager.Manager) error {
+	validator := &cephDeploymentValidator{api: newReconciler(mgr).(*ReconcileCephDeployment)}
+	if err := builder.WebhookManagedBy(mgr, &cephlcmv1alpha1.CephDeployment{}).WithValidator(validator).Complete(); err != nil {
+		return err
+	}
+	return addConversionWebhookCABundle(mgr, validator.api.ClientNoCache)
+}
+
+func (v *cephDeploymentValidator) ValidateCreate(ctx context.Context, cephDpl *cephlcmv1alpha1.CephDeployment) (admission.Warnings, error) {
+	lcmConfigData, err := v.getLcmConfigData(ctx, cephDpl.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	errMsgs, warnMsgs := v.getSpecIssues(ctx, lcmConfigData, cephDpl)
+	if errMsg := v.getRookNamespaceIssue(ctx, lcmConfigData, cephDpl); errMsg != "" {
+		errMsgs = append(errMsgs, errMsg)
+	}
+	return getAdmissionResult(errMsgs, warnMsgs)
+}
+
+func (v *cephDeploymentValidator) ValidateUpdate(ctx context.Context, oldCephDpl, newCephDpl *cephlcmv1alpha1.CephDeployment) (admission.Warnings, error) {
+	// do not block finalizers removal and metadata only changes
+	if newCephDpl.GetDeletionTimestamp() != nil || reflect.DeepEqual(oldCephDpl.Spec, newCephDpl.Spec) {
+		return nil, nil
+	}
+	lcmConfigData, err := v.getLcmConfigData(ctx, newCephDpl.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	// Ceph cluster can not be moved to another rook namespace
+	defaultRookNamespace := lcmconfig.ReadConfiguration(log, lcmConfigData).RookNamespace
+	if getCephDeploymentRookNamespace(oldCephDpl, defaultRookNamespace) != getCephDeploymentRookNamespace(newCephDpl, defaultRookNamespace) {
+		return getAdmissionResult([]string{"rookNamespace can not be changed for already created Ceph cluster"}, nil)
+	}
+	// pools type and erasure coded profile can not be changed for already created pools
+	if errMsgs := getErasureCodedUpdateIssues(oldCephDpl, newCephDpl); len(errMsgs) > 0 {
+		return getAdmissionResult(errMsgs, nil)
+	}
+	errMsgs, warnMsgs := v.getSpecIssues(ctx, lcmConfigData, newCephDpl)
+	if len(errMsgs) == 0 {
+		return getAdmissionResult(errMsgs, warnMsgs)
+	}
+	// issues, which are already present in current spec, are not blocking
+	// to allow fix spec step by step
+	oldErrMsgs, _ := v.getSpecIssues(ctx, lcmConfigData, oldCephDpl)
+	newErrMsgs := []string{}
+	for _, errMsg := range errMsgs {
+		if lcmcommon.Contains(oldErrMsgs, errMsg) {
+			warnMsgs = append(warnMsgs, errMsg)
+		} else {
+			newErrMsgs = append(newErrMsgs, errMsg)
+		}
+	}
+	return getAdmissionResult(newErrMsgs, warnMsgs)
+}
+
+func (v *cephDeploymentValidator) ValidateDelete(_ context.Context, _ *cephlcmv1alpha1.CephDeployment) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// getLcmConfigData reads lcm config directly from api, since loaded configuration is available
+// only for leader, while admission requests are served by all controller replicas
+func (v *cephDeploymentValidator) getLcmConfigData(ctx context.Context, namespace string) (map[string]string, error) {
+	lcmConfigMap := &corev1.ConfigMap{}
+	err := v.api.ClientNoCache.Get(ctx, types.NamespacedName{Namespace: namespace, Name: lcmconfig.LcmConfigMapName}, lcmConfigMap)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get configmap '%s/%s' to validate CephDeployment spec", namespace, lcmconfig.LcmConfigMapName)
+	}
+	return lcmConfigMap.Data, nil
+}
+
+func (v *cephDeploymentValidator) getSpecIssues(ctx context.Context, lcmConfigData map[string]string, cephDpl *cephlcmv1alpha1.CephDeployment) ([]string, []string) {
+	objectField := fmt.Sprintf("cephdeployment '%s/%s' admission", cephDpl.Namespace, cephDpl.Name)
+	lcmConfig := lcmconfig.ReadClusterConfiguration(log.With().Str(lcmcommon.LoggerObjectField, objectField).Logger(), lcmConfigData, cephDpl.Name, cephDpl.Spec.RookNamespace)
+	sublog := log.With().Str(lcmcommon.LoggerObjectField, objectField).Logger().Level(lcmConfig.DeployParams.LogLevel)
+	c := &cephDeploymentConfig{
+		context:   ctx,
+		api:       v.api,
+		log:       &sublog,
+		lcmConfig: &lcmConfig,
+		cdConfig:  deployConfig{cephDpl: cephDpl.DeepCopy()},
+	}
+	if err := c.castExtensions(); err != nil {
+		return []string{err.Error()}, nil
+	}
+	return c.getSpecIssues(true)
+}
+
+// getRookNamespaceIssue checks that rook namespace is not used by another CephDeployment in namespace
+func (v *cephDeploymentValidator) getRookNamespaceIssue(ctx context.Context, lcmConfigData map[string]string, cephDpl *cephlcmv1alpha1.CephDeployment) string {
+	defaultRookNamespace := lcmconfig.ReadConfiguration(log, lcmConfigData).RookNamespace
+	cephDplList, err := v.api.CephLcmclientset.LcmV1alpha1().CephDeployments(cephDpl.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Sprintf("failed to list CephDeployments in %s namespace: %v", cephDpl.Namespace, err)
+	}
+	rookNamespace := getCephDeploymentRookNamespace(cephDpl, defaultRookNamespace)
+	for _, item := range cephDplList.Items {
+		if item.Name != cephDpl.Name && getCephDeploymentRookNamespace(&item, defaultRookNamespace) == rookNamespace {
+			return fmt.Sprintf("rook namespace '%s' is already used by CephDeployment %s/%s", rookNamespace, item.Namespace, item.Name)
+		}
+	}
+	return ""
+}
+
+func getAdmissionResult(errMsgs, warnMsgs []string) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	if len(warnMsgs) > 0 {
+		warnings = warnMsgs
+	}
+	if len(errMsgs) > 0 {
+		return warnings, errors.Errorf("validation of CephDeployment spec is failed: %s", strings.Join(errMsgs, ", "))
+	}
+	return warnings, nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestCephDeploymentValidator(t *testing.T) {
+	withUseAllDevices := func(idxs ...int) *cephlcmv1alpha1.CephDeployment {
+		cd := unitinputs.CephDeployMosk.DeepCopy()
+		for _, idx := range idxs {
+			cd.Spec.Nodes[idx].UseAllDevices = lcmcommon.PtrTo(true)
+		}
+		return cd
+	}
+	withRookNamespace := func(name, rookNamespace string) *cephlcmv1alpha1.CephDeployment {
+		cd := unitinputs.CephDeployMosk.DeepCopy()
+		cd.Name = name
+		cd.Spec.RookNamespace = rookNamespace
+		return cd
+	}
+	useAllDevicesErr := func(idx int) string {
+		return fmt.Sprintf("found 'useAllDevices' field for nodes item node '%s', which is not supported, remove field", unitinputs.CephDeployMosk.Spec.Nodes[idx].Name)
+	}
+	tests := []struct {
+		name             string
+		oldCephDpl       *cephlcmv1alpha1.CephDeployment
+		newCephDpl       *cephlcmv1alpha1.CephDeployment
+		nodeList         *v1.NodeList
+		cephDplList      *cephlcmv1alpha1.CephDeploymentList
+		lcmConfigData    map[string]string
+		expectedWarnings admission.Warnings
+		expectedError    string
+	}{
+		{
+			name:       "create valid cephdeployment",
+			newCephDpl: unitinputs.CephDeployMosk.DeepCopy(),
+			nodeList:   unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+		},
+		{
+			name:             "create cephdeployment with nodes not present in cluster yet",
+			newCephDpl:       unitinputs.CephDeployMosk.DeepCopy(),
+			nodeList:         unitinputs.GetOsdNodesList([]string{"node-1"}),
+			expectedWarnings: admission.Warnings{"found nodes present in spec, but not exist among k8s cluster nodes: node-2,node-3"},
+		},
+		{
+			name:          "create invalid cephdeployment",
+			newCephDpl:    withUseAllDevices(0),
+			nodeList:      unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			expectedError: "validation of CephDeployment spec is failed: " + useAllDevicesErr(0),
+		},
+		{
+			name:       "create cephdeployment with own rook namespace",
+			newCephDpl: withRookNamespace("another-cluster", "rook-ceph-2"),
+			nodeList:   unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			cephDplList: &cephlcmv1alpha1.CephDeploymentList{
+				Items: []cephlcmv1alpha1.CephDeployment{*unitinputs.CephDeployMosk.DeepCopy()},
+			},
+		},
+		{
+			name:       "create cephdeployment with already used rook namespace",
+			newCephDpl: withRookNamespace("another-cluster", "rook-ceph"),
+			nodeList:   unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			cephDplList: &cephlcmv1alpha1.CephDeploymentList{
+				Items: []cephlcmv1alpha1.CephDeployment{*unitinputs.CephDeployMosk.DeepCopy()},
+			},
+			expectedError: "validation of CephDeployment spec is failed: rook namespace 'rook-ceph' is already used by CephDeployment lcm-namespace/cephcluster",
+		},
+		{
+			name:       "create cephdeployment with rook namespace already used as default from lcm config",
+			newCephDpl: withRookNamespace("another-cluster", "rook-ceph-2"),
+			nodeList:   unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			cephDplList: &cephlcmv1alpha1.CephDeploymentList{
+				Items: []cephlcmv1alpha1.CephDeployment{*unitinputs.CephDeployMosk.DeepCopy()},
+			},
+			lcmConfigData: map[string]string{"ROOK_NAMESPACE": "rook-ceph-2"},
+			expectedError: "validation of CephDeployment spec is failed: rook namespace 'rook-ceph-2' is already used by CephDeployment lcm-namespace/cephcluster",
+		},
+		{
+			name:          "update cephdeployment rook namespace",
+			oldCephDpl:    unitinputs.CephDeployMosk.DeepCopy(),
+			newCephDpl:    withRookNamespace("cephcluster", "rook-ceph-2"),
+			nodeList:      unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			expectedError: "validation of CephDeployment spec is failed: rookNamespace can not be changed for already created Ceph cluster",
+		},
+		{
+			name:       "update cephdeployment erasure coded profile",
+			oldCephDpl: unitinputs.CephDeployMosk.DeepCopy(),
+			newCephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := unitinputs.CephDeployMosk.DeepCopy()
+				rgwSpec, _ := cd.Spec.ObjectStorage.Rgws[0].GetSpec()
+				rgwSpec.DataPool.ErasureCoded.CodingChunks = 2
+				cd.Spec.ObjectStorage.Rgws[0].Spec.Raw = unitinputs.ConvertStructToRaw(rgwSpec)
+				return cd
+			}(),
+			nodeList: unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			expectedError: "validation of CephDeployment spec is failed: rgw 'rgw-store' data pool erasure coded profile can't be changed " +
+				"from dataChunks=2, codingChunks=1 to dataChunks=2, codingChunks=2, create a new pool and migrate data instead",
+		},
+		{
+			name:       "update cephdeployment with default rook namespace specified explicitly",
+			oldCephDpl: unitinputs.CephDeployMosk.DeepCopy(),
+			newCephDpl: withRookNamespace("cephcluster", "rook-ceph"),
+			nodeList:   unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+		},
+		{
+			name:          "update cephdeployment with rook namespace from lcm config specified explicitly",
+			oldCephDpl:    unitinputs.CephDeployMosk.DeepCopy(),
+			newCephDpl:    withRookNamespace("cephcluster", "rook-ceph-2"),
+			nodeList:      unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			lcmConfigData: map[string]string{"ROOK_NAMESPACE": "rook-ceph-2"},
+		},
+		{
+			name:       "update cephdeployment metadata only",
+			oldCephDpl: withUseAllDevices(0),
+			newCephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := withUseAllDevices(0)
+				cd.Finalizers = nil
+				cd.DeletionTimestamp = &metav1.Time{}
+				return cd
+			}(),
+			nodeList: unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+		},
+		{
+			name:       "update cephdeployment with already present issue",
+			oldCephDpl: withUseAllDevices(0),
+			newCephDpl: func() *cephlcmv1alpha1.CephDeployment {
+				cd := withUseAllDevices(0)
+				cd.Spec.IngressConfig = nil
+				return cd
+			}(),
+			nodeList:         unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			expectedWarnings: admission.Warnings{useAllDevicesErr(0)},
+		},
+		{
+			name:             "update cephdeployment with new issue",
+			oldCephDpl:       withUseAllDevices(0),
+			newCephDpl:       withUseAllDevices(0, 1),
+			nodeList:         unitinputs.GetOsdNodesList([]string{"node-1", "node-2", "node-3"}),
+			expectedWarnings: admission.Warnings{useAllDevicesErr(0)},
+			expectedError:    "validation of CephDeployment spec is failed: " + useAllDevicesErr(1),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := &cephDeploymentValidator{api: FakeReconciler()}
+			if test.lcmConfigData != nil {
+				lcmConfigMap := &v1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "pelagia-lcmconfig", Namespace: test.newCephDpl.Namespace},
+					Data:       test.lcmConfigData,
+				}
+				validator.api.ClientNoCache = faketestclients.GetClient(faketestclients.GetClientBuilderWithObjects(lcmConfigMap))
+			}
+			faketestclients.FakeReaction(validator.api.Kubeclientset.CoreV1(), "list", []string{"nodes"}, map[string]runtime.Object{"nodes": test.nodeList}, nil)
+			if test.cephDplList != nil {
+				faketestclients.FakeReaction(validator.api.CephLcmclientset, "list", []string{"cephdeployments"}, map[string]runtime.Object{"cephdeployments": test.cephDplList}, nil)
+			}
+
+			var warnings admission.Warnings
+			var err error
+			if test.oldCephDpl == nil {
+				warnings, err = validator.ValidateCreate(context.TODO(), test.newCephDpl)
+			} else {
+				warnings, err = validator.ValidateUpdate(context.TODO(), test.oldCephDpl, test.newCephDpl)
+			}
+			assert.Equal(t, test.expectedWarnings, warnings)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+			faketestclients.CleanupFakeClientReactions(validator.api.Kubeclientset.CoreV1())
+		})
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+type capacityUsage struct {
+	UsedBytes float64 `json:"usedBytes"`
+	UsedRatio float64 `json:"usedRatio"`
+}
+
+type capacitySample struct {
+	Timestamp time.Time                `json:"timestamp"`
+	Classes   map[string]capacityUsage `json:"classes,omitempty"`
+	Pools     map[string]capacityUsage `json:"pools,omitempty"`
+}
+
+const (
+	// configmap with usage samples is kept per CephDeploymentHealth object
+	// to not lose samples on controller restart
+	capacitySamplesConfigMapTmpl = "%s-capacity-samples"
+	capacitySamplesKey           = "samples"
+)
+
+// capacityForecastState is a usage samples state for a CephDeploymentHealth object
+type capacityForecastState struct {
+	samples []capacitySample
+	// forecast calculated for the current samples
+	forecast *lcmv1alpha1.CapacityForecast
+	// new sample is added, but forecast is not calculated yet
+	outdated bool
+}
+
+var (
+	capacityForecasts     = map[string]*capacityForecastState{}
+	capacityForecastsLock sync.Mutex
+	getCapacitySampleTime = time.Now
+)
+
+func newCapacitySample(usageDetails *lcmv1alpha1.UsageDetails, timestamp time.Time) capacitySample {
+	sample := capacitySample{
+		Timestamp: timestamp,
+		Classes:   map[string]capacityUsage{},
+		Pools:     map[string]capacityUsage{},
+	}
+	for class, stats := range usageDetails.ClassesDetail {
+		used, usedErr := strconv.ParseFloat(stats.UsedBytes, 64)
+		total, totalErr := strconv.ParseFloat(stats.TotalBytes, 64)
+		if usedErr != nil || totalErr != nil || total == 0 {
+			continue
+		}
+		sample.Classes[class] = capacityUsage{UsedBytes: used, UsedRatio: used / total}
+	}
+	for pool, stats := range usageDetails.PoolsDetail {
+		used, usedErr := strconv.ParseFloat(stats.UsedBytes, 64)
+		percent, percentErr := strconv.ParseFloat(stats.UsedBytesPercentage, 64)
+		if usedErr != nil || percentErr != nil {
+			continue
+		}
+		sample.Pools[pool] = capacityUsage{UsedBytes: used, UsedRatio: percent / 100}
+	}
+	return sample
+}
+
+// getLinearSlope returns slope of least squares line fitted for points
+func getLinearSlope(xs, ys []float64) (float64, bool) {
+	if len(xs) < 2 {
+		return 0, false
+	}
+	meanX, meanY := 0.0, 0.0
+	for idx := range xs {
+		meanX += xs[idx]
+		meanY += ys[idx]
+	}
+	meanX /= float64(len(xs))
+	meanY /= float64(len(ys))
+	num, den := 0.0, 0.0
+	for idx := range xs {
+		num += (xs[idx] - meanX) * (ys[idx] - meanY)
+		den += (xs[idx] - meanX) * (xs[idx] - meanX)
+	}
+	if den == 0 {
+		return 0, false
+	}
+	return num / den, true
+}
+
+func getDaysToThreshold(current, threshold, ratePerDay float64) string {
+	if current >= threshold {
+		return "0"
+	}
+	if ratePerDay <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.1f", (threshold-current)/ratePerDay)
+}
+
+func getCapacityForecastStats(samples []capacitySample, getUsage func(capacitySample) (capacityUsage, bool), nearFullRatio, fullRatio float64) (lcmv1alpha1.CapacityForecastStats, bool) {
+	days := []float64{}
+	usedBytes := []float64{}
+	usedRatios := []float64{}
+	for _, sample := range samples {
+		if usage, present := getUsage(sample); present {
+			days = append(days, sample.Timestamp.Sub(samples[0].Timestamp).Hours()/24)
+			usedBytes = append(usedBytes, usage.UsedBytes)
+			usedRatios = append(usedRatios, usage.UsedRatio)
+		}
+	}
+	bytesRate, ok := getLinearSlope(days, usedBytes)
+	if !ok {
+		return lcmv1alpha1.CapacityForecastStats{}, false
+	}
+	ratioRate, _ := getLinearSlope(days, usedRatios)
+	current := usedRatios[len(usedRatios)-1]
+	return lcmv1alpha1.CapacityForecastStats{
+		FillRateBytesPerDay: strconv.FormatInt(int64(math.Round(bytesRate)), 10),
+		DaysToNearFull:      getDaysToThreshold(current, nearFullRatio, ratioRate),
+		DaysToFull:          getDaysToThreshold(current, fullRatio, ratioRate),
+	}, true
+}
+
+// calculateCapacityForecast projects used ratio of device classes and pools from the latest sample
+// to Ceph nearfull and full ratios
+func calculateCapacityForecast(samples []capacitySample, ratios lcmcommon.OsdFullRatios) *lcmv1alpha1.CapacityForecast {
+	lastSample := samples[len(samples)-1]
+	forecast := &lcmv1alpha1.CapacityForecast{
+		SamplesPeriod:   lastSample.Timestamp.Sub(samples[0].Timestamp).String(),
+		ClassesForecast: map[string]lcmv1alpha1.CapacityForecastStats{},
+		PoolsForecast:   map[string]lcmv1alpha1.CapacityForecastStats{},
+	}
+	for class := range lastSample.Classes {
+		getUsage := func(sample capacitySample) (capacityUsage, bool) {
+			usage, present := sample.Classes[class]
+			return usage, present
+		}
+		if stats, ok := getCapacityForecastStats(samples, getUsage, ratios.NearFullRatio, ratios.FullRatio); ok {
+			forecast.ClassesForecast[class] = stats
+		}
+	}
+	// pool used percentage is calculated by Ceph against pool max available bytes,
+	// which already respects full ratio, so pool is full when all available bytes are used
+	poolNearFullRatio := 1.0
+	if ratios.FullRatio > 0 {
+		poolNearFullRatio = ratios.NearFullRatio / ratios.FullRatio
+	}
+	for pool := range lastSample.Pools {
+		getUsage := func(sample capacitySample) (capacityUsage, bool) {
+			usage, present := sample.Pools[pool]
+			return usage, present
+		}
+		if stats, ok := getCapacityForecastStats(samples, getUsage, poolNearFullRatio, 1); ok {
+			forecast.PoolsForecast[pool] = stats
+		}
+	}
+	if len(forecast.ClassesForecast) == 0 {
+		forecast.ClassesForecast = nil
+	}
+	if len(forecast.PoolsForecast) == 0 {
+		forecast.PoolsForecast = nil
+	}
+	return forecast
+}
+
+func getCapacityForecastIssues(forecast *lcmv1alpha1.CapacityForecast, horizon time.Duration) []string {
+	horizonDays := int(horizon.Hours() / 24)
+	if forecast == nil || horizonDays == 0 {
+		return nil
+	}
+	issues := []string{}
+	withinHorizon := func(days string) bool {
+		value, err := strconv.ParseFloat(days, 64)
+		return err == nil && value < float64(horizonDays)
+	}
+	addIssue := func(kind, name string, stats lcmv1alpha1.CapacityForecastStats) {
+		if withinHorizon(stats.DaysToFull) {
+			issues = append(issues, fmt.Sprintf("%s '%s' is projected to be full within %d days", kind, name, horizonDays))
+		} else if withinHorizon(stats.DaysToNearFull) {
+			issues = append(issues, fmt.Sprintf("%s '%s' is projected to reach nearfull ratio within %d days", kind, name, horizonDays))
+		}
+	}
+	for class, stats := range forecast.ClassesForecast {
+		addIssue("device class", class, stats)
+	}
+	for pool, stats := range forecast.PoolsForecast {
+		addIssue("pool", pool, stats)
+	}
+	sort.Strings(issues)
+	return issues
+}
+
+// getCapacityForecast keeps periodic usage samples for cluster and estimates time
+// to nearfull and full for device classes and pools present in usage details
+func (c *cephDeploymentHealthConfig) getCapacityForecast(usageDetails *lcmv1alpha1.UsageDetails) (*lcmv1alpha1.CapacityForecast, []string) {
+	if lcmcommon.Contains(c.lcmConfig.HealthParams.ChecksSkip, capacityForecastCheck) {
+		c.log.Debug().Msgf("skipping ceph cluster capacity forecast, set '%s' to skip through lcm config settings", capacityForecastCheck)
+		return nil, nil
+	}
+	if usageDetails == nil {
+		return nil, nil
+	}
+	capacityForecastsLock.Lock()
+	defer capacityForecastsLock.Unlock()
+	key := fmt.Sprintf("%s/%s", c.healthConfig.namespace, c.healthConfig.name)
+	state, present := capacityForecasts[key]
+	if !present {
+		samples := c.loadCapacitySamples()
+		if len(samples) > c.lcmConfig.HealthParams.CapacityForecastSamples {
+			samples = samples[len(samples)-c.lcmConfig.HealthParams.CapacityForecastSamples:]
+		}
+		state = &capacityForecastState{samples: samples, outdated: len(samples) > 1}
+		capacityForecasts[key] = state
+	}
+	timeNow := getCapacitySampleTime()
+	if len(state.samples) == 0 || timeNow.Sub(state.samples[len(state.samples)-1].Timestamp) >= c.lcmConfig.HealthParams.CapacityForecastSampleInterval {
+		state.samples = append(state.samples, newCapacitySample(usageDetails, timeNow))
+		if len(state.samples) > c.lcmConfig.HealthParams.CapacityForecastSamples {
+			state.samples = state.samples[len(state.samples)-c.lcmConfig.HealthParams.CapacityForecastSamples:]
+		}
+		state.outdated = len(state.samples) > 1
+		if err := c.saveCapacitySamples(state.samples); err != nil {
+			c.log.Error().Err(err).Msg("failed to save capacity usage samples, samples are kept in memory only")
+		}
+	}
+	if state.outdated {
+		var ratios lcmcommon.OsdFullRatios
+		cmd := "ceph osd dump -f json"
+		err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd, &ratios)
+		if err != nil {
+			c.log.Error().Err(err).Msg("")
+			return state.forecast.DeepCopy(), []string{fmt.Sprintf("failed to run '%s' command to check capacity forecast", cmd)}
+		}
+		state.forecast = calculateCapacityForecast(state.samples, ratios)
+		state.outdated = false
+	}
+	return state.forecast.DeepCopy(), getCapacityForecastIssues(state.forecast, c.lcmConfig.HealthParams.CapacityForecastHorizon)
+}
+
+// loadCapacitySamples returns usage samples saved for CephDeploymentHealth object, if any
+func (c *cephDeploymentHealthConfig) loadCapacitySamples() []capacitySample {
+	name := fmt.Sprintf(capacitySamplesConfigMapTmpl, c.healthConfig.name)
+	cm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.healthConfig.namespace).Get(c.context, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			c.log.Error().Err(err).Msgf("failed to get configmap '%s/%s' with capacity usage samples", c.healthConfig.namespace, name)
+		}
+		return nil
+	}
+	data := cm.Data[capacitySamplesKey]
+	if data == "" {
+		return nil
+	}
+	var samples []capacitySample
+	if err := json.Unmarshal([]byte(data), &samples); err != nil {
+		c.log.Error().Err(err).Msgf("failed to parse capacity usage samples from configmap '%s/%s', samples are dropped", c.healthConfig.namespace, name)
+		return nil
+	}
+	c.log.Debug().Msgf("loaded %d capacity usage samples from configmap '%s/%s'", len(samples), c.healthConfig.namespace, name)
+	return samples
+}
+
+// saveCapacitySamples saves usage samples to configmap owned by CephDeploymentHealth object
+func (c *cephDeploymentHealthConfig) saveCapacitySamples(samples []capacitySample) error {
+	data, err := json.Marshal(samples)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal capacity usage samples")
+	}
+	name := fmt.Sprintf(capacitySamplesConfigMapTmpl, c.healthConfig.name)
+	cm, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.healthConfig.namespace).Get(c.context, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get configmap '%s/%s'", c.healthConfig.namespace, name)
+		}
+		health, err := c.api.Lcmclientset.LcmV1alpha1().CephDeploymentHealths(c.healthConfig.namespace).Get(c.context, c.healthConfig.name, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get CephDeploymentHealth '%s/%s'", c.healthConfig.namespace, c.healthConfig.name)
+		}
+		ownerRefs, err := lcmcommon.GetObjectOwnerRef(health, c.api.Scheme)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get owner references for configmap '%s/%s'", c.healthConfig.namespace, name)
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       c.healthConfig.namespace,
+				OwnerReferences: ownerRefs,
+			},
+			Data: map[string]string{capacitySamplesKey: string(data)},
+		}
+		_, err = c.api.Kubeclientset.CoreV1().ConfigMaps(c.healthConfig.namespace).Create(c.context, cm, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create configmap '%s/%s'", c.healthConfig.namespace, name)
+		}
+		return nil
+	}
+	cm.Data = map[string]string{capacitySamplesKey: string(data)}
+	_, err = c.api.Kubeclientset.CoreV1().ConfigMaps(c.healthConfig.namespace).Update(c.context, cm, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to update configmap '%s/%s'", c.healthConfig.namespace, name)
+	}
+	return nil
+}
+
+func cleanupCapacityForecast(namespace, name string) {
+	capacityForecastsLock.Lock()
+	defer capacityForecastsLock.Unlock()
+	delete(capacityForecasts, fmt.Sprintf("%s/%s", namespace, name))
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestGetCapacityForecast(t *testing.T) {
+	getUsageDetails := func(classUsed, fullPoolUsed, fullPoolPercent string) *lcmv1alpha1.UsageDetails {
+		return &lcmv1alpha1.UsageDetails{
+			ClassesDetail: map[string]lcmv1alpha1.ClassUsageStats{
+				"hdd": {UsedBytes: classUsed, TotalBytes: "1000"},
+			},
+			PoolsDetail: map[string]lcmv1alpha1.PoolUsageStats{
+				"pool-hdd":  {UsedBytes: "100", UsedBytesPercentage: "10.000"},
+				"pool-full": {UsedBytes: fullPoolUsed, UsedBytesPercentage: fullPoolPercent},
+			},
+		}
+	}
+	tests := []struct {
+		name             string
+		timeShift        time.Duration
+		usageDetails     *lcmv1alpha1.UsageDetails
+		lcmConfigData    map[string]string
+		osdDumpFails     bool
+		restarted        bool
+		expectedForecast *lcmv1alpha1.CapacityForecast
+		expectedIssues   []string
+	}{
+		{
+			name:         "first usage sample, no forecast",
+			usageDetails: getUsageDetails("500", "895", "89.500"),
+		},
+		{
+			name:         "sample interval is not passed, no forecast",
+			timeShift:    30 * time.Minute,
+			usageDetails: getUsageDetails("550", "897", "89.700"),
+		},
+		{
+			name:         "second usage sample, forecast calculated",
+			timeShift:    24 * time.Hour,
+			usageDetails: getUsageDetails("600", "900", "90.000"),
+			expectedForecast: &lcmv1alpha1.CapacityForecast{
+				SamplesPeriod: "24h0m0s",
+				ClassesForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"hdd": {FillRateBytesPerDay: "100", DaysToNearFull: "2.5", DaysToFull: "3.5"},
+				},
+				PoolsForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"pool-hdd":  {FillRateBytesPerDay: "0"},
+					"pool-full": {FillRateBytesPerDay: "5", DaysToNearFull: "0", DaysToFull: "20.0"},
+				},
+			},
+			expectedIssues: []string{
+				"device class 'hdd' is projected to be full within 30 days",
+				"pool 'pool-full' is projected to be full within 30 days",
+			},
+		},
+		{
+			name:         "failed to get full ratios, previous forecast is kept",
+			timeShift:    48 * time.Hour,
+			usageDetails: getUsageDetails("700", "905", "90.500"),
+			osdDumpFails: true,
+			expectedForecast: &lcmv1alpha1.CapacityForecast{
+				SamplesPeriod: "24h0m0s",
+				ClassesForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"hdd": {FillRateBytesPerDay: "100", DaysToNearFull: "2.5", DaysToFull: "3.5"},
+				},
+				PoolsForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"pool-hdd":  {FillRateBytesPerDay: "0"},
+					"pool-full": {FillRateBytesPerDay: "5", DaysToNearFull: "0", DaysToFull: "20.0"},
+				},
+			},
+			expectedIssues: []string{"failed to run 'ceph osd dump -f json' command to check capacity forecast"},
+		},
+		{
+			name:          "forecast recalculated, issues disabled",
+			timeShift:     48*time.Hour + time.Minute,
+			usageDetails:  getUsageDetails("710", "906", "90.600"),
+			lcmConfigData: map[string]string{"HEALTH_CAPACITY_FORECAST_HORIZON_DAYS": "0"},
+			expectedForecast: &lcmv1alpha1.CapacityForecast{
+				SamplesPeriod: "48h0m0s",
+				ClassesForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"hdd": {FillRateBytesPerDay: "100", DaysToNearFull: "1.5", DaysToFull: "2.5"},
+				},
+				PoolsForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"pool-hdd":  {FillRateBytesPerDay: "0"},
+					"pool-full": {FillRateBytesPerDay: "5", DaysToNearFull: "0", DaysToFull: "19.0"},
+				},
+			},
+		},
+		{
+			name:         "controller restarted, samples are loaded from configmap",
+			timeShift:    48*time.Hour + 2*time.Minute,
+			usageDetails: getUsageDetails("712", "906", "90.600"),
+			restarted:    true,
+			expectedForecast: &lcmv1alpha1.CapacityForecast{
+				SamplesPeriod: "48h0m0s",
+				ClassesForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"hdd": {FillRateBytesPerDay: "100", DaysToNearFull: "1.5", DaysToFull: "2.5"},
+				},
+				PoolsForecast: map[string]lcmv1alpha1.CapacityForecastStats{
+					"pool-hdd":  {FillRateBytesPerDay: "0"},
+					"pool-full": {FillRateBytesPerDay: "5", DaysToNearFull: "0", DaysToFull: "19.0"},
+				},
+			},
+			expectedIssues: []string{
+				"device class 'hdd' is projected to be full within 30 days",
+				"pool 'pool-full' is projected to be full within 30 days",
+			},
+		},
+		{
+			name:          "capacity forecast skipped",
+			timeShift:     72 * time.Hour,
+			usageDetails:  getUsageDetails("800", "910", "91.000"),
+			lcmConfigData: map[string]string{"HEALTH_CHECKS_SKIP": "capacity_forecast"},
+		},
+	}
+	oldCmdRun := lcmcommon.RunPodCommand
+	oldSampleTime := getCapacitySampleTime
+	capacityForecasts = map[string]*capacityForecastState{}
+	timeStart := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	inputResources := map[string]runtime.Object{
+		"pods":                  unitinputs.ToolBoxPodList,
+		"configmaps":            &corev1.ConfigMapList{},
+		"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fakeCephReconcileConfig(nil, test.lcmConfigData)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "list", []string{"pods"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "get", []string{"configmaps"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "create", []string{"configmaps"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "update", []string{"configmaps"}, inputResources, nil)
+			faketestclients.FakeReaction(c.api.Lcmclientset, "get", []string{"cephdeploymenthealths"}, inputResources, nil)
+			if test.restarted {
+				capacityForecasts = map[string]*capacityForecastState{}
+			}
+			lcmcommon.RunPodCommand = func(e lcmcommon.ExecConfig) (string, string, error) {
+				if e.Command == "ceph osd dump -f json" && !test.osdDumpFails {
+					return unitinputs.CephOsdDumpFullRatios, "", nil
+				}
+				return "", "", errors.New("command failed")
+			}
+			getCapacitySampleTime = func() time.Time {
+				return timeStart.Add(test.timeShift)
+			}
+
+			forecast, issues := c.getCapacityForecast(test.usageDetails)
+			assert.Equal(t, test.expectedForecast, forecast)
+			assert.Equal(t, test.expectedIssues, issues)
+		})
+	}
+	configMaps := inputResources["configmaps"].(*corev1.ConfigMapList).Items
+	assert.Equal(t, 1, len(configMaps))
+	assert.Equal(t, "cephcluster-capacity-samples", configMaps[0].Name)
+	assert.Equal(t, unitinputs.LcmObjectMeta.Namespace, configMaps[0].Namespace)
+	assert.Equal(t, 1, len(configMaps[0].OwnerReferences))
+	var savedSamples []capacitySample
+	err := json.Unmarshal([]byte(configMaps[0].Data[capacitySamplesKey]), &savedSamples)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(savedSamples))
+	cleanupCapacityForecast(unitinputs.LcmObjectMeta.Namespace, unitinputs.LcmObjectMeta.Name)
+	assert.Equal(t, map[string]*capacityForecastState{}, capacityForecasts)
+	getCapacitySampleTime = oldSampleTime
+	lcmcommon.RunPodCommand = oldCmdRun
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+const rookConfigOverrideName = "rook-config-override"
+
+// getCephConfigDrift compares Ceph config database with config computed by CephDeployment,
+// which is stored in rook-config-override ConfigMap, config file options and runtime options,
+// and with options which Rook sets from CephCluster spec
+func (c *cephDeploymentHealthConfig) getCephConfigDrift() (map[string]lcmv1alpha1.CephConfigSectionDrift, []string) {
+	if c.healthConfig.cephCluster.Spec.External.Enable {
+		return nil, nil
+	}
+	if lcmcommon.Contains(c.lcmConfig.HealthParams.ChecksSkip, cephConfigDriftCheck) {
+		c.log.Debug().Msgf("skipping ceph config drift check, set '%s' to skip through lcm config settings", cephConfigDriftCheck)
+		return nil, nil
+	}
+	configOverride, err := c.api.Kubeclientset.CoreV1().ConfigMaps(c.lcmConfig.RookNamespace).Get(c.context, rookConfigOverrideName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			c.log.Debug().Msgf("skipping ceph config drift check, configmap '%s/%s' is not found", c.lcmConfig.RookNamespace, rookConfigOverrideName)
+			return nil, nil
+		}
+		c.log.Error().Err(err).Msg("")
+		return nil, []string{fmt.Sprintf("failed to get configmap '%s/%s' to check ceph config drift", c.lcmConfig.RookNamespace, rookConfigOverrideName)}
+	}
+	cephDpl, err := c.api.Lcmclientset.LcmV1alpha1().CephDeployments(c.healthConfig.namespace).Get(c.context, c.healthConfig.name, metav1.GetOptions{})
+	if err != nil {
+		c.log.Error().Err(err).Msg("")
+		return nil, []string{fmt.Sprintf("failed to get CephDeployment '%s/%s' to check ceph config drift", c.healthConfig.namespace, c.healthConfig.name)}
+	}
+	ignoredOptions := []string{}
+	if cephDpl.Spec.DriftPolicy != nil && cephDpl.Spec.DriftPolicy.CephConfig != nil {
+		ignoredOptions = cephDpl.Spec.DriftPolicy.CephConfig.IgnoredOptions
+	}
+	var configDump []lcmcommon.CephConfigOption
+	cmd := "ceph config dump --format json"
+	err = lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd, &configDump)
+	if err != nil {
+		c.log.Error().Err(err).Msg("")
+		return nil, []string{fmt.Sprintf("failed to run '%s' command to check ceph config drift", cmd)}
+	}
+	expectedConfig := lcmcommon.ParseCephConfigOverride(configOverride.Data["config"])
+	lcmcommon.AddClusterSpecCephConfig(expectedConfig, c.healthConfig.cephCluster.Spec)
+	drift := lcmcommon.GetCephConfigDrift(expectedConfig, lcmcommon.ParseCephRuntimeConfig(configOverride.Data["runtime"]), configDump, ignoredOptions)
+	if len(drift) == 0 {
+		return nil, nil
+	}
+	for section, sectionDrift := range drift {
+		c.log.Debug().Msgf("ceph config section '%s' drift found: unexpected %d, overridden %d, missing %d options",
+			section, len(sectionDrift.Unexpected), len(sectionDrift.Overridden), len(sectionDrift.Missing))
+	}
+	return drift, nil
+}
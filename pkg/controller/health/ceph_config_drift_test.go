@@ -0,0 +1,219 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestGetCephConfigDrift(t *testing.T) {
+	baseConfig := getEmtpyHealthConfig()
+	baseConfig.cephCluster = &unitinputs.CephClusterReady
+	configMapList := &corev1.ConfigMapList{Items: []corev1.ConfigMap{*unitinputs.BaseRookConfigOverride.DeepCopy()}}
+	cephDplList := &lcmv1alpha1.CephDeploymentList{Items: []lcmv1alpha1.CephDeployment{*unitinputs.BaseCephDeployment.DeepCopy()}}
+	configDumpNoDrift := `[
+  {"section": "global", "name": "mon_max_pg_per_osd", "value": "300"},
+  {"section": "global", "name": "mon_allow_pool_delete", "value": "true"},
+  {"section": "osd", "name": "bdev_async_discard_threads", "value": "1"},
+  {"section": "osd", "name": "bdev_enable_discard", "value": "true"}
+]`
+	configDumpDrift := `[
+  {"section": "global", "name": "mon_max_pg_per_osd", "value": "300"},
+  {"section": "global", "name": "osd_pool_default_size", "value": "2"},
+  {"section": "osd", "name": "bdev_async_discard_threads", "value": "4"},
+  {"section": "osd", "name": "osd_memory_target", "value": "4294967296", "mask": "host:node-1"}
+]`
+	tests := []struct {
+		name           string
+		healthConfig   healthConfig
+		checkDisabled  bool
+		inputResources map[string]runtime.Object
+		apiErrors      map[string]error
+		cephCliOutput  map[string]string
+		expectedDrift  map[string]lcmv1alpha1.CephConfigSectionDrift
+		expectedIssues []string
+	}{
+		{
+			name: "ceph config drift check is skipped for external cluster",
+			healthConfig: func() healthConfig {
+				hc := getEmtpyHealthConfig()
+				hc.cephCluster = &unitinputs.CephClusterExternal
+				return hc
+			}(),
+		},
+		{
+			name:          "ceph config drift check is disabled",
+			healthConfig:  baseConfig,
+			checkDisabled: true,
+		},
+		{
+			name:         "rook config override configmap is not found",
+			healthConfig: baseConfig,
+			inputResources: map[string]runtime.Object{
+				"configmaps":      unitinputs.ConfigMapList,
+				"cephdeployments": cephDplList,
+			},
+		},
+		{
+			name:         "failed to get rook config override configmap",
+			healthConfig: baseConfig,
+			inputResources: map[string]runtime.Object{
+				"configmaps":      configMapList,
+				"cephdeployments": cephDplList,
+			},
+			apiErrors:      map[string]error{"get-configmaps": errors.New("failed to get configmap")},
+			expectedIssues: []string{"failed to get configmap 'rook-ceph/rook-config-override' to check ceph config drift"},
+		},
+		{
+			name:         "failed to get cephdeployment",
+			healthConfig: baseConfig,
+			inputResources: map[string]runtime.Object{
+				"configmaps":      configMapList,
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
+			},
+			expectedIssues: []string{"failed to get CephDeployment 'lcm-namespace/cephcluster' to check ceph config drift"},
+		},
+		{
+			name:         "failed to get ceph config dump",
+			healthConfig: baseConfig,
+			inputResources: map[string]runtime.Object{
+				"configmaps":      configMapList,
+				"cephdeployments": cephDplList,
+			},
+			expectedIssues: []string{"failed to run 'ceph config dump --format json' command to check ceph config drift"},
+		},
+		{
+			name:         "no ceph config drift",
+			healthConfig: baseConfig,
+			inputResources: map[string]runtime.Object{
+				"configmaps":      configMapList,
+				"cephdeployments": cephDplList,
+			},
+			cephCliOutput: map[string]string{"ceph config dump --format json": configDumpNoDrift},
+		},
+		{
+			name:         "ceph config drift found",
+			healthConfig: baseConfig,
+			inputResources: map[string]runtime.Object{
+				"configmaps":      configMapList,
+				"cephdeployments": cephDplList,
+			},
+			cephCliOutput: map[string]string{"ceph config dump --format json": configDumpDrift},
+			expectedDrift: map[string]lcmv1alpha1.CephConfigSectionDrift{
+				"global": {
+					Unexpected: []lcmv1alpha1.CephConfigOptionDrift{{Name: "osd_pool_default_size", Actual: "2"}},
+				},
+				"osd": {
+					Overridden: []lcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_async_discard_threads", Expected: "1", Actual: "4"}},
+					Missing:    []lcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_enable_discard", Expected: "true"}},
+				},
+				"osd/host:node-1": {
+					Unexpected: []lcmv1alpha1.CephConfigOptionDrift{{Name: "osd_memory_target", Actual: "4294967296"}},
+				},
+			},
+		},
+		{
+			name: "ceph config drift found, cluster spec ceph config options are expected",
+			healthConfig: func() healthConfig {
+				hc := getEmtpyHealthConfig()
+				cephCluster := unitinputs.CephClusterReady.DeepCopy()
+				cephCluster.Spec.CephConfig = map[string]map[string]string{
+					"global": {"osd_pool_default_size": "3"},
+				}
+				hc.cephCluster = cephCluster
+				return hc
+			}(),
+			inputResources: map[string]runtime.Object{
+				"configmaps":      configMapList,
+				"cephdeployments": cephDplList,
+			},
+			cephCliOutput: map[string]string{"ceph config dump --format json": configDumpDrift},
+			expectedDrift: map[string]lcmv1alpha1.CephConfigSectionDrift{
+				"global": {
+					Overridden: []lcmv1alpha1.CephConfigOptionDrift{{Name: "osd_pool_default_size", Expected: "3", Actual: "2"}},
+				},
+				"osd": {
+					Overridden: []lcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_async_discard_threads", Expected: "1", Actual: "4"}},
+					Missing:    []lcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_enable_discard", Expected: "true"}},
+				},
+				"osd/host:node-1": {
+					Unexpected: []lcmv1alpha1.CephConfigOptionDrift{{Name: "osd_memory_target", Actual: "4294967296"}},
+				},
+			},
+		},
+		{
+			name:         "ceph config drift found, some options are ignored",
+			healthConfig: baseConfig,
+			inputResources: map[string]runtime.Object{
+				"configmaps": configMapList,
+				"cephdeployments": func() *lcmv1alpha1.CephDeploymentList {
+					cd := unitinputs.BaseCephDeployment.DeepCopy()
+					cd.Spec.DriftPolicy = &lcmv1alpha1.CephDeploymentDriftPolicy{
+						CephConfig: &lcmv1alpha1.CephConfigDriftPolicy{
+							IgnoredOptions: []string{"osd_pool_default_size", "osd/host:node-1|osd_memory_target"},
+						},
+					}
+					return &lcmv1alpha1.CephDeploymentList{Items: []lcmv1alpha1.CephDeployment{*cd}}
+				}(),
+			},
+			cephCliOutput: map[string]string{"ceph config dump --format json": configDumpDrift},
+			expectedDrift: map[string]lcmv1alpha1.CephConfigSectionDrift{
+				"osd": {
+					Overridden: []lcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_async_discard_threads", Expected: "1", Actual: "4"}},
+					Missing:    []lcmv1alpha1.CephConfigOptionDrift{{Name: "bdev_enable_discard", Expected: "true"}},
+				},
+			},
+		},
+	}
+	oldCmdFunc := lcmcommon.RunPodCommand
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lcmConfigData := map[string]string{}
+			if test.checkDisabled {
+				lcmConfigData["HEALTH_CHECKS_SKIP"] = cephConfigDriftCheck
+			}
+			c := fakeCephReconcileConfig(&test.healthConfig, lcmConfigData)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "list", []string{"pods"}, map[string]runtime.Object{"pods": unitinputs.ToolBoxPodList}, nil)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "get", []string{"configmaps"}, test.inputResources, test.apiErrors)
+			faketestclients.FakeReaction(c.api.Lcmclientset, "get", []string{"cephdeployments"}, test.inputResources, nil)
+
+			lcmcommon.RunPodCommand = func(e lcmcommon.ExecConfig) (string, string, error) {
+				if output, ok := test.cephCliOutput[e.Command]; ok {
+					return output, "", nil
+				}
+				return "", "", errors.New("failed command")
+			}
+
+			drift, issues := c.getCephConfigDrift()
+			assert.Equal(t, test.expectedDrift, drift)
+			assert.Equal(t, test.expectedIssues, issues)
+			faketestclients.CleanupFakeClientReactions(c.api.Kubeclientset.CoreV1())
+			faketestclients.CleanupFakeClientReactions(c.api.Lcmclientset)
+		})
+	}
+	lcmcommon.RunPodCommand = oldCmdFunc
+}
@@ -42,6 +42,12 @@ func (c *cephDeploymentHealthConfig) getClusterDetailsInfo() (*lcmv1alpha1.Clust
 		issues = append(issues, usageDetailsIssue)
 	}
 
+	capacityForecast, capacityForecastIssues := c.getCapacityForecast(usageDetails)
+	newDetails.CapacityForecast = capacityForecast
+	if len(capacityForecastIssues) > 0 {
+		issues = append(issues, capacityForecastIssues...)
+	}
+
 	eventsStatus, eventsStatusIssue := c.getCephEvents()
 	newDetails.CephEvents = eventsStatus
 	if eventsStatusIssue != "" {
@@ -53,6 +59,12 @@ func (c *cephDeploymentHealthConfig) getClusterDetailsInfo() (*lcmv1alpha1.Clust
 		issues = append(issues, replicasIssues...)
 	}
 
+	pgDetails, pgIssues := c.getPgDetails()
+	newDetails.PgDetails = pgDetails
+	if len(pgIssues) > 0 {
+		issues = append(issues, pgIssues...)
+	}
+
 	rgwInfo, rgwIssues := c.getRgwInfo()
 	newDetails.RgwInfo = rgwInfo
 	if len(rgwIssues) > 0 {
@@ -60,7 +72,7 @@ func (c *cephDeploymentHealthConfig) getClusterDetailsInfo() (*lcmv1alpha1.Clust
 	}
 
 	// to avoid api diff since section is optional and omit empty set
-	if usageDetails == nil && eventsStatus == nil && rgwInfo == nil {
+	if usageDetails == nil && eventsStatus == nil && pgDetails == nil && rgwInfo == nil {
 		newDetails = nil
 	}
 
@@ -117,12 +129,10 @@ func (c *cephDeploymentHealthConfig) getCephEvents() (*lcmv1alpha1.CephEvents, s
 		c.log.Debug().Msgf("skipping ceph cluster events check, set '%s' to skip through lcm config settings", cephEventsCheck)
 		return nil, ""
 	}
-	var cephStatus lcmcommon.CephStatus
-	cmd := "ceph status -f json"
-	err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd, &cephStatus)
+	cephStatus, err := c.getCephStatus()
 	if err != nil {
 		c.log.Error().Err(err).Msg("")
-		return nil, fmt.Sprintf("failed to run '%s' command to check events details", cmd)
+		return nil, fmt.Sprintf("failed to run '%s' command to check events details", cephStatusCmd)
 	}
 	return &lcmv1alpha1.CephEvents{
 		RebalanceDetails:    getEventDetails("Rebalancing", cephStatus.ProgressEvents),
@@ -130,6 +140,20 @@ func (c *cephDeploymentHealthConfig) getCephEvents() (*lcmv1alpha1.CephEvents, s
 	}, ""
 }
 
+// getCephStatus returns Ceph cluster status, which is requested once per health check
+func (c *cephDeploymentHealthConfig) getCephStatus() (*lcmcommon.CephStatus, error) {
+	if c.healthConfig.cephStatus != nil {
+		return c.healthConfig.cephStatus, nil
+	}
+	var cephStatus lcmcommon.CephStatus
+	err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cephStatusCmd, &cephStatus)
+	if err != nil {
+		return nil, err
+	}
+	c.healthConfig.cephStatus = &cephStatus
+	return &cephStatus, nil
+}
+
 func getEventDetails(eventPrefix string, cephStatusEvents map[string]lcmcommon.ProgressEvents) lcmv1alpha1.CephEventDetails {
 	eventDetails := lcmv1alpha1.CephEventDetails{}
 	inAction := false
@@ -206,6 +230,7 @@ func (c *cephDeploymentHealthConfig) checkReplicasSizing() []string {
 
 	poolsDetail := []struct {
 		Name        string `json:"pool_name"`
+		Type        int    `json:"type"`
 		Size        int    `json:"size"`
 		CrushRuleID int    `json:"crush_rule"`
 	}{}
@@ -228,17 +253,20 @@ func (c *cephDeploymentHealthConfig) checkReplicasSizing() []string {
 		return []string{fmt.Sprintf("failed to run '%s' command to check replicas sizing", cmd)}
 	}
 
-	deviceClassToFailureDomainMapping := map[string]map[string]int{}
+	// device classes and failure domains are counted per crush root,
+	// since pools may use rules with different roots
+	rootsMapping := map[string]map[string]map[string]int{}
+	deviceClassesFound := false
 	for _, node := range osdTree.Nodes {
 		if node.Type == "root" {
-			// multiple roots and no roots  setup is not supported
-			// because some ceph features will not work corretly
+			deviceClassToFailureDomainMapping := map[string]map[string]int{}
 			_ = c.countDomainsAndClasses(&osdTree, deviceClassToFailureDomainMapping, node.ID)
-			break
+			rootsMapping[node.Name] = deviceClassToFailureDomainMapping
+			deviceClassesFound = deviceClassesFound || len(deviceClassToFailureDomainMapping) > 0
 		}
 	}
 
-	if len(deviceClassToFailureDomainMapping) == 0 {
+	if !deviceClassesFound {
 		return []string{"no device classes found in cluster"}
 	}
 
@@ -246,6 +274,7 @@ func (c *cephDeploymentHealthConfig) checkReplicasSizing() []string {
 	for _, pool := range poolsDetail {
 		poolFailureDomain := ""
 		poolDeviceClass := ""
+		poolRoot := ""
 		for _, crushRule := range crushRuleDump {
 			if pool.CrushRuleID == crushRule.ID {
 				for _, item := range crushRule.Steps {
@@ -253,8 +282,8 @@ func (c *cephDeploymentHealthConfig) checkReplicasSizing() []string {
 						args := strings.Split(classValue.(string), "~")
 						// check is crush rule has specified device class directly
 						// in format like `default~hdd` or has no class like `default`
-						// also since root is single - no need to check root name
 						if len(args) > 1 {
+							poolRoot = args[0]
 							poolDeviceClass = args[1]
 						} else {
 							c.log.Warn().Msgf("pool '%s' has crush rule '%s' without specified device class, skipping check", pool.Name, crushRule.Name)
@@ -271,11 +300,24 @@ func (c *cephDeploymentHealthConfig) checkReplicasSizing() []string {
 		if poolFailureDomain == "" || poolDeviceClass == "" {
 			continue
 		}
+		deviceClassToFailureDomainMapping, rootFound := rootsMapping[poolRoot]
+		if !rootFound {
+			msg := fmt.Sprintf("pool '%s' specified to use crush root '%s', which is not found in cluster", pool.Name, poolRoot)
+			c.log.Error().Msg(msg)
+			issues = append(issues, msg)
+			continue
+		}
 		if domainsInfo, ok := deviceClassToFailureDomainMapping[poolDeviceClass]; ok {
 			if count, ok := domainsInfo[poolFailureDomain]; ok {
 				if count < pool.Size {
 					msg := fmt.Sprintf("pool '%s' with deviceClass '%s' and failureDomain '%s' has targeted to have %d replicas/chunks, while cluster can provide %d replica(s)",
 						pool.Name, poolDeviceClass, poolFailureDomain, pool.Size, count)
+					// erasure coded pool size is a sum of data and coding chunks,
+					// each one requires separate failure domain
+					if pool.Type == poolTypeErasureCoded {
+						msg = fmt.Sprintf("erasure coded pool '%s' with deviceClass '%s' and failureDomain '%s' requires %d failure domains for data and coding chunks, while cluster can provide %d",
+							pool.Name, poolDeviceClass, poolFailureDomain, pool.Size, count)
+					}
 					c.log.Error().Msg(msg)
 					issues = append(issues, msg)
 				}
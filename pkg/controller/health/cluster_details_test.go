@@ -48,6 +48,7 @@ func TestGetClusterDetailsInfo(t *testing.T) {
 				"failed to run 'ceph df -f json' command to check capacity details",
 				"failed to run 'ceph osd tree -f json' command to check replicas sizing",
 				"failed to run 'ceph status -f json' command to check events details",
+				"failed to run 'ceph status -f json' command to check placement groups",
 			},
 		},
 		{
@@ -58,6 +59,7 @@ func TestGetClusterDetailsInfo(t *testing.T) {
 				"ceph osd tree -f json":            unitinputs.CephOsdTreeForSizingCheck,
 				"ceph osd crush rule dump -f json": unitinputs.CephOsdCrushRuleDump,
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
+				pgDumpStuckCmd:                     "",
 			},
 			expectedStatus: unitinputs.CephDetailsStatusNoIssues,
 			expectedIssues: []string{},
@@ -73,7 +75,7 @@ func TestGetClusterDetailsInfo(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			lcmConfigData := map[string]string{}
 			if test.skipChecks {
-				lcmConfigData["HEALTH_CHECKS_SKIP"] = "usage_details,ceph_events,pools_replicas,rgw_info"
+				lcmConfigData["HEALTH_CHECKS_SKIP"] = "usage_details,ceph_events,pools_replicas,pg_states,rgw_info"
 			}
 			c := fakeCephReconcileConfig(nil, lcmConfigData)
 			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "list", []string{"pods"}, map[string]runtime.Object{"pods": unitinputs.ToolBoxPodList}, nil)
@@ -313,6 +315,49 @@ func TestCheckReplicasSizing(t *testing.T) {
 				"pool 'pool-3' with deviceClass 'ssd' and failureDomain 'rack' has targeted to have 3 replicas/chunks, while cluster can provide 2 replica(s)",
 			},
 		},
+		{
+			name:              "issues for erasure coded pool found",
+			cephOsdTreeOutput: unitinputs.CephOsdTreeForSizingCheck,
+			cephOsdPoolDetailsOutput: `[
+  {"pool_name": "pool-1", "type": 1, "size": 3, "crush_rule": 2},
+  {"pool_name": "pool-2", "type": 1, "size": 3, "crush_rule": 3},
+  {"pool_name": "pool-3", "type": 3, "size": 6, "crush_rule": 5}
+]`,
+			cephCrushRuleDumpOutput: unitinputs.CephOsdCrushRuleDump,
+			expectedIssues: []string{
+				"erasure coded pool 'pool-3' with deviceClass 'hdd' and failureDomain 'host' requires 6 failure domains for data and coding chunks, while cluster can provide 4",
+			},
+		},
+		{
+			name: "issues for replica's found with multiple crush roots",
+			cephOsdTreeOutput: `{
+  "nodes":[
+    {"id":-1,"name":"default","type":"root","type_id":11,"children":[-3,-5,-7]},
+    {"id":-3,"name":"node-1","type":"host","type_id":1,"pool_weights":{},"children":[0]},
+    {"id":0,"device_class":"hdd","name":"osd.0","type":"osd","type_id":0,"crush_weight":0.048797607421875,"depth":2,"pool_weights":{},"exists":1,"status":"up","reweight":1,"primary_affinity":1},
+    {"id":-5,"name":"node-2","type":"host","type_id":1,"pool_weights":{},"children":[1]},
+    {"id":1,"device_class":"hdd","name":"osd.1","type":"osd","type_id":0,"crush_weight":0.048797607421875,"depth":2,"pool_weights":{},"exists":1,"status":"up","reweight":1,"primary_affinity":1},
+    {"id":-7,"name":"node-3","type":"host","type_id":1,"pool_weights":{},"children":[2]},
+    {"id":2,"device_class":"hdd","name":"osd.2","type":"osd","type_id":0,"crush_weight":0.048797607421875,"depth":2,"pool_weights":{},"exists":1,"status":"up","reweight":1,"primary_affinity":1},
+    {"id":-9,"name":"nvme","type":"root","type_id":11,"children":[-11]},
+    {"id":-11,"name":"node-4","type":"host","type_id":1,"pool_weights":{},"children":[3]},
+    {"id":3,"device_class":"nvme","name":"osd.3","type":"osd","type_id":0,"crush_weight":0.048797607421875,"depth":2,"pool_weights":{},"exists":1,"status":"up","reweight":1,"primary_affinity":1}
+  ]
+			}`,
+			cephOsdPoolDetailsOutput: unitinputs.CephPoolsDetails,
+			cephCrushRuleDumpOutput: unitinputs.BuildCliOutput(unitinputs.CephCrushRuleDumpTmpl, "osd crush rule dump", map[string]string{
+				"pool1_deviceclass":   "default~hdd",
+				"pool1_failuredomain": "host",
+				"pool2_deviceclass":   "nvme~nvme",
+				"pool2_failuredomain": "host",
+				"pool3_deviceclass":   "tenant~hdd",
+				"pool3_failuredomain": "host",
+			}),
+			expectedIssues: []string{
+				"pool 'pool-2' with deviceClass 'nvme' and failureDomain 'host' has targeted to have 3 replicas/chunks, while cluster can provide 1 replica(s)",
+				"pool 'pool-3' specified to use crush root 'tenant', which is not found in cluster",
+			},
+		},
 	}
 	oldCmdRun := lcmcommon.RunPodCommand
 	for _, test := range tests {
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -96,6 +97,7 @@ func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
 		Rookclientset:    RookClientset,
 		Gatewayclientset: gatewayClient,
 		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder(ControllerName),
 	}, nil
 }
 
@@ -146,6 +148,7 @@ type ReconcileCephDeploymentHealth struct {
 	Rookclientset    rookclient.Interface
 	Gatewayclientset gatewayclient.Interface
 	Scheme           *runtime.Scheme
+	Recorder         events.EventRecorder
 }
 
 func (r *ReconcileCephDeploymentHealth) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -156,10 +159,20 @@ func (r *ReconcileCephDeploymentHealth) Reconcile(ctx context.Context, request r
 	if err != nil {
 		sublog.Error().Err(err).Msg("")
 		if apierrors.IsNotFound(err) {
+			cleanupMetrics(request.Namespace, request.Name)
+			cleanupCapacityForecast(request.Namespace, request.Name)
+			cleanupHealthNotifier(request.Namespace, request.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{RequeueAfter: requeueAfterInterval}, err
 	}
+	// health is checked for Ceph cluster from related CephDeployment rook namespace
+	rookNamespace, err := lcmcommon.GetCephDeploymentRookNamespace(ctx, r.Lcmclientset, request.Namespace, request.Name)
+	if err != nil {
+		sublog.Error().Err(err).Msg("")
+		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+	}
+	lcmConfig = lcmconfig.GetClusterConfiguration(request.Namespace, request.Name, rookNamespace)
 
 	// init health config
 	newHealthConfig := &cephDeploymentHealthConfig{
@@ -183,12 +196,12 @@ func (r *ReconcileCephDeploymentHealth) Reconcile(ctx context.Context, request r
 		sublog.Error().Msgf("issues found during ceph deployment verification: [%s]", strings.Join(verificationIssues, ", "))
 	}
 
-	r.updateCephDeploymentHealthStatus(ctx, sublog, request, newHealthStatus, verificationIssues)
+	r.updateCephDeploymentHealthStatus(ctx, sublog, request, newHealthStatus, verificationIssues, lcmConfig.HealthParams)
 	sublog.Debug().Msg("reconcile finished")
 	return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
 }
 
-func (r *ReconcileCephDeploymentHealth) updateCephDeploymentHealthStatus(ctx context.Context, objlog zerolog.Logger, req reconcile.Request, healthReport *lcmv1alpha1.CephDeploymentHealthReport, reportIssues []string) {
+func (r *ReconcileCephDeploymentHealth) updateCephDeploymentHealthStatus(ctx context.Context, objlog zerolog.Logger, req reconcile.Request, healthReport *lcmv1alpha1.CephDeploymentHealthReport, reportIssues []string, healthParams *lcmconfig.HealthParams) {
 	var err error
 	deploymentHealth := &lcmv1alpha1.CephDeploymentHealth{}
 	err = r.Client.Get(ctx, req.NamespacedName, deploymentHealth)
@@ -200,6 +213,7 @@ func (r *ReconcileCephDeploymentHealth) updateCephDeploymentHealthStatus(ctx con
 			HealthReport:     healthReport,
 			LastHealthCheck:  deploymentHealth.Status.LastHealthCheck,
 			LastHealthUpdate: deploymentHealth.Status.LastHealthUpdate,
+			History:          deploymentHealth.Status.History,
 		}
 		if len(reportIssues) > 0 {
 			newStatus.Issues = reportIssues
@@ -212,7 +226,15 @@ func (r *ReconcileCephDeploymentHealth) updateCephDeploymentHealthStatus(ctx con
 			objlog.Debug().Msgf("updating health status with new check timestamps")
 		}
 		newStatus.LastHealthCheck = timeNow
+		// history last seen timings are updated every check, so not compared above
+		newStatus.History = updateHealthHistory(deploymentHealth.Status.History, healthReport, reportIssues, timeNow, healthParams.IssuesHistorySize)
+		oldStatus := deploymentHealth.Status
 		err = lcmv1alpha1.UpdateCephHealthDeploymentStatus(ctx, deploymentHealth, newStatus, r.Client)
+		if err == nil {
+			recordHealthMetrics(req.Namespace, req.Name, newStatus)
+			r.recordStateChangedEvent(deploymentHealth, oldStatus.State, newStatus)
+			r.sendHealthNotifications(ctx, objlog, deploymentHealth, oldStatus, newStatus, healthParams)
+		}
 	}
 	if err != nil {
 		objlog.Error().Err(errors.Wrap(err, "failed to update status")).Msg("")
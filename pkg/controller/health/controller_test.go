@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -142,6 +143,7 @@ func TestHealthReconcile(t *testing.T) {
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
 				"ceph osd metadata -f json":        unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":            unitinputs.CephOsdInfoOutput,
+				pgDumpStuckCmd:                     "",
 			},
 			diskDaemonReport: map[string]string{
 				"node-1": unitinputs.CephDiskDaemonDiskReportStringNode1,
@@ -173,11 +175,33 @@ func TestHealthReconcile(t *testing.T) {
 				"ceph mgr dump -f json":     unitinputs.CephMgrDumpBaseUnhealthy,
 				"ceph osd metadata -f json": unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":     unitinputs.CephOsdInfoOutput,
+				pgDumpStuckCmd:              "",
 			},
 			expectedStatus: func() lcmv1alpha1.CephDeploymentHealthStatus {
 				status := unitinputs.CephDeploymentHealthStatusNotOk.Status.DeepCopy()
 				status.LastHealthCheck = "time-3"
 				status.LastHealthUpdate = "time-3"
+				for _, transition := range []lcmv1alpha1.HealthIssueTransition{
+					{Issue: "RECENT_MGR_MODULE_CRASH: 2 mgr modules have recently crashed", Source: "ceph_health/RECENT_MGR_MODULE_CRASH"},
+					{Issue: "cephcluster 'rook-ceph/cephcluster' object state is 'Failure'", Source: "health_check"},
+					{Issue: "cephcluster 'rook-ceph/cephcluster' object status is not updated for last 5 minutes", Source: "health_check"},
+					{Issue: "daemonset 'lcm-namespace/pelagia-disk-daemon' is not ready", Source: "disk_daemon"},
+					{Issue: "daemonset 'rook-ceph/rook-ceph.cephfs.csi.ceph.com-nodeplugin' is not ready", Source: "ceph_csi_daemons/rook-ceph.cephfs.csi.ceph.com-nodeplugin"},
+					{Issue: "daemonset 'rook-ceph/rook-ceph.rbd.csi.ceph.com-nodeplugin' is not ready", Source: "ceph_csi_daemons/rook-ceph.rbd.csi.ceph.com-nodeplugin"},
+					{Issue: "deployment 'rook-ceph/ceph-csi-controller-manager' is not ready", Source: "ceph_csi_daemons/ceph-csi-operator"},
+					{Issue: "deployment 'rook-ceph/rook-ceph.cephfs.csi.ceph.com-ctrlplugin' is not ready", Source: "ceph_csi_daemons/rook-ceph.cephfs.csi.ceph.com-ctrlplugin"},
+					{Issue: "deployment 'rook-ceph/rook-ceph.rbd.csi.ceph.com-ctrlplugin' is not ready", Source: "ceph_csi_daemons/rook-ceph.rbd.csi.ceph.com-ctrlplugin"},
+					{Issue: "failed to run 'ceph osd tree -f json' command to check replicas sizing", Source: "health_check"},
+					{Issue: "no active mgr", Source: "ceph_daemons/mgr"},
+					{Issue: "not all (2/3) mons are running", Source: "ceph_daemons/mon"},
+					{Issue: "not all osds are in", Source: "ceph_daemons/osd"},
+					{Issue: "not all osds are up", Source: "ceph_daemons/osd"},
+				} {
+					transition.State = lcmv1alpha1.HealthIssueActive
+					transition.FirstSeen = "time-3"
+					transition.LastSeen = "time-3"
+					status.History = append(status.History, transition)
+				}
 				return *status
 			}(),
 			expectedResult: resInterval,
@@ -209,6 +233,7 @@ func TestHealthReconcile(t *testing.T) {
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
 				"ceph osd metadata -f json":        unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":            unitinputs.CephOsdInfoOutput,
+				pgDumpStuckCmd:                     "",
 			},
 			diskDaemonReport: map[string]string{
 				"node-1": unitinputs.CephDiskDaemonDiskReportStringNode1,
@@ -249,6 +274,7 @@ func TestHealthReconcile(t *testing.T) {
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
 				"ceph osd metadata -f json":        unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":            unitinputs.CephOsdInfoOutput,
+				pgDumpStuckCmd:                     "",
 			},
 			diskDaemonReport: map[string]string{
 				"node-1": unitinputs.CephDiskDaemonDiskReportStringNode1,
@@ -340,7 +366,7 @@ func TestHealthAndConfigReconcile(t *testing.T) {
 	oldVal := lcmconfig.ParamsToControl
 	lcmconfig.ParamsToControl = lcmconfig.ControlParamsHealth
 	configRequest := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: unitinputs.LcmObjectMeta.Namespace, Name: "pelagia-lcmconfig"}}
-	disableAllChecks := []string{cephDaemonsCheck, cephCSIDaemonsCheck, usageDetailsCheck, cephEventsCheck, poolReplicasCheck, rgwInfoCheck, specAnalysisCheck}
+	disableAllChecks := []string{cephDaemonsCheck, cephCSIDaemonsCheck, usageDetailsCheck, cephEventsCheck, poolReplicasCheck, rgwInfoCheck, specAnalysisCheck, cephConfigDriftCheck, capacityForecastCheck, pgStatesCheck}
 	disableAllChecksStr := strings.Join(disableAllChecks, ",")
 	lcmConfigMap := unitinputs.GetConfigMap(configRequest.Name, configRequest.Namespace, map[string]string{"HEALTH_CHECKS_SKIP": disableAllChecksStr, "HEALTH_LOG_LEVEL": "trace"})
 	configReconciler := &lcmconfig.ReconcileCephDeploymentHealthConfig{
@@ -380,7 +406,8 @@ func TestHealthAndConfigReconcile(t *testing.T) {
 	_, err = healthReconciler.Reconcile(ctx, healthRequest)
 	assert.Nil(t, err)
 	expectedLcmConfig := lcmconfig.LcmConfig{
-		RookNamespace: "rook-ceph",
+		RookNamespace:  "rook-ceph",
+		DiskDaemonName: "pelagia-disk-daemon",
 		CommonParams: lcmconfig.CommonParams{
 			BaseGatewayName:          "app-gateway",
 			BaseGatewayNamespace:     "openstack",
@@ -400,10 +427,16 @@ func TestHealthAndConfigReconcile(t *testing.T) {
 				"MON_DISK_LOW",
 				"RECENT_CRASH",
 			},
-			ChecksSkip:                disableAllChecks,
-			LogLevel:                  -1,
-			UsageDetailsClassesFilter: "",
-			UsageDetailsPoolsFilter:   "",
+			ChecksSkip:                     disableAllChecks,
+			LogLevel:                       -1,
+			UsageDetailsClassesFilter:      "",
+			UsageDetailsPoolsFilter:        "",
+			IssuesHistorySize:              50,
+			NotificationsDedupInterval:     15 * time.Minute,
+			NotificationsRateLimit:         10,
+			CapacityForecastSampleInterval: time.Hour,
+			CapacityForecastSamples:        168,
+			CapacityForecastHorizon:        30 * 24 * time.Hour,
 		},
 	}
 	assert.Equal(t, expectedLcmConfig, lcmconfig.GetConfiguration("lcm-namespace"))
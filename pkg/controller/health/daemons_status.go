@@ -57,16 +57,14 @@ func (c *cephDeploymentHealthConfig) getCephDaemonsStatus() (map[string]lcmv1alp
 		c.log.Debug().Msgf("skipping ceph daemons state check, set '%s' to skip through lcm config settings", cephDaemonsCheck)
 		return nil, nil
 	}
-	var cephStatus lcmcommon.CephStatus
-	cmd := "ceph status -f json"
-	err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd, &cephStatus)
+	cephStatus, err := c.getCephStatus()
 	if err != nil {
 		c.log.Error().Err(err).Msg("")
-		return nil, []string{fmt.Sprintf("failed to run '%s' command to check daemons status", cmd)}
+		return nil, []string{fmt.Sprintf("failed to run '%s' command to check daemons status", cephStatusCmd)}
 	}
 
 	var cephMgrDump mgrDump
-	cmd = "ceph mgr dump -f json"
+	cmd := "ceph mgr dump -f json"
 	err = lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd, &cephMgrDump)
 	if err != nil {
 		c.log.Error().Err(err).Msg("")
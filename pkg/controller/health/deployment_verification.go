@@ -64,6 +64,12 @@ func (c *cephDeploymentHealthConfig) cephDeploymentVerification() (*lcmv1alpha1.
 		healthIssues = append(healthIssues, specIssues...)
 	}
 
+	configDrift, configDriftIssues := c.getCephConfigDrift()
+	newHealthReport.CephConfigDrift = configDrift
+	if len(configDriftIssues) > 0 {
+		healthIssues = append(healthIssues, configDriftIssues...)
+	}
+
 	sort.Strings(healthIssues)
 	return newHealthReport, healthIssues
 }
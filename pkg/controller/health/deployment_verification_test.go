@@ -79,6 +79,7 @@ func TestCephDeploymentVerification(t *testing.T) {
 				"ceph osd tree -f json":            unitinputs.CephOsdTreeForSizingCheck,
 				"ceph osd crush rule dump -f json": unitinputs.CephOsdCrushRuleDump,
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
+				pgDumpStuckCmd:                     "",
 			},
 			expectedStatus: unitinputs.CephExternalClusterReportOk,
 			foundIssues:    []string{},
@@ -108,6 +109,7 @@ func TestCephDeploymentVerification(t *testing.T) {
 				"ceph osd tree -f json":            unitinputs.CephOsdTreeForSizingCheck,
 				"ceph osd crush rule dump -f json": unitinputs.CephOsdCrushRuleDump,
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
+				pgDumpStuckCmd:                     "",
 			},
 			expectedStatus: func() *lcmv1alpha1.CephDeploymentHealthReport {
 				report := unitinputs.CephExternalClusterReportOk.DeepCopy()
@@ -137,6 +139,7 @@ func TestCephDeploymentVerification(t *testing.T) {
 				"failed to list cephobjectstores in 'rook-ceph' namespace",
 				"failed to run 'ceph status -f json' command to check daemons status",
 				"failed to run 'ceph status -f json' command to check events details",
+				"failed to run 'ceph status -f json' command to check placement groups",
 			},
 		},
 		{
@@ -165,6 +168,7 @@ func TestCephDeploymentVerification(t *testing.T) {
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
 				"ceph osd metadata -f json":        unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":            unitinputs.CephOsdInfoOutput,
+				pgDumpStuckCmd:                     "",
 			},
 			daemonReport: map[string]string{
 				"node-1": unitinputs.CephDiskDaemonDiskReportStringNode1,
@@ -195,6 +199,7 @@ func TestCephDeploymentVerification(t *testing.T) {
 				"ceph mgr dump -f json":     unitinputs.CephMgrDumpBaseUnhealthy,
 				"ceph osd metadata -f json": unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":     unitinputs.CephOsdInfoOutput,
+				pgDumpStuckCmd:              "",
 			},
 			expectedStatus: unitinputs.CephBaseClusterReportNotOk,
 			foundIssues:    unitinputs.CephDeploymentHealthStatusNotOk.Status.Issues,
@@ -227,6 +232,7 @@ func TestCephDeploymentVerification(t *testing.T) {
 				"ceph osd metadata -f json":        unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":            unitinputs.CephOsdInfoOutput,
 				"radosgw-admin sync status --rgw-zonegroup=zonegroup-1 --rgw-zone=zone-1": unitinputs.RadosgwAdminMasterSyncStatusOk,
+				pgDumpStuckCmd: "",
 			},
 			daemonReport: map[string]string{
 				"node-1": unitinputs.CephDiskDaemonDiskReportStringNode1,
@@ -261,6 +267,7 @@ func TestCephDeploymentVerification(t *testing.T) {
 				"ceph osd pool ls detail -f json":  unitinputs.CephPoolsDetails,
 				"ceph osd metadata -f json":        unitinputs.CephOsdMetadataOutput,
 				"ceph osd info -f json":            unitinputs.CephOsdInfoOutput,
+				pgDumpStuckCmd:                     "",
 			},
 			daemonReport: map[string]string{
 				"node-1": "{||}",
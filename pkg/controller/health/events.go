@@ -0,0 +1,47 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+const (
+	eventReasonHealthStateChanged = "HealthStateChanged"
+	eventActionHealthCheck        = "HealthCheck"
+)
+
+func (r *ReconcileCephDeploymentHealth) recordStateChangedEvent(deploymentHealth *lcmv1alpha1.CephDeploymentHealth, oldState lcmv1alpha1.CephDeploymentHealthState, newStatus lcmv1alpha1.CephDeploymentHealthStatus) {
+	if r.Recorder == nil || newStatus.State == oldState {
+		return
+	}
+	// do not spam about initial healthy state
+	if oldState == "" && newStatus.State == lcmv1alpha1.HealthStateOk {
+		return
+	}
+	if newStatus.State == lcmv1alpha1.HealthStateFailed {
+		r.Recorder.Eventf(deploymentHealth, nil, corev1.EventTypeWarning, eventReasonHealthStateChanged, eventActionHealthCheck,
+			"health state changed to %s, issues: %s", newStatus.State, strings.Join(newStatus.Issues, ", "))
+		return
+	}
+	r.Recorder.Eventf(deploymentHealth, nil, corev1.EventTypeNormal, eventReasonHealthStateChanged, eventActionHealthCheck,
+		"health state changed from %s to %s", oldState, newStatus.State)
+}
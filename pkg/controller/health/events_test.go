@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/events"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+func TestRecordStateChangedEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		oldState      lcmv1alpha1.CephDeploymentHealthState
+		newStatus     lcmv1alpha1.CephDeploymentHealthStatus
+		expectedEvent string
+	}{
+		{
+			name:      "initial healthy state",
+			newStatus: lcmv1alpha1.CephDeploymentHealthStatus{State: lcmv1alpha1.HealthStateOk},
+		},
+		{
+			name:      "state is not changed",
+			oldState:  lcmv1alpha1.HealthStateFailed,
+			newStatus: lcmv1alpha1.CephDeploymentHealthStatus{State: lcmv1alpha1.HealthStateFailed, Issues: []string{"CEPH_HEALTH_WARN"}},
+		},
+		{
+			name:          "state changed to failed",
+			oldState:      lcmv1alpha1.HealthStateOk,
+			newStatus:     lcmv1alpha1.CephDeploymentHealthStatus{State: lcmv1alpha1.HealthStateFailed, Issues: []string{"CEPH_HEALTH_WARN", "mon quorum is not full"}},
+			expectedEvent: "Warning HealthStateChanged health state changed to Failed, issues: CEPH_HEALTH_WARN, mon quorum is not full",
+		},
+		{
+			name:          "state changed to ok",
+			oldState:      lcmv1alpha1.HealthStateFailed,
+			newStatus:     lcmv1alpha1.CephDeploymentHealthStatus{State: lcmv1alpha1.HealthStateOk},
+			expectedEvent: "Normal HealthStateChanged health state changed from Failed to Ok",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(1)
+			r := FakeReconciler()
+			r.Recorder = recorder
+			r.recordStateChangedEvent(&lcmv1alpha1.CephDeploymentHealth{}, test.oldState, test.newStatus)
+			event := ""
+			select {
+			case event = <-recorder.Events:
+			default:
+			}
+			assert.Equal(t, test.expectedEvent, event)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"fmt"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+const (
+	rookOperatorIssueSource = "rook_operator"
+	cephHealthIssueSource   = "ceph_health"
+	diskDaemonIssueSource   = "disk_daemon"
+	multisiteIssueSource    = "rgw_multisite"
+	// source for issues, which are not related to particular daemon
+	defaultIssueSource = "health_check"
+)
+
+// getHealthIssuesSources returns mapping of issues to daemons or checks, which raised them,
+// based on health report sections
+func getHealthIssuesSources(report *lcmv1alpha1.CephDeploymentHealthReport) map[string]string {
+	sources := map[string]string{}
+	if report == nil {
+		return sources
+	}
+	addSource := func(source string, issues []string) {
+		for _, issue := range issues {
+			if _, present := sources[issue]; !present {
+				sources[issue] = source
+			}
+		}
+	}
+	addSource(rookOperatorIssueSource, report.RookOperator.Issues)
+	if report.RookCephObjects != nil && report.RookCephObjects.CephCluster != nil && report.RookCephObjects.CephCluster.CephStatus != nil {
+		for check, details := range report.RookCephObjects.CephCluster.CephStatus.Details {
+			addSource(fmt.Sprintf("%s/%s", cephHealthIssueSource, check), []string{fmt.Sprintf("%s: %s", check, details.Message)})
+		}
+	}
+	if report.CephDaemons != nil {
+		for daemon, status := range report.CephDaemons.CephDaemons {
+			addSource(fmt.Sprintf("%s/%s", cephDaemonsCheck, daemon), status.Issues)
+		}
+		for daemon, status := range report.CephDaemons.CephCSIDaemons {
+			addSource(fmt.Sprintf("%s/%s", cephCSIDaemonsCheck, daemon), status.Issues)
+		}
+	}
+	if report.ClusterDetails != nil && report.ClusterDetails.RgwInfo != nil && report.ClusterDetails.RgwInfo.MultisiteDetails != nil {
+		addSource(multisiteIssueSource, report.ClusterDetails.RgwInfo.MultisiteDetails.Messages)
+	}
+	if report.OsdAnalysis != nil {
+		addSource(diskDaemonIssueSource, report.OsdAnalysis.DiskDaemon.Issues)
+		for node, status := range report.OsdAnalysis.SpecAnalysis {
+			addSource(fmt.Sprintf("%s/%s", specAnalysisCheck, node), status.Issues)
+		}
+	}
+	return sources
+}
+
+func getHealthIssueSource(sources map[string]string, issue string) string {
+	if source, present := sources[issue]; present {
+		return source
+	}
+	return defaultIssueSource
+}
+
+// updateHealthHistory returns health issues history updated with current issues: active issues
+// which are not found anymore are marked as resolved, new issues are added as active ones.
+// History is trimmed to the specified size, the oldest resolved issues are dropped first
+func updateHealthHistory(history []lcmv1alpha1.HealthIssueTransition, report *lcmv1alpha1.CephDeploymentHealthReport, issues []string, timeNow string, historySize int) []lcmv1alpha1.HealthIssueTransition {
+	currentIssues := map[string]bool{}
+	for _, issue := range issues {
+		currentIssues[issue] = true
+	}
+	newHistory := make([]lcmv1alpha1.HealthIssueTransition, 0, len(history)+len(issues))
+	activeIssues := map[string]bool{}
+	for _, transition := range history {
+		if transition.State == lcmv1alpha1.HealthIssueActive {
+			if currentIssues[transition.Issue] {
+				transition.LastSeen = timeNow
+				activeIssues[transition.Issue] = true
+			} else {
+				transition.State = lcmv1alpha1.HealthIssueResolved
+				transition.ResolvedAt = timeNow
+			}
+		}
+		newHistory = append(newHistory, transition)
+	}
+	sources := getHealthIssuesSources(report)
+	for _, issue := range issues {
+		if activeIssues[issue] {
+			continue
+		}
+		newHistory = append(newHistory, lcmv1alpha1.HealthIssueTransition{
+			Issue:     issue,
+			Source:    getHealthIssueSource(sources, issue),
+			State:     lcmv1alpha1.HealthIssueActive,
+			FirstSeen: timeNow,
+			LastSeen:  timeNow,
+		})
+		activeIssues[issue] = true
+	}
+	for historySize > 0 && len(newHistory) > historySize {
+		dropIdx := 0
+		for idx, transition := range newHistory {
+			if transition.State == lcmv1alpha1.HealthIssueResolved {
+				dropIdx = idx
+				break
+			}
+		}
+		newHistory = append(newHistory[:dropIdx], newHistory[dropIdx+1:]...)
+	}
+	if len(newHistory) == 0 {
+		return nil
+	}
+	return newHistory
+}
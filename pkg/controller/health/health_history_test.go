@@ -0,0 +1,134 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestGetHealthIssuesSources(t *testing.T) {
+	report := unitinputs.CephBaseClusterReportNotOk.DeepCopy()
+	report.RookOperator = unitinputs.RookOperatorStatusFailed
+	report.ClusterDetails.RgwInfo = &lcmv1alpha1.RgwInfo{
+		MultisiteDetails: &lcmv1alpha1.MultisiteState{
+			MetadataSyncState: lcmv1alpha1.MultiSiteOutOfSync,
+			DataSyncState:     lcmv1alpha1.MultiSiteSyncing,
+			Messages:          []string{"metadata is behind master zone"},
+		},
+	}
+	report.OsdAnalysis.SpecAnalysis = map[string]lcmv1alpha1.DaemonStatus{
+		"node-1": {Status: lcmv1alpha1.DaemonStateFailed, Issues: []string{"spec analysis failed for node 'node-1'"}},
+	}
+	expected := map[string]string{
+		"failed to get 'rook-ceph-operator' deployment in 'rook-ceph' namespace":       "rook_operator",
+		"RECENT_MGR_MODULE_CRASH: 2 mgr modules have recently crashed":                 "ceph_health/RECENT_MGR_MODULE_CRASH",
+		"not all (2/3) mons are running":                                               "ceph_daemons/mon",
+		"no active mgr":                                                                "ceph_daemons/mgr",
+		"not all osds are in":                                                          "ceph_daemons/osd",
+		"not all osds are up":                                                          "ceph_daemons/osd",
+		"deployment 'rook-ceph/ceph-csi-controller-manager' is not ready":              "ceph_csi_daemons/ceph-csi-operator",
+		"daemonset 'rook-ceph/rook-ceph.rbd.csi.ceph.com-nodeplugin' is not ready":     "ceph_csi_daemons/rook-ceph.rbd.csi.ceph.com-nodeplugin",
+		"daemonset 'rook-ceph/rook-ceph.cephfs.csi.ceph.com-nodeplugin' is not ready":  "ceph_csi_daemons/rook-ceph.cephfs.csi.ceph.com-nodeplugin",
+		"deployment 'rook-ceph/rook-ceph.rbd.csi.ceph.com-ctrlplugin' is not ready":    "ceph_csi_daemons/rook-ceph.rbd.csi.ceph.com-ctrlplugin",
+		"deployment 'rook-ceph/rook-ceph.cephfs.csi.ceph.com-ctrlplugin' is not ready": "ceph_csi_daemons/rook-ceph.cephfs.csi.ceph.com-ctrlplugin",
+		"metadata is behind master zone":                                               "rgw_multisite",
+		"daemonset 'lcm-namespace/pelagia-disk-daemon' is not ready":                   "disk_daemon",
+		"spec analysis failed for node 'node-1'":                                       "spec_analysis/node-1",
+	}
+	assert.Equal(t, expected, getHealthIssuesSources(report))
+	assert.Equal(t, map[string]string{}, getHealthIssuesSources(nil))
+}
+
+func TestUpdateHealthHistory(t *testing.T) {
+	report := &lcmv1alpha1.CephDeploymentHealthReport{
+		RookOperator: unitinputs.RookOperatorStatusOk,
+		CephDaemons:  &lcmv1alpha1.CephDaemonsStatus{CephDaemons: unitinputs.CephDaemonsBaseUnhealthy},
+	}
+	tests := []struct {
+		name            string
+		history         []lcmv1alpha1.HealthIssueTransition
+		issues          []string
+		historySize     int
+		expectedHistory []lcmv1alpha1.HealthIssueTransition
+	}{
+		{
+			name: "no history and no issues",
+		},
+		{
+			name:        "new issues appeared",
+			issues:      []string{"no active mgr", "not all osds are up", "some cluster issue"},
+			historySize: 10,
+			expectedHistory: []lcmv1alpha1.HealthIssueTransition{
+				{Issue: "no active mgr", Source: "ceph_daemons/mgr", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-now", LastSeen: "time-now"},
+				{Issue: "not all osds are up", Source: "ceph_daemons/osd", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-now", LastSeen: "time-now"},
+				{Issue: "some cluster issue", Source: "health_check", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-now", LastSeen: "time-now"},
+			},
+		},
+		{
+			name: "issues are still present, resolved and appeared again",
+			history: []lcmv1alpha1.HealthIssueTransition{
+				{Issue: "not all osds are up", Source: "ceph_daemons/osd", State: lcmv1alpha1.HealthIssueResolved, FirstSeen: "time-1", LastSeen: "time-2", ResolvedAt: "time-3"},
+				{Issue: "no active mgr", Source: "ceph_daemons/mgr", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-1", LastSeen: "time-3"},
+				{Issue: "some cluster issue", Source: "health_check", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-2", LastSeen: "time-3"},
+			},
+			issues:      []string{"no active mgr", "not all osds are up"},
+			historySize: 10,
+			expectedHistory: []lcmv1alpha1.HealthIssueTransition{
+				{Issue: "not all osds are up", Source: "ceph_daemons/osd", State: lcmv1alpha1.HealthIssueResolved, FirstSeen: "time-1", LastSeen: "time-2", ResolvedAt: "time-3"},
+				{Issue: "no active mgr", Source: "ceph_daemons/mgr", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-1", LastSeen: "time-now"},
+				{Issue: "some cluster issue", Source: "health_check", State: lcmv1alpha1.HealthIssueResolved, FirstSeen: "time-2", LastSeen: "time-3", ResolvedAt: "time-now"},
+				{Issue: "not all osds are up", Source: "ceph_daemons/osd", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-now", LastSeen: "time-now"},
+			},
+		},
+		{
+			name: "history is trimmed, resolved issues are dropped first",
+			history: []lcmv1alpha1.HealthIssueTransition{
+				{Issue: "no active mgr", Source: "ceph_daemons/mgr", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-1", LastSeen: "time-3"},
+				{Issue: "not all osds are up", Source: "ceph_daemons/osd", State: lcmv1alpha1.HealthIssueResolved, FirstSeen: "time-1", LastSeen: "time-2", ResolvedAt: "time-3"},
+				{Issue: "not all osds are in", Source: "ceph_daemons/osd", State: lcmv1alpha1.HealthIssueResolved, FirstSeen: "time-2", LastSeen: "time-2", ResolvedAt: "time-3"},
+			},
+			issues:      []string{"no active mgr", "not all (2/3) mons are running"},
+			historySize: 2,
+			expectedHistory: []lcmv1alpha1.HealthIssueTransition{
+				{Issue: "no active mgr", Source: "ceph_daemons/mgr", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-1", LastSeen: "time-now"},
+				{Issue: "not all (2/3) mons are running", Source: "ceph_daemons/mon", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-now", LastSeen: "time-now"},
+			},
+		},
+		{
+			name: "history is trimmed, no resolved issues",
+			history: []lcmv1alpha1.HealthIssueTransition{
+				{Issue: "no active mgr", Source: "ceph_daemons/mgr", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-1", LastSeen: "time-3"},
+			},
+			issues:      []string{"no active mgr", "not all osds are in"},
+			historySize: 1,
+			expectedHistory: []lcmv1alpha1.HealthIssueTransition{
+				{Issue: "not all osds are in", Source: "ceph_daemons/osd", State: lcmv1alpha1.HealthIssueActive, FirstSeen: "time-now", LastSeen: "time-now"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history := updateHealthHistory(test.history, report, test.issues, "time-now", test.historySize)
+			assert.Equal(t, test.expectedHistory, history)
+		})
+	}
+}
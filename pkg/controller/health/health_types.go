@@ -22,6 +22,7 @@ import (
 	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
 	"github.com/rs/zerolog"
 
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
 	lcmconfig "github.com/Mirantis/pelagia/v3/pkg/controller/config"
 )
 
@@ -41,6 +42,8 @@ type healthConfig struct {
 	rgwOpts              map[string]rgwOpts
 	multisiteOpts        multisiteOpts
 	sharedFilesystemOpts sharedFilesystemOpts
+	// 'ceph status' output, requested once and shared by checks
+	cephStatus *lcmcommon.CephStatus
 }
 
 type rgwOpts struct {
@@ -67,4 +70,13 @@ const (
 	poolReplicasCheck   = "pools_replicas"
 	rgwInfoCheck        = "rgw_info"
 	specAnalysisCheck   = "spec_analysis"
+	// ceph config options set outside of CephDeployment
+	cephConfigDriftCheck = "ceph_config_drift"
+	// projection of usage details to nearfull/full
+	capacityForecastCheck = "capacity_forecast"
+	// placement groups states and stuck placement groups
+	pgStatesCheck = "pg_states"
 )
+
+// ceph pool type for erasure coded pools in 'ceph osd pool ls detail' output
+const poolTypeErasureCoded = 3
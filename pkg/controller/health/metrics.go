@@ -0,0 +1,246 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+const (
+	metricsNamespace = "pelagia"
+	metricsSubsystem = "cephdeploymenthealth"
+
+	rebalanceEventLabel    = "rebalance"
+	pgAutoscalerEventLabel = "pg_autoscaler"
+	metadataSyncLabel      = "metadata"
+	dataSyncLabel          = "data"
+)
+
+var (
+	metricsObjectLabels = []string{"namespace", "name"}
+
+	healthStates = []lcmv1alpha1.CephDeploymentHealthState{
+		lcmv1alpha1.HealthStateOk,
+		lcmv1alpha1.HealthStateFailed,
+	}
+	daemonStates = []lcmv1alpha1.DaemonState{
+		lcmv1alpha1.DaemonStateOk,
+		lcmv1alpha1.DaemonStateFailed,
+		lcmv1alpha1.DaemonStateSkipped,
+	}
+	multisiteStates = []lcmv1alpha1.MultiSiteState{
+		lcmv1alpha1.MultiSiteSyncing,
+		lcmv1alpha1.MultiSiteOutOfSync,
+		lcmv1alpha1.MultiSiteFailed,
+	}
+
+	healthStateGauge = newHealthGaugeVec("state",
+		"Current CephDeploymentHealth state, set to 1 for the current state and 0 for others.", "state")
+	healthIssuesGauge = newHealthGaugeVec("issues",
+		"Number of active CephDeploymentHealth issues per health check.", "check")
+	daemonStatusGauge = newHealthGaugeVec("daemon_status",
+		"Current daemon status from CephDeploymentHealth report, set to 1 for the current status and 0 for others.", "check", "daemon", "status")
+	osdSpecAnalysisGauge = newHealthGaugeVec("osd_spec_analysis_status",
+		"Current OSD spec analysis status for node, set to 1 for the current status and 0 for others.", "node", "status")
+	poolUsedBytesGauge = newHealthGaugeVec("pool_used_bytes",
+		"Used bytes in Ceph pool.", "pool")
+	poolAvailableBytesGauge = newHealthGaugeVec("pool_available_bytes",
+		"Available bytes in Ceph pool.", "pool")
+	poolTotalBytesGauge = newHealthGaugeVec("pool_total_bytes",
+		"Total bytes in Ceph pool.", "pool")
+	poolUsedPercentGauge = newHealthGaugeVec("pool_used_percent",
+		"Percent of used bytes in Ceph pool.", "pool")
+	classUsedBytesGauge = newHealthGaugeVec("device_class_used_bytes",
+		"Used bytes for Ceph OSD device class.", "device_class")
+	classAvailableBytesGauge = newHealthGaugeVec("device_class_available_bytes",
+		"Available bytes for Ceph OSD device class.", "device_class")
+	classTotalBytesGauge = newHealthGaugeVec("device_class_total_bytes",
+		"Total bytes for Ceph OSD device class.", "device_class")
+	cephEventProgressingGauge = newHealthGaugeVec("ceph_event_progressing",
+		"Whether Ceph cluster event is in progress (1) or idle (0).", "event")
+	cephEventProgressGauge = newHealthGaugeVec("ceph_event_progress_ratio",
+		"Average progress of in progress Ceph cluster events, from 0 to 1.", "event")
+	multisiteSyncGauge = newHealthGaugeVec("rgw_multisite_sync_state",
+		"Current RGW multisite sync state, set to 1 for the current state and 0 for others.", "sync", "state")
+	multisiteMasterZoneGauge = newHealthGaugeVec("rgw_multisite_master_zone",
+		"Whether current RGW zone is master zone (1) or not (0).")
+	configDriftGauge = newHealthGaugeVec("config_drift_options",
+		"Number of drifted Ceph config options per config section and drift kind.", "section", "kind")
+
+	healthGauges = []*prometheus.GaugeVec{
+		healthStateGauge,
+		healthIssuesGauge,
+		daemonStatusGauge,
+		osdSpecAnalysisGauge,
+		poolUsedBytesGauge,
+		poolAvailableBytesGauge,
+		poolTotalBytesGauge,
+		poolUsedPercentGauge,
+		classUsedBytesGauge,
+		classAvailableBytesGauge,
+		classTotalBytesGauge,
+		cephEventProgressingGauge,
+		cephEventProgressGauge,
+		multisiteSyncGauge,
+		multisiteMasterZoneGauge,
+		configDriftGauge,
+	}
+)
+
+func init() {
+	for _, gauge := range healthGauges {
+		metrics.Registry.MustRegister(gauge)
+	}
+}
+
+func newHealthGaugeVec(name, help string, labels ...string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      name,
+		Help:      help,
+	}, append(append([]string{}, metricsObjectLabels...), labels...))
+}
+
+func boolToMetricValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func setStringMetricValue(gauge prometheus.Gauge, value string) {
+	if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+		gauge.Set(parsed)
+	}
+}
+
+func recordDaemonStatusMetric(namespace, name, check, daemon string, status lcmv1alpha1.DaemonStatus) {
+	if status.Status == "" {
+		return
+	}
+	for _, state := range daemonStates {
+		daemonStatusGauge.WithLabelValues(namespace, name, check, daemon, string(state)).Set(boolToMetricValue(state == status.Status))
+	}
+}
+
+func recordCephEventMetrics(namespace, name, event string, details lcmv1alpha1.CephEventDetails) {
+	if details.State == "" {
+		return
+	}
+	cephEventProgressingGauge.WithLabelValues(namespace, name, event).Set(boolToMetricValue(details.State == lcmv1alpha1.CephEventProgressing))
+	progress := 0.0
+	progressCount := 0
+	for _, message := range details.Messages {
+		if value, err := strconv.ParseFloat(message.Progress, 64); err == nil {
+			progress += value
+			progressCount++
+		}
+	}
+	if progressCount > 0 {
+		progress /= float64(progressCount)
+	}
+	cephEventProgressGauge.WithLabelValues(namespace, name, event).Set(progress)
+}
+
+func recordMultisiteSyncMetric(namespace, name, sync string, syncState lcmv1alpha1.MultiSiteState) {
+	if syncState == "" {
+		return
+	}
+	for _, state := range multisiteStates {
+		multisiteSyncGauge.WithLabelValues(namespace, name, sync, string(state)).Set(boolToMetricValue(state == syncState))
+	}
+}
+
+// recordHealthMetrics exports current CephDeploymentHealth status as metrics, previous
+// series are dropped, since report sections, pools and daemons may disappear
+func recordHealthMetrics(namespace, name string, status lcmv1alpha1.CephDeploymentHealthStatus) {
+	cleanupMetrics(namespace, name)
+	for _, state := range healthStates {
+		healthStateGauge.WithLabelValues(namespace, name, string(state)).Set(boolToMetricValue(state == status.State))
+	}
+	report := status.HealthReport
+	sources := getHealthIssuesSources(report)
+	for _, issue := range status.Issues {
+		check := strings.SplitN(getHealthIssueSource(sources, issue), "/", 2)[0]
+		healthIssuesGauge.WithLabelValues(namespace, name, check).Inc()
+	}
+	if report == nil {
+		return
+	}
+	recordDaemonStatusMetric(namespace, name, rookOperatorIssueSource, lcmcommon.RookCephOperatorName, report.RookOperator)
+	if report.CephDaemons != nil {
+		for daemon, daemonStatus := range report.CephDaemons.CephDaemons {
+			recordDaemonStatusMetric(namespace, name, cephDaemonsCheck, daemon, daemonStatus)
+		}
+		for daemon, daemonStatus := range report.CephDaemons.CephCSIDaemons {
+			recordDaemonStatusMetric(namespace, name, cephCSIDaemonsCheck, daemon, daemonStatus)
+		}
+	}
+	if report.OsdAnalysis != nil {
+		recordDaemonStatusMetric(namespace, name, diskDaemonIssueSource, lcmcommon.PelagiaDiskDaemon, report.OsdAnalysis.DiskDaemon)
+		for node, nodeStatus := range report.OsdAnalysis.SpecAnalysis {
+			for _, state := range daemonStates {
+				osdSpecAnalysisGauge.WithLabelValues(namespace, name, node, string(state)).Set(boolToMetricValue(state == nodeStatus.Status))
+			}
+		}
+	}
+	if report.ClusterDetails != nil {
+		if report.ClusterDetails.UsageDetails != nil {
+			for pool, stats := range report.ClusterDetails.UsageDetails.PoolsDetail {
+				setStringMetricValue(poolUsedBytesGauge.WithLabelValues(namespace, name, pool), stats.UsedBytes)
+				setStringMetricValue(poolAvailableBytesGauge.WithLabelValues(namespace, name, pool), stats.AvailableBytes)
+				setStringMetricValue(poolTotalBytesGauge.WithLabelValues(namespace, name, pool), stats.TotalBytes)
+				setStringMetricValue(poolUsedPercentGauge.WithLabelValues(namespace, name, pool), stats.UsedBytesPercentage)
+			}
+			for class, stats := range report.ClusterDetails.UsageDetails.ClassesDetail {
+				setStringMetricValue(classUsedBytesGauge.WithLabelValues(namespace, name, class), stats.UsedBytes)
+				setStringMetricValue(classAvailableBytesGauge.WithLabelValues(namespace, name, class), stats.AvailableBytes)
+				setStringMetricValue(classTotalBytesGauge.WithLabelValues(namespace, name, class), stats.TotalBytes)
+			}
+		}
+		if report.ClusterDetails.CephEvents != nil {
+			recordCephEventMetrics(namespace, name, rebalanceEventLabel, report.ClusterDetails.CephEvents.RebalanceDetails)
+			recordCephEventMetrics(namespace, name, pgAutoscalerEventLabel, report.ClusterDetails.CephEvents.PgAutoscalerDetails)
+		}
+		if report.ClusterDetails.RgwInfo != nil && report.ClusterDetails.RgwInfo.MultisiteDetails != nil {
+			multisite := report.ClusterDetails.RgwInfo.MultisiteDetails
+			recordMultisiteSyncMetric(namespace, name, metadataSyncLabel, multisite.MetadataSyncState)
+			recordMultisiteSyncMetric(namespace, name, dataSyncLabel, multisite.DataSyncState)
+			multisiteMasterZoneGauge.WithLabelValues(namespace, name).Set(boolToMetricValue(multisite.MasterZone))
+		}
+	}
+	for section, drift := range report.CephConfigDrift {
+		configDriftGauge.WithLabelValues(namespace, name, section, "unexpected").Set(float64(len(drift.Unexpected)))
+		configDriftGauge.WithLabelValues(namespace, name, section, "overridden").Set(float64(len(drift.Overridden)))
+		configDriftGauge.WithLabelValues(namespace, name, section, "missing").Set(float64(len(drift.Missing)))
+	}
+}
+
+func cleanupMetrics(namespace, name string) {
+	labels := prometheus.Labels{"namespace": namespace, "name": name}
+	for _, gauge := range healthGauges {
+		gauge.DeletePartialMatch(labels)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func readMetric(t *testing.T, metric prometheus.Metric) *dto.Metric {
+	out := &dto.Metric{}
+	err := metric.Write(out)
+	assert.Nil(t, err)
+	return out
+}
+
+func countMetricSeries(collector prometheus.Collector) int {
+	ch := make(chan prometheus.Metric, 100)
+	collector.Collect(ch)
+	close(ch)
+	return len(ch)
+}
+
+func resetMetrics() {
+	for _, gauge := range healthGauges {
+		gauge.Reset()
+	}
+}
+
+func TestRecordHealthMetrics(t *testing.T) {
+	resetMetrics()
+	gaugeValue := func(gauge *prometheus.GaugeVec, labels ...string) float64 {
+		return readMetric(t, gauge.WithLabelValues(append([]string{"lcm-namespace", "cephcluster"}, labels...)...)).GetGauge().GetValue()
+	}
+
+	report := unitinputs.CephBaseClusterReportNotOk.DeepCopy()
+	report.ClusterDetails.CephEvents = unitinputs.CephEventsProgressing
+	report.CephConfigDrift = map[string]lcmv1alpha1.CephConfigSectionDrift{
+		"global": {
+			Unexpected: []lcmv1alpha1.CephConfigOptionDrift{{Name: "osd_pool_default_size"}},
+			Missing:    []lcmv1alpha1.CephConfigOptionDrift{{Name: "mon_max_pg_per_osd"}, {Name: "mon_target_pg_per_osd"}},
+		},
+	}
+	recordHealthMetrics("lcm-namespace", "cephcluster", lcmv1alpha1.CephDeploymentHealthStatus{
+		State:        lcmv1alpha1.HealthStateFailed,
+		HealthReport: report,
+		Issues: []string{
+			"RECENT_MGR_MODULE_CRASH: 2 mgr modules have recently crashed",
+			"no active mgr",
+			"not all osds are in",
+			"not all osds are up",
+			"some cluster issue",
+		},
+	})
+	assert.Equal(t, 1.0, gaugeValue(healthStateGauge, "Failed"))
+	assert.Equal(t, 0.0, gaugeValue(healthStateGauge, "Ok"))
+	assert.Equal(t, 1.0, gaugeValue(healthIssuesGauge, "ceph_health"))
+	assert.Equal(t, 3.0, gaugeValue(healthIssuesGauge, "ceph_daemons"))
+	assert.Equal(t, 1.0, gaugeValue(healthIssuesGauge, "health_check"))
+	assert.Equal(t, 1.0, gaugeValue(daemonStatusGauge, "rook_operator", "rook-ceph-operator", "ok"))
+	assert.Equal(t, 1.0, gaugeValue(daemonStatusGauge, "ceph_daemons", "mgr", "failed"))
+	assert.Equal(t, 0.0, gaugeValue(daemonStatusGauge, "ceph_daemons", "mgr", "ok"))
+	assert.Equal(t, 1.0, gaugeValue(daemonStatusGauge, "ceph_csi_daemons", "rook-ceph.rbd.csi.ceph.com-nodeplugin", "failed"))
+	assert.Equal(t, 1.0, gaugeValue(daemonStatusGauge, "disk_daemon", "pelagia-disk-daemon", "failed"))
+	assert.Equal(t, 0, countMetricSeries(osdSpecAnalysisGauge))
+	assert.Equal(t, 12288.0, gaugeValue(poolUsedBytesGauge, "pool-hdd"))
+	assert.Equal(t, 104807084032.0, gaugeValue(poolAvailableBytesGauge, "pool-hdd"))
+	assert.Equal(t, 104807096320.0, gaugeValue(poolTotalBytesGauge, "pool-hdd"))
+	assert.Equal(t, 2, countMetricSeries(poolUsedPercentGauge))
+	assert.Equal(t, 81630961664.0, gaugeValue(classUsedBytesGauge, "hdd"))
+	assert.Equal(t, 428350242816.0, gaugeValue(classAvailableBytesGauge, "hdd"))
+	assert.Equal(t, 509981204480.0, gaugeValue(classTotalBytesGauge, "hdd"))
+	assert.Equal(t, 1.0, gaugeValue(cephEventProgressingGauge, "rebalance"))
+	assert.Equal(t, 0.948051929473877, gaugeValue(cephEventProgressGauge, "rebalance"))
+	assert.Equal(t, 1.0, gaugeValue(cephEventProgressingGauge, "pg_autoscaler"))
+	assert.Equal(t, 0, countMetricSeries(multisiteSyncGauge))
+	assert.Equal(t, 1.0, gaugeValue(configDriftGauge, "global", "unexpected"))
+	assert.Equal(t, 0.0, gaugeValue(configDriftGauge, "global", "overridden"))
+	assert.Equal(t, 2.0, gaugeValue(configDriftGauge, "global", "missing"))
+
+	// series for gone sections, pools and daemons are dropped
+	report = unitinputs.CephMultisiteClusterReportOk.DeepCopy()
+	report.ClusterDetails.RgwInfo.MultisiteDetails = unitinputs.CephMultisiteStateFailed
+	recordHealthMetrics("lcm-namespace", "cephcluster", lcmv1alpha1.CephDeploymentHealthStatus{
+		State:        lcmv1alpha1.HealthStateOk,
+		HealthReport: report,
+	})
+	assert.Equal(t, 0.0, gaugeValue(healthStateGauge, "Failed"))
+	assert.Equal(t, 1.0, gaugeValue(healthStateGauge, "Ok"))
+	assert.Equal(t, 0, countMetricSeries(healthIssuesGauge))
+	assert.Equal(t, 0, countMetricSeries(configDriftGauge))
+	assert.Equal(t, 13, countMetricSeries(poolUsedBytesGauge))
+	assert.Equal(t, 2, countMetricSeries(classTotalBytesGauge))
+	assert.Equal(t, 0.0, gaugeValue(cephEventProgressingGauge, "rebalance"))
+	assert.Equal(t, 0.0, gaugeValue(cephEventProgressGauge, "rebalance"))
+	assert.Equal(t, 1.0, gaugeValue(osdSpecAnalysisGauge, "node-1", "ok"))
+	assert.Equal(t, 0.0, gaugeValue(osdSpecAnalysisGauge, "node-2", "failed"))
+	assert.Equal(t, 1.0, gaugeValue(daemonStatusGauge, "disk_daemon", "pelagia-disk-daemon", "ok"))
+	assert.Equal(t, 1.0, gaugeValue(multisiteSyncGauge, "metadata", "failed"))
+	assert.Equal(t, 0.0, gaugeValue(multisiteSyncGauge, "data", "syncing"))
+	assert.Equal(t, 0.0, gaugeValue(multisiteMasterZoneGauge))
+
+	cleanupMetrics("lcm-namespace", "cephcluster")
+	for _, gauge := range healthGauges {
+		assert.Equal(t, 0, countMetricSeries(gauge))
+	}
+}
@@ -0,0 +1,422 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmconfig "github.com/Mirantis/pelagia/v3/pkg/controller/config"
+)
+
+const (
+	notificationIssueAppeared   = "IssueAppeared"
+	notificationIssueResolved   = "IssueResolved"
+	notificationSeverityChanged = "SeverityChanged"
+
+	notificationSeverityWarning  = "warning"
+	notificationSeverityCritical = "critical"
+
+	webhookSink      = "webhook"
+	alertmanagerSink = "alertmanager"
+
+	alertmanagerAlertsPath = "/api/v2/alerts"
+	alertmanagerAlertName  = "CephDeploymentHealthIssue"
+	// firing alerts are resent to Alertmanager, otherwise Alertmanager
+	// resolves them by itself after 'resolve_timeout', which is 5m by default
+	alertmanagerRefreshInterval = 2 * time.Minute
+
+	// max number of not sent events kept for each sink
+	maxPendingNotificationEvents = 100
+)
+
+type healthNotificationEvent struct {
+	Type             string `json:"type"`
+	Issue            string `json:"issue"`
+	Source           string `json:"source"`
+	Severity         string `json:"severity"`
+	PreviousSeverity string `json:"previousSeverity,omitempty"`
+	Timestamp        string `json:"timestamp"`
+}
+
+// webhookNotification is a json payload for generic webhook sink
+type webhookNotification struct {
+	Namespace string                                `json:"namespace"`
+	Name      string                                `json:"name"`
+	State     lcmv1alpha1.CephDeploymentHealthState `json:"state"`
+	Events    []healthNotificationEvent             `json:"events"`
+}
+
+// alertmanagerAlert is a postable alert for Alertmanager v2 API
+type alertmanagerAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	StartsAt    string            `json:"startsAt,omitempty"`
+	EndsAt      string            `json:"endsAt,omitempty"`
+}
+
+// healthNotifierState is a notifications state for a CephDeploymentHealth object
+type healthNotifierState struct {
+	// last time when event was notified, used for events deduplication
+	notified map[string]time.Time
+	// sinks requests times for the last minute, used for rate limiting
+	requests map[string][]time.Time
+	// events which are not sent yet due to rate limiting or sink failures
+	pending map[string][]healthNotificationEvent
+	// last successful request time for each sink
+	lastSent map[string]time.Time
+	// active issues are changed, but not sent to Alertmanager yet
+	alertsChanged bool
+}
+
+var (
+	healthNotifiers     = map[string]*healthNotifierState{}
+	healthNotifiersLock sync.Mutex
+
+	notificationsHTTPClient = &http.Client{Timeout: 10 * time.Second}
+	getNotificationTime     = time.Now
+)
+
+// getHealthIssuesSeverities returns severities for issues raised by Ceph health checks,
+// all other issues have warning severity
+func getHealthIssuesSeverities(report *lcmv1alpha1.CephDeploymentHealthReport) map[string]string {
+	severities := map[string]string{}
+	if report == nil || report.RookCephObjects == nil || report.RookCephObjects.CephCluster == nil || report.RookCephObjects.CephCluster.CephStatus == nil {
+		return severities
+	}
+	for check, details := range report.RookCephObjects.CephCluster.CephStatus.Details {
+		severity := notificationSeverityWarning
+		if details.Severity == "HEALTH_ERR" {
+			severity = notificationSeverityCritical
+		}
+		severities[fmt.Sprintf("%s: %s", check, details.Message)] = severity
+	}
+	return severities
+}
+
+func getHealthIssueSeverity(severities map[string]string, issue string) string {
+	if severity, present := severities[issue]; present {
+		return severity
+	}
+	return notificationSeverityWarning
+}
+
+// getHealthNotificationEvents returns events for new issues, resolved issues and issues with changed severity
+func getHealthNotificationEvents(oldStatus, newStatus lcmv1alpha1.CephDeploymentHealthStatus, timeNow string) []healthNotificationEvent {
+	oldIssues := map[string]bool{}
+	for _, issue := range oldStatus.Issues {
+		oldIssues[issue] = true
+	}
+	newIssues := map[string]bool{}
+	for _, issue := range newStatus.Issues {
+		newIssues[issue] = true
+	}
+	oldSeverities := getHealthIssuesSeverities(oldStatus.HealthReport)
+	newSeverities := getHealthIssuesSeverities(newStatus.HealthReport)
+	newSources := getHealthIssuesSources(newStatus.HealthReport)
+	events := []healthNotificationEvent{}
+	for _, issue := range newStatus.Issues {
+		event := healthNotificationEvent{
+			Issue:     issue,
+			Source:    getHealthIssueSource(newSources, issue),
+			Severity:  getHealthIssueSeverity(newSeverities, issue),
+			Timestamp: timeNow,
+		}
+		if !oldIssues[issue] {
+			event.Type = notificationIssueAppeared
+		} else if previousSeverity := getHealthIssueSeverity(oldSeverities, issue); previousSeverity != event.Severity {
+			event.Type = notificationSeverityChanged
+			event.PreviousSeverity = previousSeverity
+		} else {
+			continue
+		}
+		events = append(events, event)
+	}
+	oldSources := getHealthIssuesSources(oldStatus.HealthReport)
+	for _, issue := range oldStatus.Issues {
+		if newIssues[issue] {
+			continue
+		}
+		events = append(events, healthNotificationEvent{
+			Type:      notificationIssueResolved,
+			Issue:     issue,
+			Source:    getHealthIssueSource(oldSources, issue),
+			Severity:  getHealthIssueSeverity(oldSeverities, issue),
+			Timestamp: timeNow,
+		})
+	}
+	return events
+}
+
+// getHealthNotificationSinks returns configured sinks with urls to send notifications
+func getHealthNotificationSinks(healthParams *lcmconfig.HealthParams) map[string]string {
+	sinks := map[string]string{}
+	if healthParams.NotificationsWebhookURL != "" {
+		sinks[webhookSink] = healthParams.NotificationsWebhookURL
+	}
+	if healthParams.NotificationsAlertmanagerURL != "" {
+		sinks[alertmanagerSink] = strings.TrimSuffix(healthParams.NotificationsAlertmanagerURL, "/") + alertmanagerAlertsPath
+	}
+	return sinks
+}
+
+func getHealthNotifierState(key string) *healthNotifierState {
+	state, present := healthNotifiers[key]
+	if !present {
+		state = &healthNotifierState{
+			notified: map[string]time.Time{},
+			requests: map[string][]time.Time{},
+			pending:  map[string][]healthNotificationEvent{},
+			lastSent: map[string]time.Time{},
+		}
+		healthNotifiers[key] = state
+	}
+	return state
+}
+
+// deduplicate drops events which were already notified during dedup interval
+func (s *healthNotifierState) deduplicate(events []healthNotificationEvent, now time.Time, interval time.Duration) []healthNotificationEvent {
+	for key, notified := range s.notified {
+		if now.Sub(notified) >= interval {
+			delete(s.notified, key)
+		}
+	}
+	newEvents := []healthNotificationEvent{}
+	for _, event := range events {
+		key := fmt.Sprintf("%s|%s|%s", event.Type, event.Severity, event.Issue)
+		if _, present := s.notified[key]; present {
+			continue
+		}
+		if interval > 0 {
+			s.notified[key] = now
+		}
+		newEvents = append(newEvents, event)
+	}
+	return newEvents
+}
+
+// allowRequest checks that sink requests rate limit is not reached
+func (s *healthNotifierState) allowRequest(sink string, now time.Time, rateLimit int) bool {
+	requests := []time.Time{}
+	for _, requestTime := range s.requests[sink] {
+		if now.Sub(requestTime) < time.Minute {
+			requests = append(requests, requestTime)
+		}
+	}
+	s.requests[sink] = requests
+	if len(requests) >= rateLimit {
+		return false
+	}
+	s.requests[sink] = append(requests, now)
+	return true
+}
+
+func prepareWebhookNotification(deploymentHealth *lcmv1alpha1.CephDeploymentHealth, status lcmv1alpha1.CephDeploymentHealthStatus, events []healthNotificationEvent) ([]byte, error) {
+	return json.Marshal(webhookNotification{
+		Namespace: deploymentHealth.Namespace,
+		Name:      deploymentHealth.Name,
+		State:     status.State,
+		Events:    events,
+	})
+}
+
+// prepareAlertmanagerNotification prepares firing alerts for all active issues
+// and resolved alerts for resolved issues and issues with changed severity
+func prepareAlertmanagerNotification(deploymentHealth *lcmv1alpha1.CephDeploymentHealth, status lcmv1alpha1.CephDeploymentHealthStatus, events []healthNotificationEvent) ([]byte, error) {
+	newAlert := func(issue, source, severity string) alertmanagerAlert {
+		return alertmanagerAlert{
+			Labels: map[string]string{
+				"alertname":            alertmanagerAlertName,
+				"namespace":            deploymentHealth.Namespace,
+				"cephdeploymenthealth": deploymentHealth.Name,
+				"source":               source,
+				"severity":             severity,
+				"issue":                issue,
+			},
+			Annotations: map[string]string{
+				"summary": issue,
+			},
+		}
+	}
+	firstSeen := map[string]string{}
+	for _, transition := range status.History {
+		if transition.State == lcmv1alpha1.HealthIssueActive {
+			firstSeen[transition.Issue] = transition.FirstSeen
+		}
+	}
+	sources := getHealthIssuesSources(status.HealthReport)
+	severities := getHealthIssuesSeverities(status.HealthReport)
+	alerts := []alertmanagerAlert{}
+	for _, issue := range status.Issues {
+		alert := newAlert(issue, getHealthIssueSource(sources, issue), getHealthIssueSeverity(severities, issue))
+		alert.StartsAt = firstSeen[issue]
+		alerts = append(alerts, alert)
+	}
+	for _, event := range events {
+		switch event.Type {
+		case notificationIssueResolved:
+			alert := newAlert(event.Issue, event.Source, event.Severity)
+			alert.EndsAt = event.Timestamp
+			alerts = append(alerts, alert)
+		case notificationSeverityChanged:
+			alert := newAlert(event.Issue, event.Source, event.PreviousSeverity)
+			alert.EndsAt = event.Timestamp
+			alerts = append(alerts, alert)
+		}
+	}
+	return json.Marshal(alerts)
+}
+
+func sendNotification(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to prepare request to '%s'", url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := notificationsHTTPClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send request to '%s'", url)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("request to '%s' failed with status '%s': %s", url, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
+
+// healthNotification is a prepared request to a notification sink
+type healthNotification struct {
+	sink   string
+	url    string
+	body   []byte
+	events int
+}
+
+// sendHealthNotifications sends health issues changes to configured notification sinks,
+// notifications are not critical for health status, so failures are only reported.
+// Notifications are prepared under the lock, but sent outside it to not block other objects
+func (r *ReconcileCephDeploymentHealth) sendHealthNotifications(ctx context.Context, objlog zerolog.Logger, deploymentHealth *lcmv1alpha1.CephDeploymentHealth, oldStatus, newStatus lcmv1alpha1.CephDeploymentHealthStatus, healthParams *lcmconfig.HealthParams) {
+	sinks := getHealthNotificationSinks(healthParams)
+	if len(sinks) == 0 {
+		return
+	}
+	key := fmt.Sprintf("%s/%s", deploymentHealth.Namespace, deploymentHealth.Name)
+	now := getNotificationTime()
+	notifications := prepareHealthNotifications(objlog, key, deploymentHealth, oldStatus, newStatus, healthParams, sinks, now)
+	sent := []healthNotification{}
+	for _, notification := range notifications {
+		if err := sendNotification(ctx, notification.url, notification.body); err != nil {
+			objlog.Error().Err(err).Msgf("failed to send health notification to %s sink", notification.sink)
+			continue
+		}
+		objlog.Info().Msgf("sent health notification with %d events to %s sink", notification.events, notification.sink)
+		sent = append(sent, notification)
+	}
+	if len(sent) == 0 {
+		return
+	}
+	healthNotifiersLock.Lock()
+	defer healthNotifiersLock.Unlock()
+	state, present := healthNotifiers[key]
+	if !present {
+		// notifier is cleaned up, since object is removed
+		return
+	}
+	for _, notification := range sent {
+		// keep events, which are added while notification is sending
+		if len(state.pending[notification.sink]) > notification.events {
+			state.pending[notification.sink] = state.pending[notification.sink][notification.events:]
+		} else {
+			state.pending[notification.sink] = nil
+		}
+		state.lastSent[notification.sink] = now
+		if notification.sink == alertmanagerSink {
+			state.alertsChanged = false
+		}
+	}
+}
+
+// prepareHealthNotifications updates notifier state with new events and returns notifications for sinks,
+// which have pending events or should be refreshed and which rate limit is not reached
+func prepareHealthNotifications(objlog zerolog.Logger, key string, deploymentHealth *lcmv1alpha1.CephDeploymentHealth, oldStatus, newStatus lcmv1alpha1.CephDeploymentHealthStatus,
+	healthParams *lcmconfig.HealthParams, sinks map[string]string, now time.Time) []healthNotification {
+	healthNotifiersLock.Lock()
+	defer healthNotifiersLock.Unlock()
+	state := getHealthNotifierState(key)
+	events := state.deduplicate(getHealthNotificationEvents(oldStatus, newStatus, newStatus.LastHealthCheck), now, healthParams.NotificationsDedupInterval)
+	notifications := []healthNotification{}
+	for _, sink := range []string{webhookSink, alertmanagerSink} {
+		url, present := sinks[sink]
+		if !present {
+			delete(state.pending, sink)
+			continue
+		}
+		pending := append(state.pending[sink], events...)
+		if len(pending) > maxPendingNotificationEvents {
+			objlog.Warn().Msgf("dropping %d oldest not sent notification events for %s sink", len(pending)-maxPendingNotificationEvents, sink)
+			pending = pending[len(pending)-maxPendingNotificationEvents:]
+		}
+		state.pending[sink] = pending
+		// Alertmanager deduplicates alerts by itself, so firing alerts are sent on any active issues change
+		refresh := false
+		if sink == alertmanagerSink {
+			if !reflect.DeepEqual(oldStatus.Issues, newStatus.Issues) {
+				state.alertsChanged = true
+			}
+			refresh = state.alertsChanged || (len(newStatus.Issues) > 0 && now.Sub(state.lastSent[sink]) >= alertmanagerRefreshInterval)
+		}
+		if len(pending) == 0 && !refresh {
+			continue
+		}
+		if !state.allowRequest(sink, now, healthParams.NotificationsRateLimit) {
+			objlog.Warn().Msgf("notifications rate limit for %s sink is reached, %d events are postponed", sink, len(pending))
+			continue
+		}
+		var body []byte
+		var err error
+		if sink == alertmanagerSink {
+			body, err = prepareAlertmanagerNotification(deploymentHealth, newStatus, pending)
+		} else {
+			body, err = prepareWebhookNotification(deploymentHealth, newStatus, pending)
+		}
+		if err != nil {
+			objlog.Error().Err(err).Msgf("failed to prepare health notification for %s sink", sink)
+			continue
+		}
+		notifications = append(notifications, healthNotification{sink: sink, url: url, body: body, events: len(pending)})
+	}
+	return notifications
+}
+
+func cleanupHealthNotifier(namespace, name string) {
+	healthNotifiersLock.Lock()
+	defer healthNotifiersLock.Unlock()
+	delete(healthNotifiers, fmt.Sprintf("%s/%s", namespace, name))
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmconfig "github.com/Mirantis/pelagia/v3/pkg/controller/config"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func getHealthStatusForNotifications(lastCheck string, cephHealthDetails map[string]cephv1.CephHealthMessage, daemons map[string]lcmv1alpha1.DaemonStatus, issues ...string) lcmv1alpha1.CephDeploymentHealthStatus {
+	status := lcmv1alpha1.CephDeploymentHealthStatus{
+		State: lcmv1alpha1.HealthStateOk,
+		HealthReport: &lcmv1alpha1.CephDeploymentHealthReport{
+			RookOperator: unitinputs.RookOperatorStatusOk,
+			RookCephObjects: &lcmv1alpha1.RookCephObjectsStatus{
+				CephCluster: &cephv1.ClusterStatus{
+					CephStatus: &cephv1.CephStatus{Health: "HEALTH_OK", Details: cephHealthDetails},
+				},
+			},
+			CephDaemons: &lcmv1alpha1.CephDaemonsStatus{CephDaemons: daemons},
+		},
+		LastHealthCheck:  lastCheck,
+		LastHealthUpdate: lastCheck,
+	}
+	if len(issues) > 0 {
+		status.State = lcmv1alpha1.HealthStateFailed
+		status.Issues = issues
+		status.HealthReport.RookCephObjects.CephCluster.CephStatus.Health = "HEALTH_WARN"
+	}
+	status.History = updateHealthHistory(nil, status.HealthReport, issues, lastCheck, 10)
+	return status
+}
+
+func TestGetHealthNotificationEvents(t *testing.T) {
+	oldStatus := getHealthStatusForNotifications("time-1",
+		map[string]cephv1.CephHealthMessage{"PG_DEGRADED": {Severity: "HEALTH_WARN", Message: "Degraded data redundancy"}},
+		map[string]lcmv1alpha1.DaemonStatus{"mgr": {Status: lcmv1alpha1.DaemonStateFailed, Issues: []string{"no active mgr"}}},
+		"PG_DEGRADED: Degraded data redundancy", "no active mgr")
+	newStatus := getHealthStatusForNotifications("time-2",
+		map[string]cephv1.CephHealthMessage{"PG_DEGRADED": {Severity: "HEALTH_ERR", Message: "Degraded data redundancy"}},
+		map[string]lcmv1alpha1.DaemonStatus{"osd": {Status: lcmv1alpha1.DaemonStateFailed, Issues: []string{"not all osds are up"}}},
+		"PG_DEGRADED: Degraded data redundancy", "not all osds are up")
+
+	expected := []healthNotificationEvent{
+		{Type: "SeverityChanged", Issue: "PG_DEGRADED: Degraded data redundancy", Source: "ceph_health/PG_DEGRADED", Severity: "critical", PreviousSeverity: "warning", Timestamp: "time-2"},
+		{Type: "IssueAppeared", Issue: "not all osds are up", Source: "ceph_daemons/osd", Severity: "warning", Timestamp: "time-2"},
+		{Type: "IssueResolved", Issue: "no active mgr", Source: "ceph_daemons/mgr", Severity: "warning", Timestamp: "time-2"},
+	}
+	assert.Equal(t, expected, getHealthNotificationEvents(oldStatus, newStatus, "time-2"))
+	assert.Equal(t, []healthNotificationEvent{}, getHealthNotificationEvents(newStatus, newStatus, "time-3"))
+}
+
+func TestSendHealthNotifications(t *testing.T) {
+	failRequests := false
+	requests := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		requests[req.URL.Path] = string(body)
+		if failRequests {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	healthParams := &lcmconfig.HealthParams{
+		NotificationsWebhookURL:      server.URL + "/webhook",
+		NotificationsAlertmanagerURL: server.URL + "/",
+		NotificationsDedupInterval:   15 * time.Minute,
+		NotificationsRateLimit:       1,
+	}
+	mgrDown := map[string]lcmv1alpha1.DaemonStatus{"mgr": {Status: lcmv1alpha1.DaemonStateFailed, Issues: []string{"no active mgr"}}}
+	osdDown := map[string]lcmv1alpha1.DaemonStatus{
+		"mgr": {Status: lcmv1alpha1.DaemonStateFailed, Issues: []string{"no active mgr"}},
+		"osd": {Status: lcmv1alpha1.DaemonStateFailed, Issues: []string{"not all osds are up"}},
+	}
+	okStatus := getHealthStatusForNotifications("time-0", nil, nil)
+	tests := []struct {
+		name             string
+		oldStatus        lcmv1alpha1.CephDeploymentHealthStatus
+		newStatus        lcmv1alpha1.CephDeploymentHealthStatus
+		secondsPassed    int
+		failRequests     bool
+		expectedRequests map[string]string
+	}{
+		{
+			name:      "new issue is notified",
+			oldStatus: okStatus,
+			newStatus: getHealthStatusForNotifications("time-1", nil, mgrDown, "no active mgr"),
+			expectedRequests: map[string]string{
+				"/webhook":       `{"namespace":"lcm-namespace","name":"cephcluster","state":"Failed","events":[{"type":"IssueAppeared","issue":"no active mgr","source":"ceph_daemons/mgr","severity":"warning","timestamp":"time-1"}]}`,
+				"/api/v2/alerts": `[{"labels":{"alertname":"CephDeploymentHealthIssue","cephdeploymenthealth":"cephcluster","issue":"no active mgr","namespace":"lcm-namespace","severity":"warning","source":"ceph_daemons/mgr"},"annotations":{"summary":"no active mgr"},"startsAt":"time-1"}]`,
+			},
+		},
+		{
+			name:             "resolved issue is postponed due to rate limit",
+			oldStatus:        getHealthStatusForNotifications("time-1", nil, mgrDown, "no active mgr"),
+			newStatus:        getHealthStatusForNotifications("time-2", nil, nil),
+			secondsPassed:    30,
+			expectedRequests: map[string]string{},
+		},
+		{
+			name:          "postponed events are sent",
+			oldStatus:     getHealthStatusForNotifications("time-2", nil, nil),
+			newStatus:     getHealthStatusForNotifications("time-3", nil, nil),
+			secondsPassed: 90,
+			expectedRequests: map[string]string{
+				"/webhook":       `{"namespace":"lcm-namespace","name":"cephcluster","state":"Ok","events":[{"type":"IssueResolved","issue":"no active mgr","source":"ceph_daemons/mgr","severity":"warning","timestamp":"time-2"}]}`,
+				"/api/v2/alerts": `[{"labels":{"alertname":"CephDeploymentHealthIssue","cephdeploymenthealth":"cephcluster","issue":"no active mgr","namespace":"lcm-namespace","severity":"warning","source":"ceph_daemons/mgr"},"annotations":{"summary":"no active mgr"},"endsAt":"time-2"}]`,
+			},
+		},
+		{
+			name:          "flapping issue is deduplicated, alerts are updated",
+			oldStatus:     getHealthStatusForNotifications("time-3", nil, nil),
+			newStatus:     getHealthStatusForNotifications("time-4", nil, mgrDown, "no active mgr"),
+			secondsPassed: 180,
+			expectedRequests: map[string]string{
+				"/api/v2/alerts": `[{"labels":{"alertname":"CephDeploymentHealthIssue","cephdeploymenthealth":"cephcluster","issue":"no active mgr","namespace":"lcm-namespace","severity":"warning","source":"ceph_daemons/mgr"},"annotations":{"summary":"no active mgr"},"startsAt":"time-4"}]`,
+			},
+		},
+		{
+			name:          "sinks failed, events are kept",
+			oldStatus:     getHealthStatusForNotifications("time-4", nil, mgrDown, "no active mgr"),
+			newStatus:     getHealthStatusForNotifications("time-5", nil, osdDown, "no active mgr", "not all osds are up"),
+			secondsPassed: 300,
+			failRequests:  true,
+			expectedRequests: map[string]string{
+				"/webhook":       `{"namespace":"lcm-namespace","name":"cephcluster","state":"Failed","events":[{"type":"IssueAppeared","issue":"not all osds are up","source":"ceph_daemons/osd","severity":"warning","timestamp":"time-5"}]}`,
+				"/api/v2/alerts": `[{"labels":{"alertname":"CephDeploymentHealthIssue","cephdeploymenthealth":"cephcluster","issue":"no active mgr","namespace":"lcm-namespace","severity":"warning","source":"ceph_daemons/mgr"},"annotations":{"summary":"no active mgr"},"startsAt":"time-5"},{"labels":{"alertname":"CephDeploymentHealthIssue","cephdeploymenthealth":"cephcluster","issue":"not all osds are up","namespace":"lcm-namespace","severity":"warning","source":"ceph_daemons/osd"},"annotations":{"summary":"not all osds are up"},"startsAt":"time-5"}]`,
+			},
+		},
+		{
+			name:          "sinks are recovered, kept events are sent",
+			oldStatus:     getHealthStatusForNotifications("time-5", nil, osdDown, "no active mgr", "not all osds are up"),
+			newStatus:     getHealthStatusForNotifications("time-6", nil, osdDown, "no active mgr", "not all osds are up"),
+			secondsPassed: 400,
+			expectedRequests: map[string]string{
+				"/webhook":       `{"namespace":"lcm-namespace","name":"cephcluster","state":"Failed","events":[{"type":"IssueAppeared","issue":"not all osds are up","source":"ceph_daemons/osd","severity":"warning","timestamp":"time-5"}]}`,
+				"/api/v2/alerts": `[{"labels":{"alertname":"CephDeploymentHealthIssue","cephdeploymenthealth":"cephcluster","issue":"no active mgr","namespace":"lcm-namespace","severity":"warning","source":"ceph_daemons/mgr"},"annotations":{"summary":"no active mgr"},"startsAt":"time-6"},{"labels":{"alertname":"CephDeploymentHealthIssue","cephdeploymenthealth":"cephcluster","issue":"not all osds are up","namespace":"lcm-namespace","severity":"warning","source":"ceph_daemons/osd"},"annotations":{"summary":"not all osds are up"},"startsAt":"time-6"}]`,
+			},
+		},
+		{
+			name:             "no changes, nothing to send",
+			oldStatus:        getHealthStatusForNotifications("time-6", nil, osdDown, "no active mgr", "not all osds are up"),
+			newStatus:        getHealthStatusForNotifications("time-7", nil, osdDown, "no active mgr", "not all osds are up"),
+			secondsPassed:    460,
+			expectedRequests: map[string]string{},
+		},
+	}
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldTimeFunc := getNotificationTime
+	healthNotifiers = map[string]*healthNotifierState{}
+	r := FakeReconciler()
+	deploymentHealth := unitinputs.CephDeploymentHealth.DeepCopy()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			getNotificationTime = func() time.Time {
+				return baseTime.Add(time.Duration(test.secondsPassed) * time.Second)
+			}
+			failRequests = test.failRequests
+			requests = map[string]string{}
+			r.sendHealthNotifications(context.TODO(), log, deploymentHealth, test.oldStatus, test.newStatus, healthParams)
+			assert.Equal(t, test.expectedRequests, requests)
+		})
+	}
+	assert.Contains(t, healthNotifiers, "lcm-namespace/cephcluster")
+	cleanupHealthNotifier(deploymentHealth.Namespace, deploymentHealth.Name)
+	assert.Equal(t, map[string]*healthNotifierState{}, healthNotifiers)
+	getNotificationTime = oldTimeFunc
+}
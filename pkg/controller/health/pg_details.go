@@ -0,0 +1,223 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+const (
+	pgStateActive   = "active"
+	pgStateInactive = "inactive"
+	pgStateStale    = "stale"
+	// max number of stuck placement groups listed in health report
+	maxStuckPgsInReport = 50
+	// timestamps format in 'ceph pg' commands output
+	cephTimestampLayout = "2006-01-02T15:04:05.999999-0700"
+
+	cephStatusCmd  = "ceph status -f json"
+	pgDumpStuckCmd = "ceph pg dump_stuck inactive unclean undersized degraded stale -f json"
+)
+
+var pgStatesToCount = []string{"undersized", "degraded", "incomplete", "inconsistent"}
+
+// getPgStatesCount returns number of placement groups in inactive and other states
+// which require attention, based on placement groups count by state from ceph status,
+// a single pg may be counted for several states
+func getPgStatesCount(pgsByState []lcmcommon.PgStateCount) map[string]int {
+	statesCount := map[string]int{}
+	for _, pgState := range pgsByState {
+		if pgState.Count == 0 {
+			continue
+		}
+		states := strings.Split(pgState.StateName, "+")
+		if !lcmcommon.Contains(states, pgStateActive) {
+			statesCount[pgStateInactive] += pgState.Count
+		}
+		for _, state := range pgStatesToCount {
+			if lcmcommon.Contains(states, state) {
+				statesCount[state] += pgState.Count
+			}
+		}
+	}
+	return statesCount
+}
+
+// getPgStuckSince returns time when placement group was last seen in state, for which it is stuck now
+func getPgStuckSince(pg lcmcommon.PgStats) string {
+	states := strings.Split(pg.State, "+")
+	lastSeen := pg.LastClean
+	switch {
+	case lcmcommon.Contains(states, pgStateStale):
+		lastSeen = pg.LastUnstale
+	case !lcmcommon.Contains(states, pgStateActive):
+		lastSeen = pg.LastActive
+	case lcmcommon.Contains(states, "undersized"):
+		lastSeen = pg.LastFullsized
+	case lcmcommon.Contains(states, "degraded"):
+		lastSeen = pg.LastUndegraded
+	}
+	parsed, err := time.Parse(cephTimestampLayout, lastSeen)
+	if err != nil {
+		return ""
+	}
+	return parsed.UTC().Format(time.RFC3339)
+}
+
+func (c *cephDeploymentHealthConfig) getStuckPgs() ([]lcmcommon.PgStats, error) {
+	output, err := lcmcommon.RunCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, pgDumpStuckCmd)
+	if err != nil {
+		return nil, err
+	}
+	// command has no output when there are no stuck pgs
+	if strings.TrimSpace(output) == "" {
+		return nil, nil
+	}
+	var stuckList lcmcommon.PgStuckList
+	err = json.Unmarshal([]byte(output), &stuckList)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse output for command '%s'", pgDumpStuckCmd)
+	}
+	return stuckList.StuckPgStats, nil
+}
+
+// getPoolsAndHostsMapping returns pool names by pool ids and hosts by osd ids
+func (c *cephDeploymentHealthConfig) getPoolsAndHostsMapping() (map[string]string, map[int]string, string) {
+	poolsDetail := []struct {
+		ID   int    `json:"pool_id"`
+		Name string `json:"pool_name"`
+	}{}
+	cmd := "ceph osd pool ls detail -f json"
+	err := lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd, &poolsDetail)
+	if err != nil {
+		c.log.Error().Err(err).Msg("")
+		return nil, nil, fmt.Sprintf("failed to run '%s' command to check stuck placement groups", cmd)
+	}
+	var osdTree lcmcommon.OsdTree
+	cmd = "ceph osd tree -f json"
+	err = lcmcommon.RunAndParseCephToolboxCLI(c.context, c.api.Kubeclientset, c.api.Config, c.lcmConfig.RookNamespace, cmd, &osdTree)
+	if err != nil {
+		c.log.Error().Err(err).Msg("")
+		return nil, nil, fmt.Sprintf("failed to run '%s' command to check stuck placement groups", cmd)
+	}
+	pools := map[string]string{}
+	for _, pool := range poolsDetail {
+		pools[strconv.Itoa(pool.ID)] = pool.Name
+	}
+	hosts := map[int]string{}
+	for _, node := range osdTree.Nodes {
+		if node.Type == "host" {
+			for _, osdID := range node.Children {
+				hosts[osdID] = node.Name
+			}
+		}
+	}
+	return pools, hosts, ""
+}
+
+// getPgDetails returns placement groups states counts and stuck placement groups mapped
+// to pools and acting osds and hosts, details are not set if all placement groups are fine.
+// Placement groups in states from pgStatesToCount, which are not stuck yet, are only counted
+func (c *cephDeploymentHealthConfig) getPgDetails() (*lcmv1alpha1.PgDetails, []string) {
+	if lcmcommon.Contains(c.lcmConfig.HealthParams.ChecksSkip, pgStatesCheck) {
+		c.log.Debug().Msgf("skipping ceph cluster placement groups check, set '%s' to skip through lcm config settings", pgStatesCheck)
+		return nil, nil
+	}
+	cephStatus, err := c.getCephStatus()
+	if err != nil {
+		c.log.Error().Err(err).Msg("")
+		return nil, []string{fmt.Sprintf("failed to run '%s' command to check placement groups", cephStatusCmd)}
+	}
+	stuckPgs, err := c.getStuckPgs()
+	if err != nil {
+		c.log.Error().Err(err).Msg("")
+		return nil, []string{"failed to run 'ceph pg dump_stuck' command to check stuck placement groups"}
+	}
+	pgDetails := &lcmv1alpha1.PgDetails{
+		StatesCount:   getPgStatesCount(cephStatus.PgMap.PgsByState),
+		StuckPgsCount: len(stuckPgs),
+	}
+	issues := []string{}
+	if len(stuckPgs) > 0 {
+		pools, hosts, issue := c.getPoolsAndHostsMapping()
+		if issue != "" {
+			return nil, []string{issue}
+		}
+		poolsWithStuckPgs := map[string]bool{}
+		for _, pg := range stuckPgs {
+			poolID := strings.Split(pg.PgID, ".")[0]
+			poolName, present := pools[poolID]
+			if !present {
+				poolName = poolID
+			}
+			actingHosts := []string{}
+			for _, osdID := range pg.Acting {
+				if host, present := hosts[osdID]; present && !lcmcommon.Contains(actingHosts, host) {
+					actingHosts = append(actingHosts, host)
+				}
+			}
+			sort.Strings(actingHosts)
+			if len(actingHosts) == 0 {
+				actingHosts = nil
+			}
+			pgDetails.StuckPgs = append(pgDetails.StuckPgs, lcmv1alpha1.StuckPgInfo{
+				PgID:        pg.PgID,
+				Pool:        poolName,
+				State:       pg.State,
+				ActingOsds:  pg.Acting,
+				ActingHosts: actingHosts,
+				StuckSince:  getPgStuckSince(pg),
+			})
+			if !poolsWithStuckPgs[poolName] {
+				poolsWithStuckPgs[poolName] = true
+				issues = append(issues, fmt.Sprintf("pool '%s' has stuck placement groups", poolName))
+			}
+		}
+		// the longest stuck pgs go first, pgs with unknown stuck time go last
+		sort.Slice(pgDetails.StuckPgs, func(i, j int) bool {
+			left, right := pgDetails.StuckPgs[i], pgDetails.StuckPgs[j]
+			if left.StuckSince != right.StuckSince {
+				if left.StuckSince == "" || right.StuckSince == "" {
+					return right.StuckSince == ""
+				}
+				return left.StuckSince < right.StuckSince
+			}
+			return left.PgID < right.PgID
+		})
+		if len(pgDetails.StuckPgs) > maxStuckPgsInReport {
+			pgDetails.StuckPgs = pgDetails.StuckPgs[:maxStuckPgsInReport]
+		}
+	}
+	if len(pgDetails.StatesCount) == 0 && pgDetails.StuckPgsCount == 0 {
+		return nil, nil
+	}
+	if len(pgDetails.StatesCount) == 0 {
+		pgDetails.StatesCount = nil
+	}
+	sort.Strings(issues)
+	return pgDetails, issues
+}
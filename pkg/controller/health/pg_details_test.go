@@ -0,0 +1,153 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+	faketestclients "github.com/Mirantis/pelagia/v3/test/unit/clients"
+	unitinputs "github.com/Mirantis/pelagia/v3/test/unit/inputs"
+)
+
+func TestGetPgDetails(t *testing.T) {
+	pgDetailsWithIssues := &lcmv1alpha1.PgDetails{
+		StatesCount: map[string]int{
+			"inactive":     2,
+			"undersized":   2,
+			"degraded":     2,
+			"incomplete":   1,
+			"inconsistent": 1,
+		},
+		StuckPgsCount: 3,
+		StuckPgs: []lcmv1alpha1.StuckPgInfo{
+			{
+				PgID:        "2.0",
+				Pool:        "pool-2",
+				State:       "undersized+degraded+peered",
+				ActingOsds:  []int{4},
+				ActingHosts: []string{"node-2"},
+				StuckSince:  "2026-01-01T07:15:30Z",
+			},
+			{
+				PgID:        "1.0",
+				Pool:        "pool-1",
+				State:       "active+undersized+degraded",
+				ActingOsds:  []int{20, 0},
+				ActingHosts: []string{"node-1", "node-2"},
+				StuckSince:  "2026-01-01T08:30:00Z",
+			},
+			{
+				PgID:        "3.0",
+				Pool:        "pool-3",
+				State:       "stale+incomplete",
+				ActingOsds:  []int{30, 5},
+				ActingHosts: []string{"node-1", "node-2"},
+				StuckSince:  "2026-01-01T09:30:00Z",
+			},
+		},
+	}
+	tests := []struct {
+		name            string
+		cephOutputs     map[string]string
+		skipCheck       bool
+		expectedDetails *lcmv1alpha1.PgDetails
+		expectedIssues  []string
+	}{
+		{
+			name:           "failed to get ceph status",
+			expectedIssues: []string{"failed to run 'ceph status -f json' command to check placement groups"},
+		},
+		{
+			name: "failed to dump stuck pgs",
+			cephOutputs: map[string]string{
+				"ceph status -f json": unitinputs.CephStatusPgsWithIssues,
+			},
+			expectedIssues: []string{"failed to run 'ceph pg dump_stuck' command to check stuck placement groups"},
+		},
+		{
+			name: "all pgs are active and clean",
+			cephOutputs: map[string]string{
+				"ceph status -f json": unitinputs.CephStatusBaseHealthy,
+				pgDumpStuckCmd:        "",
+			},
+		},
+		{
+			name: "pgs have issues, but not stuck",
+			cephOutputs: map[string]string{
+				"ceph status -f json": unitinputs.CephStatusPgsWithIssues,
+				pgDumpStuckCmd:        "",
+			},
+			expectedDetails: &lcmv1alpha1.PgDetails{StatesCount: pgDetailsWithIssues.StatesCount},
+			expectedIssues:  []string{},
+		},
+		{
+			name: "stuck pgs found, failed to get pools",
+			cephOutputs: map[string]string{
+				"ceph status -f json": unitinputs.CephStatusPgsWithIssues,
+				pgDumpStuckCmd:        unitinputs.CephPgDumpStuck,
+			},
+			expectedIssues: []string{"failed to run 'ceph osd pool ls detail -f json' command to check stuck placement groups"},
+		},
+		{
+			name: "stuck pgs found",
+			cephOutputs: map[string]string{
+				"ceph status -f json":             unitinputs.CephStatusPgsWithIssues,
+				pgDumpStuckCmd:                    unitinputs.CephPgDumpStuck,
+				"ceph osd pool ls detail -f json": unitinputs.CephPoolsDetails,
+				"ceph osd tree -f json":           unitinputs.CephOsdTreeOutput,
+			},
+			expectedDetails: pgDetailsWithIssues,
+			expectedIssues: []string{
+				"pool 'pool-1' has stuck placement groups",
+				"pool 'pool-2' has stuck placement groups",
+				"pool 'pool-3' has stuck placement groups",
+			},
+		},
+		{
+			name:      "pg states check skipped",
+			skipCheck: true,
+		},
+	}
+	oldCmdRun := lcmcommon.RunPodCommand
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lcmConfigData := map[string]string{}
+			if test.skipCheck {
+				lcmConfigData["HEALTH_CHECKS_SKIP"] = "pg_states"
+			}
+			c := fakeCephReconcileConfig(nil, lcmConfigData)
+			faketestclients.FakeReaction(c.api.Kubeclientset.CoreV1(), "list", []string{"pods"}, map[string]runtime.Object{"pods": unitinputs.ToolBoxPodList}, nil)
+			lcmcommon.RunPodCommand = func(e lcmcommon.ExecConfig) (string, string, error) {
+				if output, ok := test.cephOutputs[e.Command]; ok {
+					return output, "", nil
+				}
+				return "", "", errors.New("command failed")
+			}
+
+			details, issues := c.getPgDetails()
+			assert.Equal(t, test.expectedDetails, details)
+			assert.Equal(t, test.expectedIssues, issues)
+		})
+	}
+	lcmcommon.RunPodCommand = oldCmdRun
+}
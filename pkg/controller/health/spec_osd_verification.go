@@ -163,7 +163,7 @@ func (c *cephDeploymentHealthConfig) getSpecAnalysisStatus() (*lcmv1alpha1.OsdSp
 	}
 
 	issues := []string{}
-	diskDaemonState, numberReady := c.getDaemonSetStatus(c.healthConfig.namespace, lcmcommon.PelagiaDiskDaemon)
+	diskDaemonState, numberReady := c.getDaemonSetStatus(c.healthConfig.namespace, c.lcmConfig.DiskDaemonName)
 	newStatus := &lcmv1alpha1.OsdSpecAnalysisState{DiskDaemon: diskDaemonState}
 	if len(newStatus.DiskDaemon.Issues) > 0 {
 		issues = append(issues, newStatus.DiskDaemon.Issues...)
@@ -305,7 +305,7 @@ func (c *cephDeploymentHealthConfig) getNodeAnalyseStatus(namespace string, node
 	var diskDaemonReport lcmcommon.DiskDaemonReport
 	for {
 		cmd := fmt.Sprintf("%s --full-report --port %d", lcmcommon.PelagiaDiskDaemon, c.lcmConfig.CommonParams.DiskDaemonPort)
-		err := lcmcommon.RunAndParseDiskDaemonCLI(c.context, c.api.Kubeclientset, c.api.Config, namespace, node.Name, cmd, &diskDaemonReport)
+		err := lcmcommon.RunAndParseDiskDaemonCLI(c.context, c.api.Kubeclientset, c.api.Config, namespace, c.lcmConfig.DiskDaemonName, node.Name, cmd, &diskDaemonReport)
 		if err != nil {
 			c.log.Error().Err(err).Msg("")
 			return lcmv1alpha1.DaemonStatus{
@@ -141,7 +141,6 @@ type ReconcileLcmResources struct {
 }
 
 func (r *ReconcileLcmResources) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
-	lcmConfig := lcmconfig.GetConfiguration(request.Namespace)
 	sublog := log.With().Str(lcmcommon.LoggerObjectField, fmt.Sprintf("namespace '%v'", request.Namespace)).Logger()
 	deploymentHealth, err := r.Lcmclientset.LcmV1alpha1().CephDeploymentHealths(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
 	if err != nil {
@@ -152,6 +151,12 @@ func (r *ReconcileLcmResources) Reconcile(ctx context.Context, request reconcile
 		sublog.Error().Err(err).Msg("")
 		return reconcile.Result{RequeueAfter: requeueAfterInterval}, err
 	}
+	rookNamespace, err := lcmcommon.GetCephDeploymentRookNamespace(ctx, r.Lcmclientset, request.Namespace, request.Name)
+	if err != nil {
+		sublog.Error().Err(err).Msg("")
+		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+	}
+	lcmConfig := lcmconfig.GetClusterConfiguration(request.Namespace, request.Name, rookNamespace)
 	// add owner refs for disk-daemon
 	lcmOwnerRefs, err := lcmcommon.GetObjectOwnerRef(deploymentHealth, r.Scheme)
 	if err != nil {
@@ -60,11 +60,11 @@ func (c *cephDeploymentInfraConfig) ensureDiskDaemon() error {
 		return nil
 	}
 	if c.infraConfig.cephImage == "" {
-		c.log.Error().Msgf("related CephCluster has no image provided in status yet, skipping %s reconcile", lcmcommon.PelagiaDiskDaemon)
+		c.log.Error().Msgf("related CephCluster has no image provided in status yet, skipping %s reconcile", c.lcmConfig.DiskDaemonName)
 		return nil
 	}
 	diskDaemonNew := c.generateDiskDaemon()
-	diskDaemonCur, err := c.api.Kubeclientset.AppsV1().DaemonSets(c.infraConfig.namespace).Get(c.context, lcmcommon.PelagiaDiskDaemon, metav1.GetOptions{})
+	diskDaemonCur, err := c.api.Kubeclientset.AppsV1().DaemonSets(c.infraConfig.namespace).Get(c.context, c.lcmConfig.DiskDaemonName, metav1.GetOptions{})
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			c.log.Info().Msgf("create disk daemon daemonset '%s/%s'", diskDaemonNew.Namespace, diskDaemonNew.Name)
@@ -76,7 +76,7 @@ func (c *cephDeploymentInfraConfig) ensureDiskDaemon() error {
 			return nil
 		}
 		c.log.Error().Err(err).Msg("")
-		return errors.Wrapf(err, "failed to check disk-daemon daemonset '%s/%s'", c.infraConfig.namespace, lcmcommon.PelagiaDiskDaemon)
+		return errors.Wrapf(err, "failed to check disk-daemon daemonset '%s/%s'", c.infraConfig.namespace, c.lcmConfig.DiskDaemonName)
 	}
 	// we can't predict current default scheduler name - so just take it from present deployment
 	diskDaemonNew.Spec.Template.Spec.SchedulerName = diskDaemonCur.Spec.Template.Spec.SchedulerName
@@ -116,9 +116,9 @@ func (c *cephDeploymentInfraConfig) generateDiskDaemon() *apps.DaemonSet {
 
 	diskDaemon := &apps.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:            lcmcommon.PelagiaDiskDaemon,
+			Name:            c.lcmConfig.DiskDaemonName,
 			Namespace:       c.infraConfig.namespace,
-			Labels:          lcmcommon.ExtendLabels(map[string]string{"app": lcmcommon.PelagiaDiskDaemon}, baseResourceLabels),
+			Labels:          lcmcommon.ExtendLabels(map[string]string{"app": c.lcmConfig.DiskDaemonName}, baseResourceLabels),
 			OwnerReferences: c.infraConfig.lcmOwnerRefs,
 		},
 		Spec: apps.DaemonSetSpec{
@@ -140,11 +140,11 @@ func (c *cephDeploymentInfraConfig) generateDiskDaemon() *apps.DaemonSet {
 				},
 			},
 			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"app": lcmcommon.PelagiaDiskDaemon},
+				MatchLabels: map[string]string{"app": c.lcmConfig.DiskDaemonName},
 			},
 			Template: v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"app": lcmcommon.PelagiaDiskDaemon},
+					Labels: map[string]string{"app": c.lcmConfig.DiskDaemonName},
 				},
 				Spec: v1.PodSpec{
 					DNSPolicy: "ClusterFirstWithHostNet",
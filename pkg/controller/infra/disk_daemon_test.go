@@ -201,6 +201,7 @@ func TestGenerateDiskDaemon(t *testing.T) {
 	tests := []struct {
 		name              string
 		infraConfig       infraConfig
+		diskDaemonName    string
 		expectedDaemonSet *appsv1.DaemonSet
 	}{
 		{
@@ -230,10 +231,30 @@ func TestGenerateDiskDaemon(t *testing.T) {
 			},
 			expectedDaemonSet: unitinputs.DiskDaemonDaemonsetWithOsdTolerations,
 		},
+		{
+			name: "generate daemonset for cluster with own rook namespace",
+			infraConfig: infraConfig{
+				namespace:       "lcm-namespace",
+				cephImage:       unitinputs.CephClusterReady.Status.CephVersion.Image,
+				controllerImage: "some-registry/lcm-controller:v1",
+			},
+			diskDaemonName: "pelagia-disk-daemon-cephcluster-2",
+			expectedDaemonSet: func() *appsv1.DaemonSet {
+				ds := unitinputs.DiskDaemonDaemonset.DeepCopy()
+				ds.Name = "pelagia-disk-daemon-cephcluster-2"
+				ds.Labels["app"] = "pelagia-disk-daemon-cephcluster-2"
+				ds.Spec.Selector.MatchLabels = map[string]string{"app": "pelagia-disk-daemon-cephcluster-2"}
+				ds.Spec.Template.Labels = map[string]string{"app": "pelagia-disk-daemon-cephcluster-2"}
+				return ds
+			}(),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			c := fakeReconcileInfraConfig(&test.infraConfig, nil)
+			if test.diskDaemonName != "" {
+				c.lcmConfig.DiskDaemonName = test.diskDaemonName
+			}
 
 			daemonSet := c.generateDiskDaemon()
 			assert.Equal(t, test.expectedDaemonSet, daemonSet)
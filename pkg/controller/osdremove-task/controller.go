@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -87,6 +88,7 @@ func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
 		Kubeclientset: KubeClientset,
 		Rookclientset: RookClientset,
 		Scheme:        mgr.GetScheme(),
+		Recorder:      mgr.GetEventRecorder(ControllerName),
 	}, nil
 }
 
@@ -154,6 +156,7 @@ type ReconcileCephOsdRemoveTask struct {
 	Rookclientset rookclient.Interface
 	Lcmclientset  lcmclient.Interface
 	Scheme        *runtime.Scheme
+	Recorder      events.EventRecorder
 }
 
 func getOldestCephOsdRemoveTaskName(cephTasks []lcmv1alpha1.CephOsdRemoveTask) string {
@@ -179,6 +182,45 @@ func getOldestCephOsdRemoveTaskName(cephTasks []lcmv1alpha1.CephOsdRemoveTask) s
 	return cephTasks[i].Name
 }
 
+func getTaskCephDeploymentName(cephTask *lcmv1alpha1.CephOsdRemoveTask) string {
+	if cephTask.Spec == nil {
+		return ""
+	}
+	return cephTask.Spec.CephDeployment
+}
+
+// getTaskCephDeploymentHealth returns CephDeploymentHealth related to the task Ceph cluster or abort reason,
+// if related CephDeploymentHealth can not be determined
+func getTaskCephDeploymentHealth(cephTask *lcmv1alpha1.CephOsdRemoveTask, deploymentHealths []lcmv1alpha1.CephDeploymentHealth) (*lcmv1alpha1.CephDeploymentHealth, string) {
+	if len(deploymentHealths) == 0 {
+		return nil, ""
+	}
+	if clusterName := getTaskCephDeploymentName(cephTask); clusterName != "" {
+		for idx := range deploymentHealths {
+			if deploymentHealths[idx].Name == clusterName {
+				return &deploymentHealths[idx], ""
+			}
+		}
+		return nil, fmt.Sprintf("no CephDeploymentHealth found for CephDeployment '%s' specified in task", clusterName)
+	}
+	if len(deploymentHealths) > 1 {
+		return nil, "multiple CephDeploymentHealth objects found in namespace, CephDeployment should be specified in task"
+	}
+	return &deploymentHealths[0], ""
+}
+
+// getClusterCephOsdRemoveTasks returns tasks related to the Ceph cluster, task without
+// specified CephDeployment is possible only for namespace with single Ceph cluster
+func getClusterCephOsdRemoveTasks(cephTasks []lcmv1alpha1.CephOsdRemoveTask, clusterName string) []lcmv1alpha1.CephOsdRemoveTask {
+	clusterTasks := make([]lcmv1alpha1.CephOsdRemoveTask, 0, len(cephTasks))
+	for _, cephTask := range cephTasks {
+		if taskClusterName := getTaskCephDeploymentName(&cephTask); taskClusterName == "" || taskClusterName == clusterName {
+			clusterTasks = append(clusterTasks, cephTask)
+		}
+	}
+	return clusterTasks
+}
+
 func (r *ReconcileCephOsdRemoveTask) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	lcmConfig := lcmconfig.GetConfiguration(request.Namespace)
 	sublog := log.With().Str(lcmcommon.LoggerObjectField, fmt.Sprintf("cephosdremovetask '%v'", request.NamespacedName)).Logger().Level(lcmConfig.TaskParams.LogLevel)
@@ -210,13 +252,12 @@ func (r *ReconcileCephOsdRemoveTask) Reconcile(ctx context.Context, request reco
 		sublog.Error().Err(err).Msg("")
 		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
 	}
-	//we should have only one CephDeploymentHealth per namespace
-	if len(deploymentHealthList.Items) != 1 {
-		if len(deploymentHealthList.Items) > 1 {
+	cephDeploymentHealth, abortReason := getTaskCephDeploymentHealth(cephTask, deploymentHealthList.Items)
+	if cephDeploymentHealth == nil {
+		if abortReason != "" {
 			if checkTaskActive(cephTask.Status) {
-				errMsg := "multiple CephDeploymentHealth objects found in namespace"
-				sublog.Error().Msgf("aborting, %s", errMsg)
-				err = r.updateCephOsdRemoveTaskStatus(ctx, request, prepareAbortStatus(cephTask.Status, errMsg))
+				sublog.Error().Msgf("aborting, %s", abortReason)
+				err = r.updateCephOsdRemoveTaskStatus(ctx, request, prepareAbortStatus(cephTask.Status, abortReason))
 			}
 		} else {
 			sublog.Info().Msg("stale, no related CephDeploymentHealth resource found in namespace, removing")
@@ -228,8 +269,6 @@ func (r *ReconcileCephOsdRemoveTask) Reconcile(ctx context.Context, request reco
 		}
 		return reconcile.Result{}, nil
 	}
-
-	cephDeploymentHealth := &deploymentHealthList.Items[0]
 	ownerRefs, err := lcmcommon.GetObjectOwnerRef(cephDeploymentHealth, r.Scheme)
 	if err != nil {
 		sublog.Error().Err(errors.Wrap(err, "owner refs set failed")).Msg("")
@@ -247,6 +286,23 @@ func (r *ReconcileCephOsdRemoveTask) Reconcile(ctx context.Context, request reco
 		return reconcile.Result{RequeueAfter: lcmcommon.DefaultImmediateRequeueInterval}, nil
 	}
 
+	// check presence of ceph deployment, to check it is updated and to get related rook namespace
+	rookNamespace := ""
+	cephDeploy, err := r.Lcmclientset.LcmV1alpha1().CephDeployments(request.Namespace).Get(ctx, cephDeploymentHealth.Name, metav1.GetOptions{})
+	if err != nil {
+		if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) || apierrors.IsNotFound(err) {
+			cephDeploy = nil
+			sublog.Info().Msgf("related CephDeployment '%s/%s' is not found, continue work with CephCluster '%s/%s' directly",
+				cephDeploymentHealth.Namespace, cephDeploymentHealth.Name, lcmConfig.RookNamespace, cephDeploymentHealth.Name)
+		} else {
+			sublog.Error().Err(err).Msg("")
+			return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
+		}
+	} else {
+		rookNamespace = cephDeploy.Spec.RookNamespace
+	}
+	lcmConfig = lcmconfig.GetClusterConfiguration(request.Namespace, cephDeploymentHealth.Name, rookNamespace)
+
 	// do not abort on api error, since cephdeploymenthealth contains cephcluster status
 	// so it may be simple API throttling error or so, re-run
 	cephCluster, err := r.Rookclientset.CephV1().CephClusters(lcmConfig.RookNamespace).Get(ctx, cephDeploymentHealth.Name, metav1.GetOptions{})
@@ -301,8 +357,8 @@ func (r *ReconcileCephOsdRemoveTask) Reconcile(ctx context.Context, request reco
 		sublog.Error().Err(err).Msg("")
 		return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
 	}
-	// check that we are picking up first created not closed task, to avoid race between multiple tasks in ns
-	if oldestTaskName := getOldestCephOsdRemoveTaskName(taskList.Items); oldestTaskName != request.Name {
+	// check that we are picking up first created not closed task, to avoid race between multiple tasks for cluster
+	if oldestTaskName := getOldestCephOsdRemoveTaskName(getClusterCephOsdRemoveTasks(taskList.Items, cephDeploymentHealth.Name)); oldestTaskName != request.Name {
 		sublog.Info().Msgf("paused, found older not completed CephOsdRemoveTask '%s/%s", request.Namespace, oldestTaskName)
 		cephTask.Status.PhaseInfo = "waiting for older CephOsdRemoveTask completion"
 		err = r.updateCephOsdRemoveTaskStatus(ctx, request, cephTask.Status)
@@ -324,18 +380,7 @@ func (r *ReconcileCephOsdRemoveTask) Reconcile(ctx context.Context, request reco
 		},
 	}
 
-	// check presence of ceph deployment, to check it is updated
-	cephDeploy, err := r.Lcmclientset.LcmV1alpha1().CephDeployments(request.Namespace).Get(ctx, cephDeploymentHealth.Name, metav1.GetOptions{})
-	if err != nil {
-		if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) || apierrors.IsNotFound(err) {
-			cephDeploy = nil
-			sublog.Info().Msgf("related CephDeployment '%s/%s' is not found, continue work with CephCluster '%s/%s' directly",
-				cephDeploymentHealth.Namespace, cephDeploymentHealth.Name, cephCluster.Namespace, cephCluster.Name)
-		} else {
-			sublog.Error().Err(err).Msg("")
-			return reconcile.Result{RequeueAfter: requeueAfterInterval}, nil
-		}
-	} else {
+	if cephDeploy != nil {
 		removeConfig.taskConfig.cephDeploymentPhase = &cephDeploy.Status.Phase
 	}
 
@@ -364,9 +409,11 @@ func (r *ReconcileCephOsdRemoveTask) updateCephOsdRemoveTaskStatus(ctx context.C
 	if err != nil {
 		return errors.Wrapf(err, "failed to get CephOsdRemoveTask '%s' to update status", req.NamespacedName)
 	}
+	oldStatus := cephTask.Status.DeepCopy()
 	err = lcmv1alpha1.UpdateCephOsdRemoveTaskStatus(ctx, cephTask, status, r.Client)
 	if err != nil {
 		return errors.Wrapf(err, "failed to update CephOsdRemoveTask '%s' status with '%v' phase", req.NamespacedName, status.Phase)
 	}
+	r.recordStatusEvents(cephTask, oldStatus, status)
 	return nil
 }
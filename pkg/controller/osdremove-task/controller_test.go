@@ -183,7 +183,7 @@ func TestTaskReconcile(t *testing.T) {
 					Items: []lcmv1alpha1.CephOsdRemoveTask{*unitinputs.CephOsdRemoveTaskInited.DeepCopy()},
 				},
 			},
-			expectedTask:   unitinputs.GetAbortedTask(unitinputs.CephOsdRemoveTaskInited, "test-time-8", "multiple CephDeploymentHealth objects found in namespace"),
+			expectedTask:   unitinputs.GetAbortedTask(unitinputs.CephOsdRemoveTaskInited, "test-time-8", "multiple CephDeploymentHealth objects found in namespace, CephDeployment should be specified in task"),
 			expectedResult: noRequeue,
 		},
 		{
@@ -220,6 +220,7 @@ func TestTaskReconcile(t *testing.T) {
 		{
 			name: "cephtask - failed to get cephcluster",
 			inputResources: map[string]runtime.Object{
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
 				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
 					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth},
 				},
@@ -234,6 +235,7 @@ func TestTaskReconcile(t *testing.T) {
 		{
 			name: "cephtask - lcm skipped for external cluster, abort failed",
 			inputResources: map[string]runtime.Object{
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
 				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
 					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth},
 				},
@@ -248,6 +250,7 @@ func TestTaskReconcile(t *testing.T) {
 		{
 			name: "cephtask - lcm skipped for external cluster",
 			inputResources: map[string]runtime.Object{
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
 				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
 					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth},
 				},
@@ -262,6 +265,7 @@ func TestTaskReconcile(t *testing.T) {
 		{
 			name: "cephtask - cephcluster has no ceph status and fsid yet",
 			inputResources: map[string]runtime.Object{
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
 				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
 					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth},
 				},
@@ -281,6 +285,7 @@ func TestTaskReconcile(t *testing.T) {
 		{
 			name: "cephtask - cephdeploymenthealth has no healthreport status",
 			inputResources: map[string]runtime.Object{
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
 				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
 					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth},
 				},
@@ -300,6 +305,7 @@ func TestTaskReconcile(t *testing.T) {
 		{
 			name: "cephtask - cephdeploymenthealth has no osd analysis status",
 			inputResources: map[string]runtime.Object{
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
 				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
 					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealthStatusNotOk},
 				},
@@ -319,6 +325,7 @@ func TestTaskReconcile(t *testing.T) {
 		{
 			name: "cephtask - no task handling, waiting for another oldest",
 			inputResources: map[string]runtime.Object{
+				"cephdeployments": &lcmv1alpha1.CephDeploymentList{},
 				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
 					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealthStatusOk},
 				},
@@ -496,6 +503,29 @@ func TestTaskReconcile(t *testing.T) {
 			expectedTask:   unitinputs.CephOsdRemoveTaskOnValidation,
 			expectedResult: resInterval,
 		},
+		{
+			name: "cephtask - few cephdeploymenthealths, specified cephdeployment is not found, aborted",
+			inputResources: map[string]runtime.Object{
+				"cephdeploymenthealths": &lcmv1alpha1.CephDeploymentHealthList{
+					Items: []lcmv1alpha1.CephDeploymentHealth{unitinputs.CephDeploymentHealth, unitinputs.CephDeploymentHealth},
+				},
+				"cephosdremovetasks": &lcmv1alpha1.CephOsdRemoveTaskList{
+					Items: []lcmv1alpha1.CephOsdRemoveTask{
+						func() lcmv1alpha1.CephOsdRemoveTask {
+							task := unitinputs.CephOsdRemoveTaskInited.DeepCopy()
+							task.Spec = &lcmv1alpha1.CephOsdRemoveTaskSpec{CephDeployment: "cephcluster-2"}
+							return *task
+						}(),
+					},
+				},
+			},
+			expectedTask: func() *lcmv1alpha1.CephOsdRemoveTask {
+				task := unitinputs.GetAbortedTask(unitinputs.CephOsdRemoveTaskInited, "test-time-25", "no CephDeploymentHealth found for CephDeployment 'cephcluster-2' specified in task")
+				task.Spec = &lcmv1alpha1.CephOsdRemoveTaskSpec{CephDeployment: "cephcluster-2"}
+				return task
+			}(),
+			expectedResult: noRequeue,
+		},
 	}
 	oldCurrentTime := lcmcommon.GetCurrentTimeString
 	for idx, test := range tests {
@@ -591,3 +621,66 @@ func TestGetOldestCephOsdRemoveTask(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTaskCephDeploymentHealth(t *testing.T) {
+	health := unitinputs.CephDeploymentHealth.DeepCopy()
+	health2 := unitinputs.CephDeploymentHealth.DeepCopy()
+	health2.Name = "cephcluster-2"
+	tests := []struct {
+		name                string
+		cephDeployment      string
+		deploymentHealths   []lcmv1alpha1.CephDeploymentHealth
+		expectedHealth      *lcmv1alpha1.CephDeploymentHealth
+		expectedAbortReason string
+	}{
+		{
+			name:              "no cephdeploymenthealths",
+			deploymentHealths: []lcmv1alpha1.CephDeploymentHealth{},
+		},
+		{
+			name:              "single cephdeploymenthealth",
+			deploymentHealths: []lcmv1alpha1.CephDeploymentHealth{*health},
+			expectedHealth:    health,
+		},
+		{
+			name:                "multiple cephdeploymenthealths, cephdeployment is not specified",
+			deploymentHealths:   []lcmv1alpha1.CephDeploymentHealth{*health, *health2},
+			expectedAbortReason: "multiple CephDeploymentHealth objects found in namespace, CephDeployment should be specified in task",
+		},
+		{
+			name:              "multiple cephdeploymenthealths, cephdeployment is specified",
+			cephDeployment:    "cephcluster-2",
+			deploymentHealths: []lcmv1alpha1.CephDeploymentHealth{*health, *health2},
+			expectedHealth:    health2,
+		},
+		{
+			name:                "specified cephdeployment is not found",
+			cephDeployment:      "cephcluster-3",
+			deploymentHealths:   []lcmv1alpha1.CephDeploymentHealth{*health, *health2},
+			expectedAbortReason: "no CephDeploymentHealth found for CephDeployment 'cephcluster-3' specified in task",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := unitinputs.CephOsdRemoveTaskInited.DeepCopy()
+			if test.cephDeployment != "" {
+				task.Spec = &lcmv1alpha1.CephOsdRemoveTaskSpec{CephDeployment: test.cephDeployment}
+			}
+			deploymentHealth, abortReason := getTaskCephDeploymentHealth(task, test.deploymentHealths)
+			assert.Equal(t, test.expectedHealth, deploymentHealth)
+			assert.Equal(t, test.expectedAbortReason, abortReason)
+		})
+	}
+}
+
+func TestGetClusterCephOsdRemoveTasks(t *testing.T) {
+	task := unitinputs.CephOsdRemoveTaskInited.DeepCopy()
+	task2 := unitinputs.CephOsdRemoveTaskOld.DeepCopy()
+	task2.Spec = &lcmv1alpha1.CephOsdRemoveTaskSpec{CephDeployment: "cephcluster-2"}
+	task3 := unitinputs.CephOsdRemoveTaskOldCompleted.DeepCopy()
+	task3.Spec = &lcmv1alpha1.CephOsdRemoveTaskSpec{CephDeployment: "cephcluster"}
+	cephTasks := []lcmv1alpha1.CephOsdRemoveTask{*task, *task2, *task3}
+
+	assert.Equal(t, []lcmv1alpha1.CephOsdRemoveTask{*task, *task3}, getClusterCephOsdRemoveTasks(cephTasks, "cephcluster"))
+	assert.Equal(t, []lcmv1alpha1.CephOsdRemoveTask{*task, *task2}, getClusterCephOsdRemoveTasks(cephTasks, "cephcluster-2"))
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osdremove
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+const (
+	eventReasonPhaseChanged      = "PhaseChanged"
+	eventReasonRemoveStepChanged = "RemoveStepChanged"
+	eventActionProcess           = "Process"
+)
+
+type removeStepTransition struct {
+	step     string
+	oldPhase lcmv1alpha1.RemovePhase
+	newPhase lcmv1alpha1.RemovePhase
+	errMsg   string
+}
+
+func getRemoveStatusPhase(status *lcmv1alpha1.RemoveStatus) lcmv1alpha1.RemovePhase {
+	if status == nil {
+		return ""
+	}
+	return status.Status
+}
+
+func appendRemoveStepTransition(transitions []removeStepTransition, step string, oldStatus, newStatus *lcmv1alpha1.RemoveStatus) []removeStepTransition {
+	newPhase := getRemoveStatusPhase(newStatus)
+	oldPhase := getRemoveStatusPhase(oldStatus)
+	if newPhase == "" || newPhase == oldPhase {
+		return transitions
+	}
+	return append(transitions, removeStepTransition{step: step, oldPhase: oldPhase, newPhase: newPhase, errMsg: newStatus.Error})
+}
+
+// getRemoveStepTransitions returns sorted list of osd/host remove steps, which phase was changed in new status
+func getRemoveStepTransitions(oldStatus, newStatus *lcmv1alpha1.CephOsdRemoveTaskStatus) []removeStepTransition {
+	transitions := []removeStepTransition{}
+	if newStatus == nil || newStatus.RemoveInfo == nil {
+		return transitions
+	}
+	oldCleanupMap := map[string]lcmv1alpha1.HostMapping{}
+	if oldStatus != nil && oldStatus.RemoveInfo != nil {
+		oldCleanupMap = oldStatus.RemoveInfo.CleanupMap
+	}
+	hosts := make([]string, 0, len(newStatus.RemoveInfo.CleanupMap))
+	for host := range newStatus.RemoveInfo.CleanupMap {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		newHostMapping := newStatus.RemoveInfo.CleanupMap[host]
+		oldHostMapping := oldCleanupMap[host]
+		osds := make([]string, 0, len(newHostMapping.OsdMapping))
+		for osd := range newHostMapping.OsdMapping {
+			osds = append(osds, osd)
+		}
+		sort.Strings(osds)
+		for _, osd := range osds {
+			newRemoveStatus := newHostMapping.OsdMapping[osd].RemoveStatus
+			if newRemoveStatus == nil {
+				continue
+			}
+			oldRemoveStatus := &lcmv1alpha1.RemoveResult{}
+			if oldOsdMapping, present := oldHostMapping.OsdMapping[osd]; present && oldOsdMapping.RemoveStatus != nil {
+				oldRemoveStatus = oldOsdMapping.RemoveStatus
+			}
+			transitions = appendRemoveStepTransition(transitions, fmt.Sprintf("osd %s removal on host %s", osd, host),
+				oldRemoveStatus.OsdRemoveStatus, newRemoveStatus.OsdRemoveStatus)
+			transitions = appendRemoveStepTransition(transitions, fmt.Sprintf("osd %s deployment removal on host %s", osd, host),
+				oldRemoveStatus.DeployRemoveStatus, newRemoveStatus.DeployRemoveStatus)
+			transitions = appendRemoveStepTransition(transitions, fmt.Sprintf("osd %s device cleanup on host %s", osd, host),
+				oldRemoveStatus.DeviceCleanUpJob, newRemoveStatus.DeviceCleanUpJob)
+		}
+		transitions = appendRemoveStepTransition(transitions, fmt.Sprintf("host %s removal", host), oldHostMapping.HostRemoveStatus, newHostMapping.HostRemoveStatus)
+	}
+	return transitions
+}
+
+func isTaskPhaseWarning(phase lcmv1alpha1.TaskPhase) bool {
+	switch phase {
+	case lcmv1alpha1.TaskPhaseAborted, lcmv1alpha1.TaskPhaseFailed, lcmv1alpha1.TaskPhaseValidationFailed, lcmv1alpha1.TaskPhaseCompletedWithWarnings:
+		return true
+	}
+	return false
+}
+
+func (r *ReconcileCephOsdRemoveTask) recordStatusEvents(cephTask *lcmv1alpha1.CephOsdRemoveTask, oldStatus, newStatus *lcmv1alpha1.CephOsdRemoveTaskStatus) {
+	if r.Recorder == nil || newStatus == nil {
+		return
+	}
+	for _, transition := range getRemoveStepTransitions(oldStatus, newStatus) {
+		eventType := corev1.EventTypeNormal
+		note := fmt.Sprintf("%s moved to '%s'", transition.step, transition.newPhase)
+		if transition.oldPhase != "" {
+			note = fmt.Sprintf("%s moved from '%s' to '%s'", transition.step, transition.oldPhase, transition.newPhase)
+		}
+		if transition.newPhase == lcmv1alpha1.RemoveFailed {
+			eventType = corev1.EventTypeWarning
+			if transition.errMsg != "" {
+				note = fmt.Sprintf("%s: %s", note, transition.errMsg)
+			}
+		}
+		r.Recorder.Eventf(cephTask, nil, eventType, eventReasonRemoveStepChanged, eventActionProcess, "%s", note)
+	}
+	oldPhase := lcmv1alpha1.TaskPhase("")
+	if oldStatus != nil {
+		oldPhase = oldStatus.Phase
+	}
+	if newStatus.Phase != oldPhase {
+		eventType := corev1.EventTypeNormal
+		if isTaskPhaseWarning(newStatus.Phase) {
+			eventType = corev1.EventTypeWarning
+		}
+		note := fmt.Sprintf("phase set to '%s'", newStatus.Phase)
+		if oldPhase != "" {
+			note = fmt.Sprintf("phase changed from '%s' to '%s'", oldPhase, newStatus.Phase)
+		}
+		if newStatus.PhaseInfo != "" {
+			note = fmt.Sprintf("%s: %s", note, newStatus.PhaseInfo)
+		}
+		r.Recorder.Eventf(cephTask, nil, eventType, eventReasonPhaseChanged, eventActionProcess, "%s", note)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osdremove
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/events"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+func readFakeEvents(recorder *events.FakeRecorder) []string {
+	result := []string{}
+	for {
+		select {
+		case e := <-recorder.Events:
+			result = append(result, e)
+		default:
+			return result
+		}
+	}
+}
+
+func TestRecordStatusEvents(t *testing.T) {
+	statusWithRemoveInfo := func(phase lcmv1alpha1.TaskPhase, osdStatus, deployStatus, hostStatus *lcmv1alpha1.RemoveStatus) *lcmv1alpha1.CephOsdRemoveTaskStatus {
+		return &lcmv1alpha1.CephOsdRemoveTaskStatus{
+			Phase: phase,
+			RemoveInfo: &lcmv1alpha1.TaskRemoveInfo{
+				CleanupMap: map[string]lcmv1alpha1.HostMapping{
+					"node-1": {
+						CompleteCleanup: true,
+						OsdMapping: map[string]lcmv1alpha1.OsdMapping{
+							"0": {
+								RemoveStatus: &lcmv1alpha1.RemoveResult{
+									OsdRemoveStatus:    osdStatus,
+									DeployRemoveStatus: deployStatus,
+								},
+							},
+						},
+						HostRemoveStatus: hostStatus,
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		oldStatus      *lcmv1alpha1.CephOsdRemoveTaskStatus
+		newStatus      *lcmv1alpha1.CephOsdRemoveTaskStatus
+		expectedEvents []string
+	}{
+		{
+			name:           "init status",
+			newStatus:      &lcmv1alpha1.CephOsdRemoveTaskStatus{Phase: lcmv1alpha1.TaskPhasePending, PhaseInfo: "initializing"},
+			expectedEvents: []string{"Normal PhaseChanged phase set to 'Pending': initializing"},
+		},
+		{
+			name:           "phase info changed only",
+			oldStatus:      &lcmv1alpha1.CephOsdRemoveTaskStatus{Phase: lcmv1alpha1.TaskPhaseValidating},
+			newStatus:      &lcmv1alpha1.CephOsdRemoveTaskStatus{Phase: lcmv1alpha1.TaskPhaseValidating, PhaseInfo: "validating"},
+			expectedEvents: []string{},
+		},
+		{
+			name:      "task aborted",
+			oldStatus: &lcmv1alpha1.CephOsdRemoveTaskStatus{Phase: lcmv1alpha1.TaskPhaseValidating},
+			newStatus: &lcmv1alpha1.CephOsdRemoveTaskStatus{Phase: lcmv1alpha1.TaskPhaseAborted, PhaseInfo: "detected external CephCluster configuration"},
+			expectedEvents: []string{
+				"Warning PhaseChanged phase changed from 'Validating' to 'Aborted': detected external CephCluster configuration",
+			},
+		},
+		{
+			name:      "remove steps started",
+			oldStatus: statusWithRemoveInfo(lcmv1alpha1.TaskPhaseApproveWaiting, nil, nil, nil),
+			newStatus: statusWithRemoveInfo(lcmv1alpha1.TaskPhaseProcessing, &lcmv1alpha1.RemoveStatus{Status: lcmv1alpha1.RemoveWaitingRebalance}, nil, nil),
+			expectedEvents: []string{
+				"Normal RemoveStepChanged osd 0 removal on host node-1 moved to 'Rebalancing'",
+				"Normal PhaseChanged phase changed from 'ApproveWaiting' to 'Processing'",
+			},
+		},
+		{
+			name: "remove steps moved and failed",
+			oldStatus: statusWithRemoveInfo(lcmv1alpha1.TaskPhaseProcessing,
+				&lcmv1alpha1.RemoveStatus{Status: lcmv1alpha1.RemoveInProgress}, nil, nil),
+			newStatus: statusWithRemoveInfo(lcmv1alpha1.TaskPhaseProcessing,
+				&lcmv1alpha1.RemoveStatus{Status: lcmv1alpha1.RemoveCompleted},
+				&lcmv1alpha1.RemoveStatus{Status: lcmv1alpha1.RemoveFailed, Error: "failed to remove deployment"},
+				&lcmv1alpha1.RemoveStatus{Status: lcmv1alpha1.RemovePending}),
+			expectedEvents: []string{
+				"Normal RemoveStepChanged osd 0 removal on host node-1 moved from 'Removing' to 'Completed'",
+				"Warning RemoveStepChanged osd 0 deployment removal on host node-1 moved to 'Failed': failed to remove deployment",
+				"Normal RemoveStepChanged host node-1 removal moved to 'Pending'",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(10)
+			r := FakeReconciler()
+			r.Recorder = recorder
+			r.recordStatusEvents(&lcmv1alpha1.CephOsdRemoveTask{}, test.oldStatus, test.newStatus)
+			assert.Equal(t, test.expectedEvents, readFakeEvents(recorder))
+		})
+	}
+}
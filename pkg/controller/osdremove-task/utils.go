@@ -52,7 +52,7 @@ func (c *cephOsdRemoveConfig) getNodeReport(hostName string) (*lcmcommon.DiskDae
 	cmd := fmt.Sprintf("%s --osd-report --port %d", lcmcommon.PelagiaDiskDaemon, c.lcmConfig.CommonParams.DiskDaemonPort)
 	nodeReportRes, err := lcmcommon.RunFuncWithRetry(retriesForFailedCommand, diskDaemonRetryTimeout, func() (interface{}, error) {
 		var report *lcmcommon.DiskDaemonReport
-		daemonErr := lcmcommon.RunAndParseDiskDaemonCLI(c.context, c.api.Kubeclientset, c.api.Config, c.taskConfig.task.Namespace, hostName, cmd, &report)
+		daemonErr := lcmcommon.RunAndParseDiskDaemonCLI(c.context, c.api.Kubeclientset, c.api.Config, c.taskConfig.task.Namespace, c.lcmConfig.DiskDaemonName, hostName, cmd, &report)
 		if daemonErr != nil {
 			c.log.Error().Err(daemonErr).Msg("")
 			return nil, daemonErr
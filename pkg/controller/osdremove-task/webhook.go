@@ -0,0 +1,176 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osdremove
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+// cephOsdRemoveTaskValidator runs static CephOsdRemoveTask spec checks on admission,
+// checks against Ceph cluster state are still done by controller in 'Validating' phase
+type cephOsdRemoveTaskValidator struct{}
+
+var _ admission.Validator[*lcmv1alpha1.CephOsdRemoveTask] = &cephOsdRemoveTaskValidator{}
+
+// AddWebhook registers CephOsdRemoveTask validating admission webhook in the Manager webhook server
+func AddWebhook(mgr manager.Manager) error {
+	return builder.WebhookManagedBy(mgr, &lcmv1alpha1.CephOsdRemoveTask{}).WithValidator(&cephOsdRemoveTaskValidator{}).Complete()
+}
+
+func (v *cephOsdRemoveTaskValidator) ValidateCreate(_ context.Context, cephTask *lcmv1alpha1.CephOsdRemoveTask) (admission.Warnings, error) {
+	errMsgs, warnMsgs := validateTaskSpec(cephTask.Spec)
+	if cephTask.Spec != nil && cephTask.Spec.Approve {
+		warnMsgs = append(warnMsgs, "approve is pre-set, osd removal will be started right after validation without remove info review")
+	}
+	return getAdmissionResult(errMsgs, warnMsgs)
+}
+
+func (v *cephOsdRemoveTaskValidator) ValidateUpdate(_ context.Context, oldCephTask, newCephTask *lcmv1alpha1.CephOsdRemoveTask) (admission.Warnings, error) {
+	if newCephTask.GetDeletionTimestamp() != nil || reflect.DeepEqual(oldCephTask.Spec, newCephTask.Spec) {
+		return nil, nil
+	}
+	oldSpec := oldCephTask.Spec
+	if oldSpec == nil {
+		oldSpec = &lcmv1alpha1.CephOsdRemoveTaskSpec{}
+	}
+	newSpec := newCephTask.Spec
+	if newSpec == nil {
+		newSpec = &lcmv1alpha1.CephOsdRemoveTaskSpec{}
+	}
+	errMsgs := []string{}
+	warnMsgs := []string{}
+	nodesChanged := !reflect.DeepEqual(oldSpec.Nodes, newSpec.Nodes)
+	if nodesChanged {
+		errMsgs, warnMsgs = validateTaskSpec(newSpec)
+	}
+	if oldCephTask.Status != nil {
+		phase := oldCephTask.Status.Phase
+		if oldSpec.CephDeployment != newSpec.CephDeployment {
+			errMsgs = append(errMsgs, "cephDeployment can not be changed for already initiated task")
+		}
+		switch {
+		case !checkTaskActive(oldCephTask.Status):
+			if nodesChanged || oldSpec.Approve != newSpec.Approve {
+				warnMsgs = append(warnMsgs, fmt.Sprintf("task is already finished with '%s' phase, spec changes have no effect", phase))
+			}
+		case phase == lcmv1alpha1.TaskPhaseWaitingOperator:
+			if nodesChanged {
+				warnMsgs = append(warnMsgs, "detected inappropriate spec changes after receiving approval, task will be aborted")
+			}
+		case phase == lcmv1alpha1.TaskPhaseProcessing:
+			if nodesChanged {
+				warnMsgs = append(warnMsgs, fmt.Sprintf("task is in '%s' phase, nodes section changes have no effect", phase))
+			}
+		}
+		if oldSpec.Approve && !newSpec.Approve && (phase == lcmv1alpha1.TaskPhaseWaitingOperator || phase == lcmv1alpha1.TaskPhaseProcessing) {
+			warnMsgs = append(warnMsgs, fmt.Sprintf("task is in '%s' phase, approve can not be revoked", phase))
+		}
+	}
+	return getAdmissionResult(errMsgs, warnMsgs)
+}
+
+func (v *cephOsdRemoveTaskValidator) ValidateDelete(_ context.Context, _ *lcmv1alpha1.CephOsdRemoveTask) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateTaskSpec checks task nodes section consistency, which does not depend on Ceph cluster state
+func validateTaskSpec(spec *lcmv1alpha1.CephOsdRemoveTaskSpec) ([]string, []string) {
+	errMsgs := []string{}
+	warnMsgs := []string{}
+	if spec == nil {
+		return errMsgs, warnMsgs
+	}
+	osdHosts := map[int]string{}
+	for _, host := range slices.Sorted(maps.Keys(spec.Nodes)) {
+		hostSpec := spec.Nodes[host]
+		options := []string{}
+		if hostSpec.CompleteCleanup {
+			options = append(options, "completeCleanup")
+		}
+		if hostSpec.DropFromCrush {
+			options = append(options, "dropFromCrush")
+		}
+		if hostSpec.CleanupStrayPartitions {
+			options = append(options, "cleanupStrayPartitions")
+		}
+		if len(hostSpec.CleanupByDevice) > 0 {
+			options = append(options, "cleanupByDevice")
+		}
+		if len(hostSpec.CleanupByOsd) > 0 {
+			options = append(options, "cleanupByOsd")
+		}
+		if len(options) == 0 {
+			errMsgs = append(errMsgs, fmt.Sprintf("[node '%s'] no cleanup option specified", host))
+			continue
+		}
+		if len(options) > 1 {
+			errMsgs = append(errMsgs, fmt.Sprintf("[node '%s'] only one cleanup option is allowed, found: %s", host, strings.Join(options, ", ")))
+			continue
+		}
+		if host == lcmcommon.StrayOsdNodeMarker && len(hostSpec.CleanupByOsd) == 0 {
+			warnMsgs = append(warnMsgs, fmt.Sprintf("[%s] stray which are present in crush map is possible to remove only be osd id", lcmcommon.StrayOsdNodeMarker))
+			continue
+		}
+		devices := map[string]bool{}
+		for _, device := range hostSpec.CleanupByDevice {
+			if devices[device.Device] {
+				errMsgs = append(errMsgs, fmt.Sprintf("[node '%s'] device '%s' specified multiple times", host, device.Device))
+			}
+			devices[device.Device] = true
+		}
+		for _, osd := range hostSpec.CleanupByOsd {
+			if osd.ID < 0 {
+				errMsgs = append(errMsgs, fmt.Sprintf("[node '%s'] osd id '%d' is invalid, should be non-negative", host, osd.ID))
+				continue
+			}
+			if osdHost, present := osdHosts[osd.ID]; present {
+				if osdHost == host {
+					errMsgs = append(errMsgs, fmt.Sprintf("[node '%s'] osd with id '%d' specified multiple times", host, osd.ID))
+				} else {
+					errMsgs = append(errMsgs, fmt.Sprintf("[node '%s'] osd with id '%d' is already specified for node '%s'", host, osd.ID, osdHost))
+				}
+				continue
+			}
+			osdHosts[osd.ID] = host
+		}
+	}
+	return errMsgs, warnMsgs
+}
+
+func getAdmissionResult(errMsgs, warnMsgs []string) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	if len(warnMsgs) > 0 {
+		warnings = warnMsgs
+	}
+	if len(errMsgs) > 0 {
+		return warnings, errors.Errorf("validation of CephOsdRemoveTask spec is failed: %s", strings.Join(errMsgs, ", "))
+	}
+	return warnings, nil
+}
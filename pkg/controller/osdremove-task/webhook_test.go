@@ -0,0 +1,153 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osdremove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+	lcmcommon "github.com/Mirantis/pelagia/v3/pkg/common"
+)
+
+func TestCephOsdRemoveTaskValidator(t *testing.T) {
+	getTask := func(phase lcmv1alpha1.TaskPhase, approve bool, nodes map[string]lcmv1alpha1.NodeCleanUpSpec) *lcmv1alpha1.CephOsdRemoveTask {
+		task := &lcmv1alpha1.CephOsdRemoveTask{
+			Spec: &lcmv1alpha1.CephOsdRemoveTaskSpec{Approve: approve, Nodes: nodes},
+		}
+		if phase != "" {
+			task.Status = &lcmv1alpha1.CephOsdRemoveTaskStatus{Phase: phase}
+		}
+		return task
+	}
+	nodesByOsd := map[string]lcmv1alpha1.NodeCleanUpSpec{
+		"node-1": {CleanupByOsd: []lcmv1alpha1.OsdCleanupSpec{{ID: 1}}},
+	}
+	nodesByDevice := map[string]lcmv1alpha1.NodeCleanUpSpec{
+		"node-1": {CleanupByDevice: []lcmv1alpha1.DeviceCleanupSpec{{Device: "sdb"}}},
+	}
+	tests := []struct {
+		name             string
+		oldTask          *lcmv1alpha1.CephOsdRemoveTask
+		newTask          *lcmv1alpha1.CephOsdRemoveTask
+		expectedWarnings admission.Warnings
+		expectedError    string
+	}{
+		{
+			name:    "create task without nodes",
+			newTask: &lcmv1alpha1.CephOsdRemoveTask{},
+		},
+		{
+			name:    "create valid task",
+			newTask: getTask("", false, nodesByOsd),
+		},
+		{
+			name:             "create valid task with approve",
+			newTask:          getTask("", true, nodesByDevice),
+			expectedWarnings: admission.Warnings{"approve is pre-set, osd removal will be started right after validation without remove info review"},
+		},
+		{
+			name: "create invalid task",
+			newTask: getTask("", false, map[string]lcmv1alpha1.NodeCleanUpSpec{
+				"node-1":                     {},
+				"node-2":                     {CompleteCleanup: true, DropFromCrush: true},
+				"node-3":                     {CleanupByOsd: []lcmv1alpha1.OsdCleanupSpec{{ID: 1}, {ID: 1}, {ID: -1}}},
+				"node-4":                     {CleanupByOsd: []lcmv1alpha1.OsdCleanupSpec{{ID: 1}}},
+				"node-5":                     {CleanupByDevice: []lcmv1alpha1.DeviceCleanupSpec{{Device: "sdb"}, {Device: "sdb", SkipDeviceCleanup: true}}},
+				lcmcommon.StrayOsdNodeMarker: {CompleteCleanup: true},
+			}),
+			expectedWarnings: admission.Warnings{"[__stray] stray which are present in crush map is possible to remove only be osd id"},
+			expectedError: "validation of CephOsdRemoveTask spec is failed: [node 'node-1'] no cleanup option specified, " +
+				"[node 'node-2'] only one cleanup option is allowed, found: completeCleanup, dropFromCrush, " +
+				"[node 'node-3'] osd with id '1' specified multiple times, [node 'node-3'] osd id '-1' is invalid, should be non-negative, " +
+				"[node 'node-4'] osd with id '1' is already specified for node 'node-3', [node 'node-5'] device 'sdb' specified multiple times",
+		},
+		{
+			name:    "update task approve",
+			oldTask: getTask(lcmv1alpha1.TaskPhaseApproveWaiting, false, nodesByOsd),
+			newTask: getTask(lcmv1alpha1.TaskPhaseApproveWaiting, true, nodesByOsd),
+		},
+		{
+			name:    "update task nodes before approve",
+			oldTask: getTask(lcmv1alpha1.TaskPhaseApproveWaiting, false, nodesByOsd),
+			newTask: getTask(lcmv1alpha1.TaskPhaseApproveWaiting, false, nodesByDevice),
+		},
+		{
+			name:             "update task nodes after approve",
+			oldTask:          getTask(lcmv1alpha1.TaskPhaseWaitingOperator, true, nodesByOsd),
+			newTask:          getTask(lcmv1alpha1.TaskPhaseWaitingOperator, true, nodesByDevice),
+			expectedWarnings: admission.Warnings{"detected inappropriate spec changes after receiving approval, task will be aborted"},
+		},
+		{
+			name:    "update task nodes during processing with invalid spec",
+			oldTask: getTask(lcmv1alpha1.TaskPhaseProcessing, true, nodesByOsd),
+			newTask: getTask(lcmv1alpha1.TaskPhaseProcessing, false, map[string]lcmv1alpha1.NodeCleanUpSpec{"node-1": {}}),
+			expectedWarnings: admission.Warnings{
+				"task is in 'Processing' phase, nodes section changes have no effect",
+				"task is in 'Processing' phase, approve can not be revoked",
+			},
+			expectedError: "validation of CephOsdRemoveTask spec is failed: [node 'node-1'] no cleanup option specified",
+		},
+		{
+			name:             "update finished task",
+			oldTask:          getTask(lcmv1alpha1.TaskPhaseValidationFailed, false, nodesByOsd),
+			newTask:          getTask(lcmv1alpha1.TaskPhaseValidationFailed, true, nodesByOsd),
+			expectedWarnings: admission.Warnings{"task is already finished with 'ValidationFailed' phase, spec changes have no effect"},
+		},
+		{
+			name:    "resolve finished task",
+			oldTask: getTask(lcmv1alpha1.TaskPhaseFailed, true, nodesByOsd),
+			newTask: func() *lcmv1alpha1.CephOsdRemoveTask {
+				task := getTask(lcmv1alpha1.TaskPhaseFailed, true, nodesByOsd)
+				task.Spec.Resolved = true
+				return task
+			}(),
+		},
+		{
+			name:    "update task cephdeployment",
+			oldTask: getTask(lcmv1alpha1.TaskPhasePending, false, nodesByOsd),
+			newTask: func() *lcmv1alpha1.CephOsdRemoveTask {
+				task := getTask(lcmv1alpha1.TaskPhasePending, false, nodesByOsd)
+				task.Spec.CephDeployment = "cephcluster-2"
+				return task
+			}(),
+			expectedError: "validation of CephOsdRemoveTask spec is failed: cephDeployment can not be changed for already initiated task",
+		},
+	}
+	validator := &cephOsdRemoveTaskValidator{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var warnings admission.Warnings
+			var err error
+			if test.oldTask == nil {
+				warnings, err = validator.ValidateCreate(context.TODO(), test.newTask)
+			} else {
+				warnings, err = validator.ValidateUpdate(context.TODO(), test.oldTask, test.newTask)
+			}
+			assert.Equal(t, test.expectedWarnings, warnings)
+			if test.expectedError != "" {
+				assert.NotNil(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
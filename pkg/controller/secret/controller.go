@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -67,7 +68,14 @@ func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	CephdplClientset, _ := lcmclient.NewForConfig(config)
 	kubeclientset, _ := kubernetes.NewForConfig(config)
 
-	return &ReconcileCephSecrets{Client: mgr.GetClient(), Kubeclientset: kubeclientset, Rookclientset: RookClientset, Cephdplclientset: CephdplClientset, Scheme: mgr.GetScheme()}
+	return &ReconcileCephSecrets{
+		Client:           mgr.GetClient(),
+		Kubeclientset:    kubeclientset,
+		Rookclientset:    RookClientset,
+		Cephdplclientset: CephdplClientset,
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorder(ControllerName),
+	}
 }
 
 func cephDplSecretPredicate[T *cephlcmv1alpha1.CephDeploymentSecret]() predicate.TypedFuncs[T] {
@@ -114,6 +122,7 @@ type ReconcileCephSecrets struct {
 	Rookclientset    rookclient.Interface
 	Cephdplclientset lcmclient.Interface
 	Scheme           *runtime.Scheme
+	Recorder         events.EventRecorder
 }
 
 func (r *ReconcileCephSecrets) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -158,6 +167,7 @@ func (r *ReconcileCephSecrets) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{RequeueAfter: lcmcommon.DefaultImmediateRequeueInterval}, nil
 	}
 
+	lcmConfig = lcmconfig.GetClusterConfiguration(request.Namespace, request.Name, cephDpl.Spec.RookNamespace)
 	secretConfig := &cephDeploymentSecretConfig{
 		context:       ctx,
 		api:           r,
@@ -218,9 +228,11 @@ func (r *ReconcileCephSecrets) updateCephDeploymentSecretStatus(ctx context.Cont
 		objlog.Debug().Msgf("updating status with new check timestamps")
 	}
 	status.LastSecretCheck = timeNow
+	oldStatus := cephDplSecret.Status.DeepCopy()
 	err = cephlcmv1alpha1.UpdateCephDeploymentSecretStatus(ctx, cephDplSecret, status, r.Client)
 	if err != nil {
 		return errors.Wrapf(err, "failed to update CephDeploymentSecret %s/%s status", cephDplSecret.Namespace, cephDplSecret.Name)
 	}
+	r.recordStateChangedEvent(cephDplSecret, oldStatus, status)
 	return nil
 }
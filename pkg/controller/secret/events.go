@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+const (
+	eventReasonStateChanged = "StateChanged"
+	eventActionSecretCheck  = "SecretCheck"
+)
+
+func (r *ReconcileCephSecrets) recordStateChangedEvent(cephDplSecret *cephlcmv1alpha1.CephDeploymentSecret, oldStatus, newStatus *cephlcmv1alpha1.CephDeploymentSecretStatus) {
+	if r.Recorder == nil || newStatus == nil {
+		return
+	}
+	oldState := cephlcmv1alpha1.CephDeploymentHealthState("")
+	if oldStatus != nil {
+		oldState = oldStatus.State
+	}
+	if newStatus.State == oldState {
+		return
+	}
+	// do not spam about initial healthy state
+	if oldState == "" && newStatus.State == cephlcmv1alpha1.HealthStateOk {
+		return
+	}
+	if newStatus.State == cephlcmv1alpha1.HealthStateFailed {
+		r.Recorder.Eventf(cephDplSecret, nil, corev1.EventTypeWarning, eventReasonStateChanged, eventActionSecretCheck,
+			"secrets state changed to %s, issues: %s", newStatus.State, strings.Join(newStatus.Messages, ", "))
+		return
+	}
+	r.Recorder.Eventf(cephDplSecret, nil, corev1.EventTypeNormal, eventReasonStateChanged, eventActionSecretCheck,
+		"secrets state changed from %s to %s", oldState, newStatus.State)
+}
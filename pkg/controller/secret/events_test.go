@@ -0,0 +1,70 @@
+/*
+Copyright 2026 Mirantis IT.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/events"
+
+	cephlcmv1alpha1 "github.com/Mirantis/pelagia/v3/pkg/apis/ceph.pelagia.lcm/v1alpha1"
+)
+
+func TestRecordStateChangedEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		oldStatus     *cephlcmv1alpha1.CephDeploymentSecretStatus
+		newStatus     *cephlcmv1alpha1.CephDeploymentSecretStatus
+		expectedEvent string
+	}{
+		{
+			name:      "initial healthy state",
+			newStatus: &cephlcmv1alpha1.CephDeploymentSecretStatus{State: cephlcmv1alpha1.HealthStateOk},
+		},
+		{
+			name:          "initial failed state",
+			newStatus:     &cephlcmv1alpha1.CephDeploymentSecretStatus{State: cephlcmv1alpha1.HealthStateFailed, Messages: []string{"failed to get rgw admin secret"}},
+			expectedEvent: "Warning StateChanged secrets state changed to Failed, issues: failed to get rgw admin secret",
+		},
+		{
+			name:      "state is not changed",
+			oldStatus: &cephlcmv1alpha1.CephDeploymentSecretStatus{State: cephlcmv1alpha1.HealthStateOk},
+			newStatus: &cephlcmv1alpha1.CephDeploymentSecretStatus{State: cephlcmv1alpha1.HealthStateOk},
+		},
+		{
+			name:          "state changed to ok",
+			oldStatus:     &cephlcmv1alpha1.CephDeploymentSecretStatus{State: cephlcmv1alpha1.HealthStateFailed},
+			newStatus:     &cephlcmv1alpha1.CephDeploymentSecretStatus{State: cephlcmv1alpha1.HealthStateOk},
+			expectedEvent: "Normal StateChanged secrets state changed from Failed to Ok",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(1)
+			r := FakeReconciler()
+			r.Recorder = recorder
+			r.recordStateChangedEvent(&cephlcmv1alpha1.CephDeploymentSecret{}, test.oldStatus, test.newStatus)
+			event := ""
+			select {
+			case event = <-recorder.Events:
+			default:
+			}
+			assert.Equal(t, test.expectedEvent, event)
+		})
+	}
+}
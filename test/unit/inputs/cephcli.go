@@ -62,6 +62,7 @@ var CephStatusTmpl = `{
   "osdmap": {osdmap},
   "fsmap": {fsmap},
   "servicemap": {servicemap},
+  "pgmap": {pgmap},
   "progress_events": {progress_events}
 }`
 
@@ -81,6 +82,7 @@ func BuildCliOutput(template string, cmd string, overrideForOutput map[string]st
 			"osdmap":          `{"num_osds": 3, "num_up_osds": 3, "num_in_osds": 3}`,
 			"fsmap":           `{"by_rank": [], "up:standby": 0}`,
 			"servicemap":      `{"services": {}}`,
+			"pgmap":           `{"pgs_by_state": [{"state_name": "active+clean", "count": 3}], "num_pgs": 3}`,
 			"progress_events": "{}",
 		}
 	case "mgr dump":
@@ -350,6 +352,15 @@ var CephDfExtraPools = `{
     ]
 }`
 
+var CephOsdDumpFullRatios = `{
+    "epoch": 42,
+    "fsid": "8668f062-3faa-358a-85f3-f80fe6c1e306",
+    "full_ratio": 0.95,
+    "backfillfull_ratio": 0.9,
+    "nearfull_ratio": 0.85,
+    "require_osd_release": "squid"
+}`
+
 var CephOsdTreeForSizingCheck = `{
   "nodes":[
     {"id":-1,"name":"default","type":"root","type_id":11,"children":[-15,-17]},
@@ -410,11 +421,54 @@ var CephOsdTreeOutput = BuildCliOutput(CephOsdTreeOutputTmpl, "", map[string]str
 var CephOsdTreeOutputNoOsdsOnHost = BuildCliOutput(CephOsdTreeOutputTmpl, "", map[string]string{"childs_1": "\n", "childs_2": "\n"})
 
 var CephPoolsDetails = `[
-  {"pool_name": "pool-1", "size": 3, "crush_rule": 2},
-  {"pool_name": "pool-2", "size": 3, "crush_rule": 3},
-  {"pool_name": "pool-3", "size": 3, "crush_rule": 5}
+  {"pool_id": 1, "pool_name": "pool-1", "size": 3, "crush_rule": 2},
+  {"pool_id": 2, "pool_name": "pool-2", "size": 3, "crush_rule": 3},
+  {"pool_id": 3, "pool_name": "pool-3", "size": 3, "crush_rule": 5}
 ]`
 
+var CephStatusPgsWithIssues = BuildCliOutput(CephStatusTmpl, "status", map[string]string{"pgmap": `{"pgs_by_state": [
+    {"state_name": "active+undersized+degraded", "count": 1},
+    {"state_name": "active+clean+inconsistent", "count": 1},
+    {"state_name": "undersized+degraded+peered", "count": 1},
+    {"state_name": "stale+incomplete", "count": 1},
+    {"state_name": "active+clean", "count": 1}
+  ], "num_pgs": 5}`})
+
+var CephPgDumpStuck = `{
+  "stuck_pg_stats": [
+    {
+      "pgid": "1.0",
+      "state": "active+undersized+degraded",
+      "acting": [20,0],
+      "last_active": "2026-01-01T10:00:00.000000+0000",
+      "last_clean": "2026-01-01T08:00:00.000000+0000",
+      "last_undegraded": "2026-01-01T09:00:00.000000+0000",
+      "last_fullsized": "2026-01-01T08:30:00.000000+0000",
+      "last_unstale": "2026-01-01T10:00:00.000000+0000"
+    },
+    {
+      "pgid": "2.0",
+      "state": "undersized+degraded+peered",
+      "acting": [4],
+      "last_active": "2026-01-01T07:15:30.123456+0000",
+      "last_clean": "2026-01-01T07:00:00.000000+0000",
+      "last_undegraded": "2026-01-01T07:00:00.000000+0000",
+      "last_fullsized": "2026-01-01T07:00:00.000000+0000",
+      "last_unstale": "2026-01-01T10:00:00.000000+0000"
+    },
+    {
+      "pgid": "3.0",
+      "state": "stale+incomplete",
+      "acting": [30,5],
+      "last_active": "2025-12-31T20:00:00.000000+0000",
+      "last_clean": "2025-12-31T20:00:00.000000+0000",
+      "last_undegraded": "2025-12-31T20:00:00.000000+0000",
+      "last_fullsized": "2025-12-31T20:00:00.000000+0000",
+      "last_unstale": "2026-01-01T09:30:00.000000+0000"
+    }
+  ]
+}`
+
 var CephCrushRuleDumpTmpl = `[
     {
         "rule_id": 0,